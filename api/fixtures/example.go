@@ -22,6 +22,7 @@ import (
 
 type ExampleResources struct {
 	AdditionalTrustBundle *corev1.ConfigMap
+	GuestRBACManifests    *corev1.ConfigMap
 	Namespace             *corev1.Namespace
 	PullSecret            *corev1.Secret
 	Resources             []crclient.Object
@@ -42,6 +43,9 @@ func (o *ExampleResources) AsObjects() []crclient.Object {
 	if o.AdditionalTrustBundle != nil {
 		objects = append(objects, o.AdditionalTrustBundle)
 	}
+	if o.GuestRBACManifests != nil {
+		objects = append(objects, o.GuestRBACManifests)
+	}
 
 	objects = append(objects, o.Cluster)
 
@@ -58,11 +62,20 @@ type ExampleOptions struct {
 	ReleaseImage                     string
 	PullSecret                       []byte
 	IssuerURL                        string
+	ServiceAccountAudiences          []string
+	GuestDefaultDenyNetworkPolicy    bool
+	GuestDefaultLimits               corev1.ResourceList
+	GuestRBACManifests               map[string]string
 	SSHPublicKey                     []byte
 	SSHPrivateKey                    []byte
 	NodePoolReplicas                 int32
 	NodeDrainTimeout                 time.Duration
+	NodeLabels                       map[string]string
+	Taints                           []hyperv1.Taint
+	NodePodPIDsLimit                 int64
+	NodeContainerPIDsLimit           int64
 	ImageContentSources              []hyperv1.ImageContentSource
+	ImageSignaturePolicies           []hyperv1.ImageSignaturePolicy
 	InfraID                          string
 	MachineCIDR                      string
 	ServiceCIDR                      []string
@@ -77,9 +90,13 @@ type ExampleOptions struct {
 	AutoRepair                       bool
 	EtcdStorageClass                 string
 	ExternalDNSDomain                string
+	SharedIngress                    bool
 	Arch                             string
 	PausedUntil                      string
 	OLMCatalogPlacement              hyperv1.OLMCatalogPlacement
+	OAuthLoginTemplate               []byte
+	OAuthProviderSelectionTemplate   []byte
+	OAuthErrorTemplate               []byte
 	AWS                              *ExampleAWSOptions
 	None                             *ExampleNoneOptions
 	Agent                            *ExampleAgentOptions
@@ -160,11 +177,21 @@ func (o ExampleOptions) Resources() *ExampleResources {
 					},
 					Zone: o.AWS.Zones[0].Name,
 				},
-				ResourceTags:   o.AWS.ResourceTags,
-				EndpointAccess: endpointAccess,
+				ResourceTags:     o.AWS.ResourceTags,
+				EndpointAccess:   endpointAccess,
+				ServiceEndpoints: o.AWS.ServiceEndpoints,
+				Partition:        o.AWS.Partition,
+				SharedVPC:        o.AWS.SharedVPC,
 			},
 		}
 
+		if o.AWS.APILoadBalancerType != "" || o.AWS.APILoadBalancerScheme != "" {
+			platformSpec.AWS.LoadBalancer = &hyperv1.AWSLoadBalancerSpec{
+				Type:   hyperv1.AWSLoadBalancerType(o.AWS.APILoadBalancerType),
+				Scheme: hyperv1.AWSLoadBalancerScheme(o.AWS.APILoadBalancerScheme),
+			}
+		}
+
 		if o.AWS.ProxyAddress != "" {
 			proxyConfig = &configv1.ProxySpec{
 				HTTPProxy:  o.AWS.ProxyAddress,
@@ -189,7 +216,7 @@ func (o ExampleOptions) Resources() *ExampleResources {
 				},
 			}
 		}
-		services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "")
+		services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "" || o.SharedIngress)
 		if o.ExternalDNSDomain != "" {
 			for i, svc := range services {
 				switch svc.Service {
@@ -233,13 +260,15 @@ func (o ExampleOptions) Resources() *ExampleResources {
 		if o.None.APIServerAddress != "" {
 			services = getServicePublishingStrategyMappingByAPIServerAddress(o.None.APIServerAddress, o.NetworkType)
 		} else {
-			services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "")
+			services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "" || o.SharedIngress)
 		}
 	case o.Agent != nil:
 		platformSpec = hyperv1.PlatformSpec{
 			Type: hyperv1.AgentPlatform,
 			Agent: &hyperv1.AgentPlatformSpec{
 				AgentNamespace: o.Agent.AgentNamespace,
+				APIVIP:         o.Agent.APIVIP,
+				IngressVIP:     o.Agent.IngressVIP,
 			},
 		}
 		agentResources := &ExampleAgentResources{
@@ -272,7 +301,7 @@ func (o ExampleOptions) Resources() *ExampleResources {
 		case "NodePort":
 			services = getServicePublishingStrategyMappingByAPIServerAddress(o.Kubevirt.APIServerAddress, o.NetworkType)
 		case "Ingress":
-			services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "")
+			services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "" || o.SharedIngress)
 		default:
 			panic(fmt.Sprintf("service publishing type %s is not supported", o.Kubevirt.ServicePublishingStrategy))
 		}
@@ -380,7 +409,7 @@ func (o ExampleOptions) Resources() *ExampleResources {
 			}
 		}
 
-		services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "")
+		services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "" || o.SharedIngress)
 		if o.ExternalDNSDomain != "" {
 			for i, svc := range services {
 				switch svc.Service {
@@ -438,7 +467,7 @@ func (o ExampleOptions) Resources() *ExampleResources {
 				ImageRegistryOperatorCloudCreds: corev1.LocalObjectReference{Name: o.PowerVS.Resources.ImageRegistryOperatorCloudCreds.Name},
 			},
 		}
-		services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "")
+		services = getIngressServicePublishingStrategyMapping(o.NetworkType, o.ExternalDNSDomain != "" || o.SharedIngress)
 	default:
 		panic("no platform specified")
 	}
@@ -491,12 +520,13 @@ func (o ExampleOptions) Resources() *ExampleResources {
 			Networking: hyperv1.ClusterNetworking{
 				NetworkType: o.NetworkType,
 			},
-			Services:   services,
-			InfraID:    o.InfraID,
-			PullSecret: corev1.LocalObjectReference{Name: pullSecret.Name},
-			IssuerURL:  o.IssuerURL,
-			SSHKey:     sshKeyReference,
-			FIPS:       o.FIPS,
+			Services:                services,
+			InfraID:                 o.InfraID,
+			PullSecret:              corev1.LocalObjectReference{Name: pullSecret.Name},
+			IssuerURL:               o.IssuerURL,
+			ServiceAccountAudiences: o.ServiceAccountAudiences,
+			SSHKey:                  sshKeyReference,
+			FIPS:                    o.FIPS,
 			DNS: hyperv1.DNSSpec{
 				BaseDomain:    o.BaseDomain,
 				PublicZoneID:  o.PublicZoneID,
@@ -543,6 +573,58 @@ func (o ExampleOptions) Resources() *ExampleResources {
 		cluster.Spec.Configuration = &hyperv1.ClusterConfiguration{Proxy: proxyConfig}
 	}
 
+	oauthTemplateSecret := func(name, key string, template []byte) *corev1.Secret {
+		return &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace.Name,
+				Name:      name,
+			},
+			Data: map[string][]byte{
+				key: template,
+			},
+		}
+	}
+	var oauthTemplates configv1.OAuthTemplates
+	if len(o.OAuthLoginTemplate) > 0 {
+		secret := oauthTemplateSecret(o.Name+"-oauth-login-template", "login.html", o.OAuthLoginTemplate)
+		resources = append(resources, secret)
+		oauthTemplates.Login = configv1.SecretNameReference{Name: secret.Name}
+	}
+	if len(o.OAuthProviderSelectionTemplate) > 0 {
+		secret := oauthTemplateSecret(o.Name+"-oauth-provider-selection-template", "providers.html", o.OAuthProviderSelectionTemplate)
+		resources = append(resources, secret)
+		oauthTemplates.ProviderSelection = configv1.SecretNameReference{Name: secret.Name}
+	}
+	if len(o.OAuthErrorTemplate) > 0 {
+		secret := oauthTemplateSecret(o.Name+"-oauth-error-template", "errors.html", o.OAuthErrorTemplate)
+		resources = append(resources, secret)
+		oauthTemplates.Error = configv1.SecretNameReference{Name: secret.Name}
+	}
+	if oauthTemplates != (configv1.OAuthTemplates{}) {
+		if cluster.Spec.Configuration == nil {
+			cluster.Spec.Configuration = &hyperv1.ClusterConfiguration{}
+		}
+		cluster.Spec.Configuration.OAuth = &configv1.OAuthSpec{Templates: oauthTemplates}
+	}
+
+	if len(o.GuestDefaultLimits) > 0 {
+		if cluster.Spec.Configuration == nil {
+			cluster.Spec.Configuration = &hyperv1.ClusterConfiguration{}
+		}
+		cluster.Spec.Configuration.GuestDefaultLimits = o.GuestDefaultLimits
+	}
+
+	if o.GuestDefaultDenyNetworkPolicy {
+		if cluster.Spec.Configuration == nil {
+			cluster.Spec.Configuration = &hyperv1.ClusterConfiguration{}
+		}
+		cluster.Spec.Configuration.GuestDefaultDenyNetworkPolicy = true
+	}
+
 	if o.NodeSelector != nil {
 		cluster.Spec.NodeSelector = o.NodeSelector
 	}
@@ -565,13 +647,37 @@ func (o ExampleOptions) Resources() *ExampleResources {
 		cluster.Spec.AdditionalTrustBundle = &corev1.LocalObjectReference{Name: userCABundleCM.Name}
 	}
 
+	var guestRBACManifestsCM *corev1.ConfigMap
+	if len(o.GuestRBACManifests) > 0 {
+		guestRBACManifestsCM = &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ConfigMap",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "guest-rbac-manifests",
+				Namespace: namespace.Name,
+			},
+			Data: o.GuestRBACManifests,
+		}
+		if cluster.Spec.Configuration == nil {
+			cluster.Spec.Configuration = &hyperv1.ClusterConfiguration{}
+		}
+		cluster.Spec.Configuration.GuestRBACManifests = &corev1.LocalObjectReference{Name: guestRBACManifestsCM.Name}
+	}
+
 	if len(o.ImageContentSources) > 0 {
 		cluster.Spec.ImageContentSources = o.ImageContentSources
 	}
 
+	if len(o.ImageSignaturePolicies) > 0 {
+		cluster.Spec.ImageSignaturePolicies = o.ImageSignaturePolicies
+	}
+
 	if o.NodePoolReplicas <= -1 {
 		return &ExampleResources{
 			AdditionalTrustBundle: userCABundleCM,
+			GuestRBACManifests:    guestRBACManifestsCM,
 			Namespace:             namespace,
 			PullSecret:            pullSecret,
 			Resources:             resources,
@@ -581,6 +687,17 @@ func (o ExampleOptions) Resources() *ExampleResources {
 		}
 	}
 
+	var pidLimits *hyperv1.PIDLimitsSpec
+	if o.NodePodPIDsLimit != 0 || o.NodeContainerPIDsLimit != 0 {
+		pidLimits = &hyperv1.PIDLimitsSpec{}
+		if o.NodePodPIDsLimit != 0 {
+			pidLimits.PodPIDsLimit = &o.NodePodPIDsLimit
+		}
+		if o.NodeContainerPIDsLimit != 0 {
+			pidLimits.ContainerPIDsLimit = &o.NodeContainerPIDsLimit
+		}
+	}
+
 	defaultNodePool := func(name string) *hyperv1.NodePool {
 		return &hyperv1.NodePool{
 			TypeMeta: metav1.TypeMeta{
@@ -606,6 +723,9 @@ func (o ExampleOptions) Resources() *ExampleResources {
 				},
 				Arch:             o.Arch,
 				NodeDrainTimeout: &metav1.Duration{Duration: o.NodeDrainTimeout},
+				PIDLimits:        pidLimits,
+				NodeLabels:       o.NodeLabels,
+				Taints:           o.Taints,
 			},
 		}
 	}
@@ -613,27 +733,41 @@ func (o ExampleOptions) Resources() *ExampleResources {
 	var nodePools []*hyperv1.NodePool
 	switch cluster.Spec.Platform.Type {
 	case hyperv1.AWSPlatform:
+		// With multiple instance types, one NodePool is created per zone and
+		// type so capacity is diversified across the given types.
+		instanceTypes := o.AWS.InstanceTypes
+		if len(instanceTypes) == 0 {
+			instanceTypes = []string{o.AWS.InstanceType}
+		}
 		for _, zone := range o.AWS.Zones {
-			nodePool := defaultNodePool(fmt.Sprintf("%s-%s", cluster.Name, zone.Name))
-			nodePool.Spec.Platform.AWS = &hyperv1.AWSNodePoolPlatform{
-				InstanceType:    o.AWS.InstanceType,
-				InstanceProfile: o.AWS.InstanceProfile,
-				Subnet: &hyperv1.AWSResourceReference{
-					ID: zone.SubnetID,
-				},
-				SecurityGroups: []hyperv1.AWSResourceReference{
-					{
-						ID: &o.AWS.SecurityGroupID,
+			for _, instanceType := range instanceTypes {
+				name := fmt.Sprintf("%s-%s", cluster.Name, zone.Name)
+				if len(instanceTypes) > 1 {
+					name = fmt.Sprintf("%s-%s", name, strings.ReplaceAll(instanceType, ".", "-"))
+				}
+				nodePool := defaultNodePool(name)
+				nodePool.Spec.Platform.AWS = &hyperv1.AWSNodePoolPlatform{
+					InstanceType:    instanceType,
+					InstanceProfile: o.AWS.InstanceProfile,
+					Subnet: &hyperv1.AWSResourceReference{
+						ID: zone.SubnetID,
 					},
-				},
-				RootVolume: &hyperv1.Volume{
-					Size:          o.AWS.RootVolumeSize,
-					Type:          o.AWS.RootVolumeType,
-					IOPS:          o.AWS.RootVolumeIOPS,
-					EncryptionKey: o.AWS.RootVolumeEncryptionKey,
-				},
+					SecurityGroups: []hyperv1.AWSResourceReference{
+						{
+							ID: &o.AWS.SecurityGroupID,
+						},
+					},
+					RootVolume: &hyperv1.Volume{
+						Size:          o.AWS.RootVolumeSize,
+						Type:          o.AWS.RootVolumeType,
+						IOPS:          o.AWS.RootVolumeIOPS,
+						EncryptionKey: o.AWS.RootVolumeEncryptionKey,
+					},
+					Tenancy:         o.AWS.Tenancy,
+					DedicatedHostID: o.AWS.DedicatedHostID,
+				}
+				nodePools = append(nodePools, nodePool)
 			}
-			nodePools = append(nodePools, nodePool)
 		}
 	case hyperv1.KubevirtPlatform:
 		nodePool := defaultNodePool(cluster.Name)
@@ -691,6 +825,7 @@ func (o ExampleOptions) Resources() *ExampleResources {
 
 	return &ExampleResources{
 		AdditionalTrustBundle: userCABundleCM,
+		GuestRBACManifests:    guestRBACManifestsCM,
 		Namespace:             namespace,
 		PullSecret:            pullSecret,
 		Resources:             resources,
@@ -700,10 +835,10 @@ func (o ExampleOptions) Resources() *ExampleResources {
 	}
 }
 
-func getIngressServicePublishingStrategyMapping(netType hyperv1.NetworkType, usesExternalDNS bool) []hyperv1.ServicePublishingStrategyMapping {
+func getIngressServicePublishingStrategyMapping(netType hyperv1.NetworkType, apiServerViaRoute bool) []hyperv1.ServicePublishingStrategyMapping {
 	// TODO (Alberto): Default KAS to Route if endpointAccess is Private.
 	apiServiceStrategy := hyperv1.LoadBalancer
-	if usesExternalDNS {
+	if apiServerViaRoute {
 		apiServiceStrategy = hyperv1.Route
 	}
 	ret := []hyperv1.ServicePublishingStrategyMapping{
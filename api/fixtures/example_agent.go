@@ -8,6 +8,8 @@ import (
 type ExampleAgentOptions struct {
 	APIServerAddress string
 	AgentNamespace   string
+	APIVIP           string
+	IngressVIP       string
 }
 
 type ExampleAgentResources struct {
@@ -11,6 +11,7 @@ type ExampleAWSOptions struct {
 	SecurityGroupID         string
 	InstanceProfile         string
 	InstanceType            string
+	InstanceTypes           []string
 	Roles                   hyperv1.AWSRolesRef
 	KMSProviderRoleARN      string
 	KMSKeyARN               string
@@ -20,7 +21,14 @@ type ExampleAWSOptions struct {
 	RootVolumeEncryptionKey string
 	ResourceTags            []hyperv1.AWSResourceTag
 	EndpointAccess          string
+	APILoadBalancerType     string
+	APILoadBalancerScheme   string
+	ServiceEndpoints        []hyperv1.AWSServiceEndpoint
+	Partition               string
 	ProxyAddress            string
+	SharedVPC               *hyperv1.AWSSharedVPC
+	Tenancy                 string
+	DedicatedHostID         string
 }
 
 type ExampleAWSOptionsZones struct {
@@ -1,6 +1,9 @@
 package v1alpha1
 
-import configv1 "github.com/openshift/api/config/v1"
+import (
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+)
 
 func (c *ClusterConfiguration) GetAPIServer() *configv1.APIServerSpec { return c.APIServer }
 func (c *ClusterConfiguration) GetAuthentication() *configv1.AuthenticationSpec {
@@ -13,3 +16,9 @@ func (c *ClusterConfiguration) GetNetwork() *configv1.NetworkSpec         { retu
 func (c *ClusterConfiguration) GetOAuth() *configv1.OAuthSpec             { return c.OAuth }
 func (c *ClusterConfiguration) GetScheduler() *configv1.SchedulerSpec     { return c.Scheduler }
 func (c *ClusterConfiguration) GetProxy() *configv1.ProxySpec             { return c.Proxy }
+func (c *ClusterConfiguration) GetIngressDefaultCertificate() *corev1.LocalObjectReference {
+	return c.IngressDefaultCertificate
+}
+func (c *ClusterConfiguration) GetGuestRBACManifests() *corev1.LocalObjectReference {
+	return c.GuestRBACManifests
+}
@@ -47,6 +47,12 @@ type HostedControlPlaneSpec struct {
 	// validation.
 	IssuerURL string `json:"issuerURL"`
 
+	// ServiceAccountAudiences is a list of additional audiences the API server
+	// accepts in projected ServiceAccount tokens, on top of the issuer URL.
+	//
+	// +optional
+	ServiceAccountAudiences []string `json:"serviceAccountAudiences,omitempty"`
+
 	// Networking specifies network configuration for the cluster.
 	// Temporarily optional for backward compatibility, required in future releases.
 	// +optional
@@ -151,6 +157,19 @@ type HostedControlPlaneSpec struct {
 	// +optional
 	AuditWebhook *corev1.LocalObjectReference `json:"auditWebhook,omitempty"`
 
+	// AuditWebhookBatching configures how the kube-apiserver batches audit
+	// events before sending them to the webhook referenced by AuditWebhook.
+	// +optional
+	AuditWebhookBatching *AuditWebhookBatchingSpec `json:"auditWebhookBatching,omitempty"`
+
+	// CloudProviderConfig references a secret in the control plane namespace
+	// containing a custom cloud provider configuration that is mounted into the
+	// cloud controller manager in place of the generated configuration. The
+	// configuration needs to be stored in the secret with a secret key name
+	// that corresponds to the constant CloudProviderConfigKey.
+	// +optional
+	CloudProviderConfig *corev1.LocalObjectReference `json:"cloudProviderConfig,omitempty"`
+
 	// Etcd contains metadata about the etcd cluster the hypershift managed Openshift control plane components
 	// use to store data.
 	Etcd EtcdSpec `json:"etcd"`
@@ -164,6 +183,11 @@ type HostedControlPlaneSpec struct {
 	// +optional
 	ImageContentSources []ImageContentSource `json:"imageContentSources,omitempty"`
 
+	// ImageSignaturePolicies configures sigstore signature verification for
+	// images pulled by the guest cluster's nodes.
+	// +optional
+	ImageSignaturePolicies []ImageSignaturePolicy `json:"imageSignaturePolicies,omitempty"`
+
 	// AdditionalTrustBundle references a ConfigMap containing a PEM-encoded X.509 certificate bundle
 	// +optional
 	AdditionalTrustBundle *corev1.LocalObjectReference `json:"additionalTrustBundle,omitempty"`
@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	configv1 "github.com/openshift/api/config/v1"
+	consolev1 "github.com/openshift/api/console/v1"
 
 	"github.com/openshift/hypershift/api/util/ipnet"
 )
@@ -19,7 +20,9 @@ func init() {
 
 const (
 	// AuditWebhookKubeconfigKey is the key name in the AuditWebhook secret that stores audit webhook kubeconfig
-	AuditWebhookKubeconfigKey          = "webhook-kubeconfig"
+	AuditWebhookKubeconfigKey = "webhook-kubeconfig"
+	// CloudProviderConfigKey is the key name in the CloudProviderConfig secret that stores the custom cloud provider config
+	CloudProviderConfigKey             = "cloud.conf"
 	DisablePKIReconciliationAnnotation = "hypershift.openshift.io/disable-pki-reconciliation"
 	// SkipReleaseImageValidation skips any release validation that the HO version might dictate for any HC and skip min supported version check for NodePools.
 	SkipReleaseImageValidation                = "hypershift.openshift.io/skip-release-image-validation"
@@ -29,6 +32,11 @@ const (
 	// before the resource goes away. The format of the annotation is a go duration string with a numeric component and unit.
 	// sample: hypershift.openshift.io/destroy-grace-period: "600s"
 	HCDestroyGracePeriodAnnotation = "hypershift.openshift.io/destroy-grace-period"
+	// ControlPlaneNamespaceAnnotation overrides the namespace the hosted control plane
+	// is created in. By default the namespace name is derived from the HostedCluster
+	// namespace and name. The value must be a valid namespace name that is not used
+	// by any other HostedCluster.
+	ControlPlaneNamespaceAnnotation = "hypershift.openshift.io/control-plane-namespace"
 	// ControlPlanePriorityClass is for pods in the HyperShift Control Plane that are not API critical but still need elevated priority. E.g Cluster Version Operator.
 	ControlPlanePriorityClass = "hypershift.openshift.io/control-plane-priority-class"
 	// APICriticalPriorityClass is for pods that are required for API calls and resource admission to succeed. This includes pods like kube-apiserver, aggregated API servers, and webhooks.
@@ -50,6 +58,13 @@ const (
 	RestartDateAnnotation = "hypershift.openshift.io/restart-date"
 	// ReleaseImageAnnotation is an annotation that can be used to see what release image a given deployment is tied to
 	ReleaseImageAnnotation = "hypershift.openshift.io/release-image"
+	// LastEncryptionKeyRotationAnnotation is set on the HostedControlPlane to the time of the most recent secret
+	// encryption key rotation. The hosted cluster config operator re-encrypts the guest cluster's secrets when it
+	// observes a rotation newer than the last completed re-encryption.
+	LastEncryptionKeyRotationAnnotation = "hypershift.openshift.io/last-encryption-key-rotation"
+	// SecretsReencryptedAnnotation is set on the HostedControlPlane by the hosted cluster config operator to the
+	// rotation time for which all of the guest cluster's secrets were last re-encrypted.
+	SecretsReencryptedAnnotation = "hypershift.openshift.io/secrets-reencrypted-for-rotation"
 	// ClusterAPIManagerImage is an annotation that allows the specification of the cluster api manager image.
 	// This is a temporary workaround necessary for compliance reasons on the IBM Cloud side:
 	// no images can be pulled from registries outside of IBM Cloud's official regional registries
@@ -232,6 +247,54 @@ const (
 
 	// JSONPatchAnnotation allow modifying the kubevirt VM template using jsonpatch
 	JSONPatchAnnotation = "hypershift.openshift.io/kubevirt-vm-jsonpatch"
+
+	// DisableCVOAutoUpgradeAnnotation prevents the guest cluster's cluster-version operator from
+	// automatically advancing the cluster version. When set to "true", the ClusterVersion channel
+	// is kept empty regardless of spec.channel, so version changes only happen through explicit
+	// updates of the release image.
+	DisableCVOAutoUpgradeAnnotation = "hypershift.openshift.io/disable-cvo-auto-upgrade"
+
+	// TopologySpreadConstraintsAnnotation makes control plane deployments with more than one
+	// replica spread across the management cluster topology with topology spread constraints,
+	// in addition to the default zonal anti-affinity. The value is a comma-separated list of
+	// topologyKey=maxSkew pairs, e.g. "topology.kubernetes.io/zone=1". The topology keys must
+	// exist as labels on the management cluster nodes for the constraints to take effect.
+	TopologySpreadConstraintsAnnotation = "hypershift.openshift.io/topology-spread-constraints"
+
+	// CCMFeatureGatesAnnotation sets feature gates on the cloud-controller-manager that runs
+	// as part of the hosted control plane. The value is a comma-separated list of
+	// gate=true|false pairs, e.g. "CloudDualStackNodeIPs=true".
+	CCMFeatureGatesAnnotation = "hypershift.openshift.io/ccm-feature-gates"
+
+	// AuditLogForwardingAnnotation makes the guest cluster's logging operator forward
+	// kube-apiserver audit logs to an external destination. The value has the form
+	// type=url where type is one of syslog, elasticsearch or kafka, e.g.
+	// "syslog=tcp://collector.example.com:514". The cluster logging operator must be
+	// installed in the guest cluster for forwarding to take effect.
+	AuditLogForwardingAnnotation = "hypershift.openshift.io/audit-log-forwarding"
+
+	// AvailabilityProbeRetriesAnnotation is the number of consecutive reconciles
+	// the kube-apiserver reachability probe may fail before the endpoint is
+	// considered unreachable. Defaults to 3, capped at 10.
+	AvailabilityProbeRetriesAnnotation = "hypershift.openshift.io/availability-probe-retries"
+
+	// AvailabilityProbeBackoffAnnotation is the duration after which a failed
+	// kube-apiserver reachability probe is retried, e.g. "5s". Defaults to 5s,
+	// capped at 1m.
+	AvailabilityProbeBackoffAnnotation = "hypershift.openshift.io/availability-probe-backoff"
+
+	// AvailabilityProbeDebounceAnnotation is the duration the kube-apiserver
+	// reachability probe must keep failing before the Available condition degrades,
+	// e.g. "1m". Transient blips shorter than this do not flip the condition.
+	// Defaults to 0, i.e. failures degrade the condition immediately. Capped at 15m.
+	AvailabilityProbeDebounceAnnotation = "hypershift.openshift.io/availability-probe-debounce"
+
+	// SerializeNodePoolRolloutsAnnotation when set to "true" on a HostedCluster lets
+	// only one of its NodePools roll out a config or version change at a time.
+	// Pools wanting to update wait until no other pool of the cluster is updating
+	// and proceed in lexical name order. This protects capacity-constrained
+	// clusters during fleet-wide config changes.
+	SerializeNodePoolRolloutsAnnotation = "hypershift.openshift.io/serialize-nodepool-rollouts"
 )
 
 // HostedClusterSpec is the desired behavior of a HostedCluster.
@@ -358,6 +421,13 @@ type HostedClusterSpec struct {
 	// +kubebuilder:validation:Format=uri
 	IssuerURL string `json:"issuerURL,omitempty"`
 
+	// ServiceAccountAudiences is a list of additional audiences the API server
+	// accepts in projected ServiceAccount tokens, on top of the issuer URL.
+	// Each entry must be a non-empty string.
+	//
+	// +optional
+	ServiceAccountAudiences []string `json:"serviceAccountAudiences,omitempty"`
+
 	// ServiceAccountSigningKey is a reference to a secret containing the private key
 	// used by the service account token issuer. The secret is expected to contain
 	// a single key named "key". If not specified, a service account signing key will
@@ -388,6 +458,22 @@ type HostedClusterSpec struct {
 	// +immutable
 	AuditWebhook *corev1.LocalObjectReference `json:"auditWebhook,omitempty"`
 
+	// AuditWebhookBatching configures how the kube-apiserver batches audit
+	// events before sending them to the webhook referenced by AuditWebhook.
+	// It has no effect unless AuditWebhook is set.
+	//
+	// +optional
+	AuditWebhookBatching *AuditWebhookBatchingSpec `json:"auditWebhookBatching,omitempty"`
+
+	// CloudProviderConfig references a secret containing a custom cloud
+	// provider configuration for the cluster. When set, the configuration is
+	// mounted into the cloud controller manager in place of the generated
+	// configuration. The configuration needs to be stored in the secret with a
+	// secret key name that corresponds to the constant CloudProviderConfigKey.
+	//
+	// +optional
+	CloudProviderConfig *corev1.LocalObjectReference `json:"cloudProviderConfig,omitempty"`
+
 	// ImageContentSources specifies image mirrors that can be used by cluster
 	// nodes to pull content.
 	//
@@ -395,6 +481,13 @@ type HostedClusterSpec struct {
 	// +immutable
 	ImageContentSources []ImageContentSource `json:"imageContentSources,omitempty"`
 
+	// ImageSignaturePolicies configures sigstore signature verification for
+	// images pulled by the guest cluster's nodes. Each entry is rendered into
+	// a ClusterImagePolicy in the guest cluster.
+	//
+	// +optional
+	ImageSignaturePolicies []ImageSignaturePolicy `json:"imageSignaturePolicies,omitempty"`
+
 	// AdditionalTrustBundle is a reference to a ConfigMap containing a
 	// PEM-encoded X.509 certificate bundle that will be added to the hosted controlplane and nodes
 	//
@@ -491,6 +584,61 @@ type ImageContentSource struct {
 	Mirrors []string `json:"mirrors,omitempty"`
 }
 
+// ImageSignaturePolicy describes a sigstore signature verification policy for
+// a set of image repositories.
+type ImageSignaturePolicy struct {
+	// Name identifies the policy. It is used as the name of the
+	// ClusterImagePolicy created in the guest cluster.
+	Name string `json:"name"`
+
+	// Scopes lists the registries and repositories the policy applies to.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Scopes []string `json:"scopes"`
+
+	// PublicKey is a PEM-encoded cosign public key trusted to sign images
+	// matching Scopes. Exactly one of PublicKey or Fulcio must be set.
+	//
+	// +optional
+	PublicKey []byte `json:"publicKey,omitempty"`
+
+	// Fulcio configures keyless signature verification against a Fulcio
+	// certificate authority. Exactly one of PublicKey or Fulcio must be set.
+	//
+	// +optional
+	Fulcio *FulcioPolicy `json:"fulcio,omitempty"`
+}
+
+// FulcioPolicy describes the root of trust for keyless sigstore signatures.
+type FulcioPolicy struct {
+	// CAData is the PEM-encoded certificate authority of the Fulcio instance.
+	CAData []byte `json:"caData"`
+
+	// OIDCIssuer is the OIDC issuer expected in the Fulcio certificate, e.g.
+	// https://oauth2.sigstore.dev/auth.
+	OIDCIssuer string `json:"oidcIssuer"`
+
+	// SignedEmail is the email address expected for the certificate identity.
+	SignedEmail string `json:"signedEmail"`
+}
+
+// AuditWebhookBatchingSpec configures the kube-apiserver audit webhook batch
+// settings. Events are always sent in batch mode; these settings bound the
+// batch size and the time an incomplete batch is held before being sent.
+type AuditWebhookBatchingSpec struct {
+	// MaxSize is the maximum number of audit events per batch.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// MaxWait is the maximum amount of time to wait before force sending an
+	// incomplete batch.
+	//
+	// +optional
+	MaxWait *metav1.Duration `json:"maxWait,omitempty"`
+}
+
 // ServicePublishingStrategyMapping specifies how individual control plane
 // services are published from the hosting cluster of a control plane.
 type ServicePublishingStrategyMapping struct {
@@ -645,6 +793,33 @@ type ClusterNetworking struct {
 	//
 	// +immutable
 	APIServer *APIServerNetworking `json:"apiServer,omitempty"`
+
+	// PolicyAuditConfig enables OVN-Kubernetes ACL logging for traffic denied
+	// by NetworkPolicies in the hosted cluster. Only honored when NetworkType
+	// is OVNKubernetes.
+	//
+	// +optional
+	PolicyAuditConfig *NetworkPolicyAuditConfig `json:"policyAuditConfig,omitempty"`
+}
+
+// NetworkPolicyAuditConfig configures audit logging of traffic denied by
+// NetworkPolicies in the hosted cluster.
+type NetworkPolicyAuditConfig struct {
+	// RateLimit is the approximate maximum number of ACL audit messages
+	// generated per second per node. When unset the OVN-Kubernetes default of
+	// 20 messages per second is used.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RateLimit *uint32 `json:"rateLimit,omitempty"`
+
+	// Severity is the syslog severity at which denied traffic is recorded.
+	// ACL logging is enabled at this severity in every non-system namespace of
+	// the hosted cluster. Defaults to notice.
+	//
+	// +kubebuilder:validation:Enum=alert;warning;notice;info;debug
+	// +optional
+	Severity string `json:"severity,omitempty"`
 }
 
 // MachineNetworkEntry is a single IP address block for node IP blocks.
@@ -941,6 +1116,20 @@ type KubevirtStorageClassMapping struct {
 type AgentPlatformSpec struct {
 	// AgentNamespace is the namespace where to search for Agents for this cluster
 	AgentNamespace string `json:"agentNamespace"`
+
+	// APIVIP is the virtual IP used to reach the guest cluster's API server on
+	// bare metal. When set, it is surfaced in the guest cluster's Infrastructure
+	// platform status so in-cluster components can rely on it.
+	//
+	// +optional
+	APIVIP string `json:"apiVIP,omitempty"`
+
+	// IngressVIP is the virtual IP used to reach the guest cluster's ingress on
+	// bare metal. When set, it is surfaced in the guest cluster's Infrastructure
+	// platform status so in-cluster components can rely on it.
+	//
+	// +optional
+	IngressVIP string `json:"ingressVIP,omitempty"`
 }
 
 // IBMCloudPlatformSpec defines IBMCloud specific settings for components
@@ -1155,6 +1344,15 @@ type AWSPlatformSpec struct {
 	// +immutable
 	ServiceEndpoints []AWSServiceEndpoint `json:"serviceEndpoints,omitempty"`
 
+	// Partition is the AWS security partition in which the cluster resides,
+	// e.g. aws-us-gov for GovCloud. When empty, the partition is derived from
+	// Region and defaults to the commercial aws partition for regions the SDK
+	// does not know about.
+	//
+	// +optional
+	// +immutable
+	Partition string `json:"partition,omitempty"`
+
 	// RolesRef contains references to various AWS IAM roles required to enable
 	// integrations such as OIDC.
 	//
@@ -1188,6 +1386,94 @@ type AWSPlatformSpec struct {
 	//
 	// +optional
 	AdditionalAllowedPrincipals []string `json:"additionalAllowedPrincipals,omitempty"`
+
+	// LoadBalancer specifies configuration for the load balancer that exposes
+	// the hosted kube-apiserver. The load balancer scheme follows EndpointAccess.
+	//
+	// +optional
+	LoadBalancer *AWSLoadBalancerSpec `json:"loadBalancer,omitempty"`
+
+	// SharedVPC specifies the configuration for running the cluster in a VPC
+	// owned by a central networking account. Load balancers and Route 53
+	// records are managed by assuming the networking account role, while
+	// worker instances are created by assuming the compute account role.
+	//
+	// +optional
+	// +immutable
+	SharedVPC *AWSSharedVPC `json:"sharedVPC,omitempty"`
+}
+
+// AWSSharedVPC configures cross-account usage of a VPC owned by a central
+// networking account, a common AWS landing-zone architecture.
+type AWSSharedVPC struct {
+	// NetworkingAccountRoleARN is the ARN of the role in the networking
+	// account which owns the shared VPC. It is assumed to manage load
+	// balancers and Route 53 records in that account.
+	//
+	// +immutable
+	NetworkingAccountRoleARN string `json:"networkingAccountRoleARN"`
+
+	// ComputeAccountRoleARN is the ARN of the role in the compute account
+	// which is assumed to manage worker instances in the shared VPC.
+	//
+	// +immutable
+	ComputeAccountRoleARN string `json:"computeAccountRoleARN"`
+}
+
+// AWSLoadBalancerType is a type of AWS load balancer used to expose the
+// hosted kube-apiserver.
+type AWSLoadBalancerType string
+
+const (
+	// NLBLoadBalancerType creates a network load balancer.
+	NLBLoadBalancerType AWSLoadBalancerType = "NLB"
+
+	// ClassicLoadBalancerType creates a classic load balancer.
+	ClassicLoadBalancerType AWSLoadBalancerType = "Classic"
+)
+
+// AWSLoadBalancerScheme is the scheme of the AWS load balancer used to expose
+// the hosted kube-apiserver.
+type AWSLoadBalancerScheme string
+
+const (
+	// InternetFacingLoadBalancerScheme exposes the load balancer with a
+	// publicly resolvable address.
+	InternetFacingLoadBalancerScheme AWSLoadBalancerScheme = "InternetFacing"
+
+	// InternalLoadBalancerScheme exposes the load balancer only within the
+	// VPC of the management cluster.
+	InternalLoadBalancerScheme AWSLoadBalancerScheme = "Internal"
+)
+
+// AWSLoadBalancerSpec specifies configuration for the load balancer that
+// exposes the hosted kube-apiserver on AWS.
+type AWSLoadBalancerSpec struct {
+	// Type is the type of load balancer to create for the kube-apiserver
+	// service. The default is NLB.
+	//
+	// +kubebuilder:validation:Enum=NLB;Classic
+	// +kubebuilder:default=NLB
+	// +optional
+	Type AWSLoadBalancerType `json:"type,omitempty"`
+
+	// Scheme is the scheme of the load balancer. The default is
+	// InternetFacing. Internal restricts the kube-apiserver to the VPC of the
+	// management cluster and is only valid with Public endpoint access;
+	// PublicAndPrivate and Private clusters already expose a dedicated
+	// internal load balancer.
+	//
+	// +kubebuilder:validation:Enum=InternetFacing;Internal
+	// +kubebuilder:default=InternetFacing
+	// +optional
+	Scheme AWSLoadBalancerScheme `json:"scheme,omitempty"`
+
+	// CrossZoneLoadBalancing enables cross-zone load balancing on the
+	// kube-apiserver load balancer. It is always enabled for private clusters
+	// regardless of this setting.
+	//
+	// +optional
+	CrossZoneLoadBalancing *bool `json:"crossZoneLoadBalancing,omitempty"`
 }
 
 type AWSRoleCredentials struct {
@@ -1703,6 +1989,41 @@ type EtcdSpec struct {
 type ManagedEtcdSpec struct {
 	// Storage specifies how etcd data is persisted.
 	Storage ManagedEtcdStorageSpec `json:"storage"`
+
+	// Compaction tunes etcd auto-compaction for high-churn clusters whose
+	// revisions accumulate faster than the etcd defaults reclaim them. When
+	// not set, the etcd built-in defaults apply. Changing this field restarts
+	// the etcd members.
+	//
+	// +optional
+	Compaction *EtcdCompactionSpec `json:"compaction,omitempty"`
+}
+
+// EtcdCompactionMode is an etcd auto-compaction mode.
+//
+// +kubebuilder:validation:Enum=Periodic;Revision
+type EtcdCompactionMode string
+
+const (
+	// PeriodicEtcdCompaction compacts the etcd keyspace by time.
+	PeriodicEtcdCompaction EtcdCompactionMode = "Periodic"
+
+	// RevisionEtcdCompaction retains a number of revisions and compacts the
+	// rest.
+	RevisionEtcdCompaction EtcdCompactionMode = "Revision"
+)
+
+// EtcdCompactionSpec configures etcd auto-compaction.
+type EtcdCompactionSpec struct {
+	// Mode selects the auto-compaction mode: Periodic compacts by time,
+	// Revision retains a number of revisions.
+	Mode EtcdCompactionMode `json:"mode"`
+
+	// Retention is the auto-compaction retention: a duration such as "30m"
+	// in Periodic mode, or a number of revisions in Revision mode.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Retention string `json:"retention"`
 }
 
 // ManagedEtcdStorageType is a storage type for an etcd cluster.
@@ -1819,6 +2140,22 @@ type SecretEncryptionSpec struct {
 	// AESCBC defines metadata about the AESCBC secret encryption strategy
 	// +optional
 	AESCBC *AESCBCSpec `json:"aescbc,omitempty"`
+
+	// KeyRotation configures automated rotation of the active encryption key.
+	// It is only supported with the aescbc encryption type and only for keys
+	// managed by hypershift.
+	// +optional
+	KeyRotation *EncryptionKeyRotationSpec `json:"keyRotation,omitempty"`
+}
+
+// EncryptionKeyRotationSpec configures automated rotation of the secret
+// encryption key.
+type EncryptionKeyRotationSpec struct {
+	// Interval defines how often a new encryption key is generated. When the
+	// interval elapses, a new key becomes the active key and the previous
+	// active key is kept as the backup key until all of the guest cluster's
+	// secrets have been re-encrypted, after which it is retired.
+	Interval metav1.Duration `json:"interval"`
 }
 
 // KMSProvider defines the supported KMS providers
@@ -2051,6 +2388,28 @@ type HostedClusterStatus struct {
 	// Platform contains platform-specific status of the HostedCluster
 	// +optional
 	Platform *PlatformStatus `json:"platform,omitempty"`
+
+	// SecretEncryptionKeyRotations records the most recent rotations of the
+	// secret encryption key, most recent last. At most the last 10 rotations
+	// are kept.
+	// +optional
+	SecretEncryptionKeyRotations []EncryptionKeyRotationStatus `json:"secretEncryptionKeyRotations,omitempty"`
+}
+
+// EncryptionKeyRotationStatus records a single rotation of the secret
+// encryption key.
+type EncryptionKeyRotationStatus struct {
+	// RotationTime is the time at which the rotation happened.
+	RotationTime metav1.Time `json:"rotationTime"`
+
+	// ActiveKeyName is the name of the secret holding the key which became
+	// the active key.
+	ActiveKeyName string `json:"activeKeyName"`
+
+	// RetiredKeyName is the name of the secret holding the key which was
+	// replaced as the active key.
+	// +optional
+	RetiredKeyName string `json:"retiredKeyName,omitempty"`
 }
 
 // PlatformStatus contains platform-specific status
@@ -2172,9 +2531,255 @@ type ClusterConfiguration struct {
 	// +optional
 	Scheduler *configv1.SchedulerSpec `json:"scheduler,omitempty"`
 
+	// PodSecurityAdmission configures the cluster-wide PodSecurity admission
+	// defaults and exemptions applied by the kube-apiserver to namespaces that
+	// do not set their own pod security labels. When not set, all levels
+	// default to restricted.
+	// +optional
+	PodSecurityAdmission *PodSecurityAdmissionSpec `json:"podSecurityAdmission,omitempty"`
+
 	// Proxy holds cluster-wide information on how to configure default proxies for the cluster.
 	// +optional
 	Proxy *configv1.ProxySpec `json:"proxy,omitempty"`
+
+	// OperatorHub configures the guest cluster's default OperatorHub catalog
+	// sources. Disconnected clusters typically disable all the default public
+	// sources and add mirrored catalog sources instead.
+	// +optional
+	OperatorHub *configv1.OperatorHubSpec `json:"operatorHub,omitempty"`
+
+	// Metrics configures how the control plane components' metrics are
+	// exposed to the management cluster monitoring stack.
+	// +optional
+	Metrics *MetricsSpec `json:"metrics,omitempty"`
+
+	// APIServerTuning tunes request handling flags of the hosted
+	// kube-apiserver, e.g. to shed HTTP/2 reconnect storms across replicas.
+	// +optional
+	APIServerTuning *APIServerTuningSpec `json:"apiServerTuning,omitempty"`
+
+	// IngressDefaultCertificate references a kubernetes.io/tls secret in the
+	// HostedCluster namespace containing the serving certificate for the default
+	// IngressController of the guest cluster. The certificate is expected to
+	// cover the *.apps wildcard domain of the cluster. When not set, a
+	// self-signed certificate is generated.
+	// +optional
+	IngressDefaultCertificate *corev1.LocalObjectReference `json:"ingressDefaultCertificate,omitempty"`
+
+	// GuestRBACManifests references a ConfigMap in the HostedCluster namespace
+	// whose entries are ClusterRole and ClusterRoleBinding manifests applied to
+	// the guest cluster during its RBAC bootstrap, in addition to the built in
+	// policy. Entries of any other kind are rejected.
+	// +optional
+	GuestRBACManifests *corev1.LocalObjectReference `json:"guestRBACManifests,omitempty"`
+
+	// Console configures customization of the guest cluster web console, such
+	// as custom links and banner notifications.
+	// +optional
+	Console *ConsoleCustomizationSpec `json:"console,omitempty"`
+
+	// SystemComponentsPlacement configures scheduling of the guest cluster's own
+	// system components such as the default ingress controller, the monitoring
+	// stack and the image registry. The node selector and tolerations are
+	// applied to the relevant guest operator configurations, e.g. to dedicate
+	// infra nodes to system components.
+	// +optional
+	SystemComponentsPlacement *SystemComponentsPlacement `json:"systemComponentsPlacement,omitempty"`
+
+	// GuestDefaultLimits, when set, installs a default LimitRange into newly
+	// requested guest cluster projects through the project request template.
+	// Keys are container resource names such as cpu or memory and values are
+	// the default limits applied to containers which do not declare their own.
+	// +optional
+	GuestDefaultLimits corev1.ResourceList `json:"guestDefaultLimits,omitempty"`
+
+	// GuestDefaultDenyNetworkPolicy, when true, installs a default-deny
+	// ingress NetworkPolicy into every guest cluster namespace outside of the
+	// system namespaces (default, openshift and namespaces prefixed with
+	// openshift- or kube-), so workloads only receive traffic which another
+	// NetworkPolicy in their namespace explicitly allows.
+	// +optional
+	GuestDefaultDenyNetworkPolicy bool `json:"guestDefaultDenyNetworkPolicy,omitempty"`
+}
+
+// APIServerTuningSpec tunes how the hosted kube-apiserver handles request
+// load. Unset fields keep the HyperShift defaults.
+type APIServerTuningSpec struct {
+	// GoawayChance is the probability, expressed as a decimal fraction
+	// between 0 and 0.02 (e.g. "0.001"), that the apiserver sends a GOAWAY
+	// frame to an HTTP/2 client so long-lived connections re-balance across
+	// replicas after a reconnect storm.
+	//
+	// +kubebuilder:validation:Pattern=`^0(\.[0-9]+)?$`
+	// +optional
+	GoawayChance string `json:"goawayChance,omitempty"`
+
+	// MaxRequestsInflight limits the number of concurrently served
+	// non-mutating requests. Defaults to 3000.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxRequestsInflight *int32 `json:"maxRequestsInflight,omitempty"`
+
+	// MaxMutatingRequestsInflight limits the number of concurrently served
+	// mutating requests. Defaults to 1000.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxMutatingRequestsInflight *int32 `json:"maxMutatingRequestsInflight,omitempty"`
+
+	// DefaultWatchCacheSize is the watch cache size used for resources without
+	// an entry in WatchCacheSizes. 0 disables the watch cache for those
+	// resources. When unset the apiserver sizes the cache heuristically.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DefaultWatchCacheSize *int32 `json:"defaultWatchCacheSize,omitempty"`
+
+	// WatchCacheSizes overrides the watch cache size for individual resources.
+	// Entries have the form resource[.group]#size, e.g. "secrets#500" or
+	// "deployments.apps#1000", with size a non-negative integer. At most 20
+	// entries may be set.
+	//
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	WatchCacheSizes []string `json:"watchCacheSizes,omitempty"`
+}
+
+// MetricsSpec configures metrics scraping of the control plane components.
+type MetricsSpec struct {
+	// RelabelConfigs are metric relabeling rules appended to the scrape
+	// endpoints of every control plane component ServiceMonitor, after the
+	// rules of the selected metrics set. They can drop high-cardinality
+	// series or attach tenant labels fleet-wide.
+	// +optional
+	RelabelConfigs []MetricsRelabelConfig `json:"relabelConfigs,omitempty"`
+}
+
+// MetricsRelabelConfig is a Prometheus metric relabeling rule. It mirrors the
+// relevant fields of the prometheus-operator RelabelConfig type.
+type MetricsRelabelConfig struct {
+	// SourceLabels are the labels whose values are concatenated with
+	// Separator and matched against Regex.
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+
+	// Separator is the string between concatenated source label values.
+	// Defaults to ";".
+	// +optional
+	Separator string `json:"separator,omitempty"`
+
+	// Regex is the regular expression the concatenated source label values
+	// are matched against. Defaults to "(.*)".
+	// +optional
+	Regex string `json:"regex,omitempty"`
+
+	// TargetLabel is the label written to by the replace action.
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+
+	// Replacement is the value written to the target label by the replace
+	// action. Defaults to "$1".
+	// +optional
+	Replacement string `json:"replacement,omitempty"`
+
+	// Action is the relabeling action. Defaults to replace.
+	// +kubebuilder:validation:Enum=replace;keep;drop;labelmap;labeldrop;labelkeep
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// PodSecurityAdmissionLevel is a PodSecurity admission level as defined by the
+// Pod Security Standards.
+//
+// +kubebuilder:validation:Enum=privileged;baseline;restricted
+type PodSecurityAdmissionLevel string
+
+const (
+	// PodSecurityAdmissionLevelPrivileged applies no restrictions.
+	PodSecurityAdmissionLevelPrivileged PodSecurityAdmissionLevel = "privileged"
+	// PodSecurityAdmissionLevelBaseline prevents known privilege escalations.
+	PodSecurityAdmissionLevelBaseline PodSecurityAdmissionLevel = "baseline"
+	// PodSecurityAdmissionLevelRestricted enforces the pod hardening best
+	// practices.
+	PodSecurityAdmissionLevelRestricted PodSecurityAdmissionLevel = "restricted"
+)
+
+// PodSecurityAdmissionSpec configures the PodSecurity admission defaults the
+// kube-apiserver applies to namespaces without explicit pod security labels.
+type PodSecurityAdmissionSpec struct {
+	// Enforce is the level whose violations cause pods to be rejected.
+	// Defaults to restricted.
+	// +optional
+	Enforce PodSecurityAdmissionLevel `json:"enforce,omitempty"`
+
+	// Audit is the level whose violations are recorded in the audit log.
+	// Defaults to restricted.
+	// +optional
+	Audit PodSecurityAdmissionLevel `json:"audit,omitempty"`
+
+	// Warn is the level whose violations trigger user-facing warnings.
+	// Defaults to restricted.
+	// +optional
+	Warn PodSecurityAdmissionLevel `json:"warn,omitempty"`
+
+	// ExemptUsernames are usernames excluded from PodSecurity admission.
+	// +optional
+	ExemptUsernames []string `json:"exemptUsernames,omitempty"`
+
+	// ExemptNamespaces are namespaces excluded from PodSecurity admission.
+	// +optional
+	ExemptNamespaces []string `json:"exemptNamespaces,omitempty"`
+
+	// ExemptRuntimeClasses are runtime classes excluded from PodSecurity
+	// admission.
+	// +optional
+	ExemptRuntimeClasses []string `json:"exemptRuntimeClasses,omitempty"`
+}
+
+// ConsoleCustomizationSpec configures customization of the guest cluster web
+// console beyond the console operator defaults.
+type ConsoleCustomizationSpec struct {
+	// Links are custom links surfaced in the guest cluster web console. Each
+	// entry results in a ConsoleLink resource named after the entry.
+	// +optional
+	Links []ConsoleLink `json:"links,omitempty"`
+
+	// Notifications are banner notifications shown in the guest cluster web
+	// console. Each entry results in a ConsoleNotification resource named
+	// after the entry.
+	// +optional
+	Notifications []ConsoleNotification `json:"notifications,omitempty"`
+}
+
+// ConsoleLink describes a single custom console link.
+type ConsoleLink struct {
+	// Name is the name of the ConsoleLink resource created in the guest
+	// cluster.
+	Name string `json:"name"`
+
+	consolev1.ConsoleLinkSpec `json:",inline"`
+}
+
+// ConsoleNotification describes a single console banner notification.
+type ConsoleNotification struct {
+	// Name is the name of the ConsoleNotification resource created in the
+	// guest cluster.
+	Name string `json:"name"`
+
+	consolev1.ConsoleNotificationSpec `json:",inline"`
+}
+
+// SystemComponentsPlacement specifies node scheduling constraints for the
+// guest cluster's system components.
+type SystemComponentsPlacement struct {
+	// NodeSelector is the node selector applied to guest system components.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are the tolerations applied to guest system components.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 }
 
 // +genclient
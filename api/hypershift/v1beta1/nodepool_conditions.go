@@ -19,6 +19,14 @@ const (
 	// A failure here is unlikely to resolve without the changing user input.
 	NodePoolValidTuningConfigConditionType = "ValidTuningConfig"
 
+	// NodePoolValidManagedNodeLabelsConditionType signals if the nodePool.spec.managedNodeLabels input is valid.
+	// A failure here is unlikely to resolve without the changing user input.
+	NodePoolValidManagedNodeLabelsConditionType = "ValidManagedNodeLabels"
+
+	// NodePoolValidDeviceReadinessConditionType signals if the nodePool.spec.deviceReadiness input is valid.
+	// A failure here is unlikely to resolve without the changing user input.
+	NodePoolValidDeviceReadinessConditionType = "ValidDeviceReadiness"
+
 	// NodePoolUpdateManagementEnabledConditionType signals if the nodePool.spec.management input is valid.
 	// A failure here is unlikely to resolve without the changing user input.
 	NodePoolUpdateManagementEnabledConditionType = "UpdateManagementEnabled"
@@ -33,6 +41,16 @@ const (
 	NodePoolUpdatingVersionConditionType = "UpdatingVersion"
 	// NodePoolUpdatingConfigConditionType signals if a config update is currently happening in NodePool.
 	NodePoolUpdatingConfigConditionType = "UpdatingConfig"
+	// NodePoolMachineConfigUpdatesPausedConditionType signals if machine config updates are
+	// currently paused for the NodePool via the pause-machineconfig-updates annotation.
+	NodePoolMachineConfigUpdatesPausedConditionType = "MachineConfigUpdatesPaused"
+	// NodePoolRolloutDeferredConditionType signals if a pending config or version
+	// rollout is waiting for another NodePool of the cluster to finish updating
+	// because the HostedCluster serializes NodePool rollouts.
+	NodePoolRolloutDeferredConditionType = "RolloutDeferred"
+	// NodePoolScalingThrottledConditionType signals if a scale up of the NodePool is
+	// being throttled by the operator-wide machine scale up rate limit.
+	NodePoolScalingThrottledConditionType = "ScalingThrottled"
 	// NodePoolUpdatingPlatformMachineTemplateConditionType signals if a platform machine template update is currently happening in NodePool.
 	NodePoolUpdatingPlatformMachineTemplateConditionType = "UpdatingPlatformMachineTemplate"
 	// NodePoolReadyConditionType bubbles up CAPI MachineDeployment/MachineSet Ready condition.
@@ -69,6 +87,7 @@ const (
 // Reasons
 const (
 	NodePoolValidationFailedReason        = "ValidationFailed"
+	NodePoolScaleUpThrottledReason        = "ScaleUpThrottled"
 	NodePoolInplaceUpgradeFailedReason    = "InplaceUpgradeFailed"
 	NodePoolNotFoundReason                = "NotFound"
 	NodePoolFailedToGetReason             = "FailedToGet"
@@ -24,6 +24,34 @@ const (
 	// IgnitionServerTokenExpirationTimestampAnnotation holds the time that a ignition token expires and should be
 	// removed from the cluster.
 	IgnitionServerTokenExpirationTimestampAnnotation = "hypershift.openshift.io/ignition-token-expiration-timestamp"
+
+	// NodePoolPauseMachineConfigUpdatesAnnotation when set to "true" on a NodePool pauses
+	// the rollout of machine config changes to existing Nodes. The current config keeps
+	// being served and pending changes are applied once the annotation is removed, which
+	// allows batching disruptive node reboots on large clusters.
+	NodePoolPauseMachineConfigUpdatesAnnotation = "hypershift.openshift.io/pause-machineconfig-updates"
+
+	// NodePoolPauseMachineImageUpdatesAnnotation when set to "true" on a NodePool keeps
+	// the machine boot image the Nodes last rolled out with instead of picking up the
+	// image of a new release on control plane upgrades, so node replacements happen only
+	// when explicitly scheduled. The pinned image is still validated against the minimum
+	// supported version and updates resume once the annotation is removed.
+	NodePoolPauseMachineImageUpdatesAnnotation = "hypershift.openshift.io/pause-machineimage-updates"
+
+	// MachineHealthCheckTimeoutAnnotation on a NodePool overrides how long a Node may
+	// report NotReady or Unknown before its machine health check remediates it, e.g.
+	// "10m". Defaults to 8m.
+	MachineHealthCheckTimeoutAnnotation = "hypershift.openshift.io/machine-health-check-timeout"
+
+	// MachineHealthCheckNodeStartupTimeoutAnnotation on a NodePool overrides how long
+	// the machine health check waits for a new machine to join the cluster before it
+	// is considered failed, e.g. "30m". Defaults to 20m.
+	MachineHealthCheckNodeStartupTimeoutAnnotation = "hypershift.openshift.io/machine-health-check-node-startup-timeout"
+
+	// MachineHealthCheckMaxUnhealthyAnnotation on a NodePool overrides the number or
+	// percentage of unhealthy machines at which the machine health check stops
+	// remediating, e.g. "3" or "40%". Defaults to 2.
+	MachineHealthCheckMaxUnhealthyAnnotation = "hypershift.openshift.io/machine-health-check-max-unhealthy"
 )
 
 var (
@@ -139,10 +167,24 @@ type NodePoolSpec struct {
 	// +optional
 	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
 
+	// ManagedNodeLabels propagates a list of labels to Nodes that are applied
+	// and continuously reconciled by the control plane rather than the kubelet.
+	// This allows restricted labels such as those in the node-role.kubernetes.io
+	// namespace, which nodes are not allowed to self-assign. Other labels in the
+	// kubernetes.io and k8s.io namespaces are rejected.
+	// +optional
+	ManagedNodeLabels map[string]string `json:"managedNodeLabels,omitempty"`
+
 	// Taints if specified, propagates a list of taints to Nodes, only once on creation.
 	// +optional
 	Taints []Taint `json:"taints,omitempty"`
 
+	// StartupTaints if specified, propagates a list of taints to Nodes on creation that
+	// are automatically removed once the Node reports Ready. This keeps pods off a node
+	// until node-level agents (e.g. CNI or device drivers) signal readiness.
+	// +optional
+	StartupTaints []Taint `json:"startupTaints,omitempty"`
+
 	// PausedUntil is a field that can be used to pause reconciliation on a resource.
 	// Either a date can be provided in RFC3339 format or a boolean. If a date is
 	// provided: reconciliation is paused on the resource until that date. If the boolean true is
@@ -161,6 +203,33 @@ type NodePoolSpec struct {
 	// +kubebuilder:validation:Optional
 	TuningConfig []corev1.LocalObjectReference `json:"tuningConfig,omitempty"`
 
+	// HugePages reserves hugepages on the NodePool's nodes at boot. The
+	// reservation is rendered into kernel arguments through a generated
+	// MachineConfig; the kubelet discovers the reserved pages once the nodes
+	// boot with them. Changing this field triggers a rolling update of the
+	// NodePool.
+	//
+	// +optional
+	HugePages []HugePageReservation `json:"hugePages,omitempty"`
+
+	// KernelArguments are additional kernel arguments set on the NodePool's
+	// nodes at boot, e.g. intel_iommu=on or isolcpus=2-5. They are rendered
+	// into a generated MachineConfig; changing this field triggers a rolling
+	// update of the NodePool so the nodes reboot with the new arguments.
+	// Arguments which change the boot or init flow, such as init= or
+	// rd.break, are rejected.
+	//
+	// +optional
+	KernelArguments []string `json:"kernelArguments,omitempty"`
+
+	// PIDLimits raises process ID limits on the NodePool's nodes for high pod
+	// density. The limits are applied through generated KubeletConfig and
+	// ContainerRuntimeConfig resources; changing them triggers a rolling
+	// update of the NodePool.
+	//
+	// +optional
+	PIDLimits *PIDLimitsSpec `json:"pidLimits,omitempty"`
+
 	// Arch is the preferred processor architecture for the NodePool (currently only supported on AWS)
 	// NOTE: This is set as optional to prevent validation from failing due to a limitation on client side validation with open API machinery:
 	//	https://github.com/kubernetes/kubernetes/issues/108768#issuecomment-1253912215
@@ -171,6 +240,78 @@ type NodePoolSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Arch is immutable"
 	// +optional
 	Arch string `json:"arch,omitempty"`
+
+	// UserDataSecret is a reference to a Secret in the NodePool's namespace
+	// whose content is used as the machine user data instead of the generated
+	// ignition pointer config. The Secret must have a "value" key holding the
+	// user data. This allows bare-metal flows with non-standard provisioning
+	// pipelines, e.g. PXE, to inject site-specific provisioning data.
+	//
+	// +optional
+	UserDataSecret *corev1.LocalObjectReference `json:"userDataSecret,omitempty"`
+
+	// DeviceReadiness, when set, reports in status how many of the pool's
+	// nodes advertise allocatable capacity for the given extended resource,
+	// e.g. a GPU exposed by a device plugin. Nodes become Ready before device
+	// plugins advertise their capacity, so this provides a pool-level signal
+	// to gate rollout of device-dependent workloads.
+	//
+	// +optional
+	DeviceReadiness *DeviceReadinessSpec `json:"deviceReadiness,omitempty"`
+}
+
+// DeviceReadinessSpec configures pool-level readiness reporting for an
+// extended resource advertised by a device plugin.
+type DeviceReadinessSpec struct {
+	// ResourceName is the extended resource name to look up in node
+	// allocatable, e.g. nvidia.com/gpu.
+	//
+	// +kubebuilder:validation:MinLength=1
+	ResourceName string `json:"resourceName"`
+}
+
+// PIDLimitsSpec configures process ID limits on the nodes of a NodePool.
+type PIDLimitsSpec struct {
+	// PodPIDsLimit is the maximum number of process IDs any single pod may
+	// use, applied through a generated KubeletConfig.
+	//
+	// +kubebuilder:validation:Minimum=1024
+	// +optional
+	PodPIDsLimit *int64 `json:"podPIDsLimit,omitempty"`
+
+	// ContainerPIDsLimit is the maximum number of process IDs any single
+	// container may use, applied through a generated ContainerRuntimeConfig.
+	// It must not exceed PodPIDsLimit when both are set.
+	//
+	// +kubebuilder:validation:Minimum=1024
+	// +optional
+	ContainerPIDsLimit *int64 `json:"containerPIDsLimit,omitempty"`
+}
+
+// HugePageSize is a hugepage size supported by the generated kernel
+// arguments.
+type HugePageSize string
+
+const (
+	// HugePageSize2M reserves 2MiB hugepages.
+	HugePageSize2M HugePageSize = "2M"
+
+	// HugePageSize1G reserves 1GiB hugepages.
+	HugePageSize1G HugePageSize = "1G"
+)
+
+// HugePageReservation reserves a number of hugepages of a given size on each
+// node of a NodePool.
+type HugePageReservation struct {
+	// Size is the hugepage size to reserve.
+	//
+	// +kubebuilder:validation:Enum="2M";"1G"
+	Size HugePageSize `json:"size"`
+
+	// Count is the number of hugepages of Size reserved on each node.
+	//
+	// +kubebuilder:validation:Minimum=1
+	Count int64 `json:"count"`
 }
 
 // NodePoolStatus is the latest observed status of a NodePool.
@@ -193,6 +334,62 @@ type NodePoolStatus struct {
 	// current state.
 	// +optional
 	Conditions []NodePoolCondition `json:"conditions,omitempty"`
+
+	// NodeHealth aggregates the conditions of the guest cluster Nodes backing
+	// the pool, so fleet tooling can consume per-pool node health without
+	// querying every guest cluster node directly.
+	//
+	// +optional
+	NodeHealth *NodePoolNodeHealthStatus `json:"nodeHealth,omitempty"`
+
+	// DeviceReadiness reports how many of the pool's nodes advertise
+	// allocatable capacity for the resource configured in
+	// spec.deviceReadiness. Only populated when spec.deviceReadiness is set.
+	//
+	// +optional
+	DeviceReadiness *NodePoolDeviceReadinessStatus `json:"deviceReadiness,omitempty"`
+}
+
+// NodePoolDeviceReadinessStatus reports how many of a pool's nodes advertise
+// allocatable capacity for the configured device resource.
+type NodePoolDeviceReadinessStatus struct {
+	// Total is the number of Nodes observed for the pool.
+	Total int32 `json:"total"`
+
+	// Advertising is the number of Nodes with nonzero allocatable capacity
+	// for the configured resource.
+	//
+	// +optional
+	Advertising int32 `json:"advertising,omitempty"`
+}
+
+// NodePoolNodeHealthStatus summarizes the health of the guest cluster Nodes
+// backing a NodePool. It is computed from a single labelled List of Nodes, so
+// the query cost is bounded regardless of the pool size.
+type NodePoolNodeHealthStatus struct {
+	// Total is the number of Nodes observed for the pool.
+	Total int32 `json:"total"`
+
+	// NotReady is the number of Nodes whose Ready condition is not True.
+	//
+	// +optional
+	NotReady int32 `json:"notReady,omitempty"`
+
+	// Cordoned is the number of Nodes marked unschedulable.
+	//
+	// +optional
+	Cordoned int32 `json:"cordoned,omitempty"`
+
+	// DiskPressure is the number of Nodes reporting the DiskPressure condition.
+	//
+	// +optional
+	DiskPressure int32 `json:"diskPressure,omitempty"`
+
+	// Reasons are the distinct reasons reported by unhealthy Node conditions,
+	// sorted lexicographically.
+	//
+	// +optional
+	Reasons []string `json:"reasons,omitempty"`
 }
 
 // NodePoolList contains a list of NodePools.
@@ -359,6 +556,15 @@ type NodePoolManagement struct {
 	//
 	// +optional
 	AutoRepair bool `json:"autoRepair"`
+
+	// MaxNodeLifetime is the maximum age a node may reach before it is
+	// automatically recycled. When set, machines older than the threshold are
+	// replaced through a rolling update paced by the replace strategy's
+	// maxUnavailable/maxSurge. Only supported with the Replace upgrade type.
+	// The minimum supported lifetime is 24 hours.
+	//
+	// +optional
+	MaxNodeLifetime *metav1.Duration `json:"maxNodeLifetime,omitempty"`
 }
 
 // NodePoolAutoScaling specifies auto-scaling behavior for a NodePool.
@@ -774,6 +980,52 @@ type AWSNodePoolPlatform struct {
 	// +kubebuilder:validation:MaxItems=25
 	// +optional
 	ResourceTags []AWSResourceTag `json:"resourceTags,omitempty"`
+
+	// PlacementGroup is the name of an EC2 placement group to launch node
+	// instances into. The placement group must exist in the account and
+	// region of the cluster.
+	//
+	// +optional
+	PlacementGroup string `json:"placementGroup,omitempty"`
+
+	// Tenancy indicates the EC2 placement tenancy of node instances. "default"
+	// runs instances on shared hardware, "dedicated" runs them on single-tenant
+	// hardware and "host" places them on dedicated hosts. If unset, the AWS
+	// default tenancy applies.
+	//
+	// +kubebuilder:validation:Enum=default;dedicated;host
+	// +optional
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// DedicatedHostID is the ID of an EC2 dedicated host (h-...) expected to
+	// serve the node instances. Only valid with host tenancy. The host must
+	// exist in the account and region of the cluster, have auto-placement
+	// enabled and match the instance type of the NodePool.
+	//
+	// +optional
+	DedicatedHostID string `json:"dedicatedHostID,omitempty"`
+
+	// DataVolumes specifies additional EBS volumes attached to each machine
+	// in the pool, separate from the OS root volume. Each volume is formatted
+	// and mounted at its MountPath via ignition on first boot, so worker-local
+	// datastores can run on dedicated provisioned-IOPS disks.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	DataVolumes []AWSDataVolume `json:"dataVolumes,omitempty"`
+}
+
+// AWSDataVolume describes an additional EBS volume attached to the machines
+// of a NodePool and mounted into the node filesystem.
+type AWSDataVolume struct {
+	// Volume specifies the size, type and IOPS of the data volume.
+	Volume `json:",inline"`
+
+	// MountPath is the absolute path at which the volume is formatted as xfs
+	// and mounted on the node.
+	//
+	// +kubebuilder:validation:Pattern=`^/.+`
+	MountPath string `json:"mountPath"`
 }
 
 // AWSResourceReference is a reference to a specific AWS resource by ID or filters.
@@ -95,6 +95,41 @@ func (in *APIServerNetworking) DeepCopy() *APIServerNetworking {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerTuningSpec) DeepCopyInto(out *APIServerTuningSpec) {
+	*out = *in
+	if in.MaxRequestsInflight != nil {
+		in, out := &in.MaxRequestsInflight, &out.MaxRequestsInflight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxMutatingRequestsInflight != nil {
+		in, out := &in.MaxMutatingRequestsInflight, &out.MaxMutatingRequestsInflight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DefaultWatchCacheSize != nil {
+		in, out := &in.DefaultWatchCacheSize, &out.DefaultWatchCacheSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WatchCacheSizes != nil {
+		in, out := &in.WatchCacheSizes, &out.WatchCacheSizes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerTuningSpec.
+func (in *APIServerTuningSpec) DeepCopy() *APIServerTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSCloudProviderConfig) DeepCopyInto(out *AWSCloudProviderConfig) {
 	*out = *in
@@ -115,6 +150,22 @@ func (in *AWSCloudProviderConfig) DeepCopy() *AWSCloudProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDataVolume) DeepCopyInto(out *AWSDataVolume) {
+	*out = *in
+	in.Volume.DeepCopyInto(&out.Volume)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDataVolume.
+func (in *AWSDataVolume) DeepCopy() *AWSDataVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDataVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSEndpointService) DeepCopyInto(out *AWSEndpointService) {
 	*out = *in
@@ -278,6 +329,26 @@ func (in *AWSKMSSpec) DeepCopy() *AWSKMSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLoadBalancerSpec) DeepCopyInto(out *AWSLoadBalancerSpec) {
+	*out = *in
+	if in.CrossZoneLoadBalancing != nil {
+		in, out := &in.CrossZoneLoadBalancing, &out.CrossZoneLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerSpec.
+func (in *AWSLoadBalancerSpec) DeepCopy() *AWSLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSNodePoolPlatform) DeepCopyInto(out *AWSNodePoolPlatform) {
 	*out = *in
@@ -303,6 +374,13 @@ func (in *AWSNodePoolPlatform) DeepCopyInto(out *AWSNodePoolPlatform) {
 		*out = make([]AWSResourceTag, len(*in))
 		copy(*out, *in)
 	}
+	if in.DataVolumes != nil {
+		in, out := &in.DataVolumes, &out.DataVolumes
+		*out = make([]AWSDataVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSNodePoolPlatform.
@@ -339,6 +417,16 @@ func (in *AWSPlatformSpec) DeepCopyInto(out *AWSPlatformSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(AWSLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedVPC != nil {
+		in, out := &in.SharedVPC, &out.SharedVPC
+		*out = new(AWSSharedVPC)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPlatformSpec.
@@ -453,6 +541,21 @@ func (in *AWSServiceEndpoint) DeepCopy() *AWSServiceEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSharedVPC) DeepCopyInto(out *AWSSharedVPC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSharedVPC.
+func (in *AWSSharedVPC) DeepCopy() *AWSSharedVPC {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSharedVPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentNodePoolPlatform) DeepCopyInto(out *AgentNodePoolPlatform) {
 	*out = *in
@@ -488,6 +591,31 @@ func (in *AgentPlatformSpec) DeepCopy() *AgentPlatformSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBatchingSpec) DeepCopyInto(out *AuditWebhookBatchingSpec) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxWait != nil {
+		in, out := &in.MaxWait, &out.MaxWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBatchingSpec.
+func (in *AuditWebhookBatchingSpec) DeepCopy() *AuditWebhookBatchingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBatchingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureKMSSpec) DeepCopyInto(out *AzureKMSSpec) {
 	*out = *in
@@ -607,11 +735,58 @@ func (in *ClusterConfiguration) DeepCopyInto(out *ClusterConfiguration) {
 		*out = new(configv1.SchedulerSpec)
 		**out = **in
 	}
+	if in.PodSecurityAdmission != nil {
+		in, out := &in.PodSecurityAdmission, &out.PodSecurityAdmission
+		*out = new(PodSecurityAdmissionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Proxy != nil {
 		in, out := &in.Proxy, &out.Proxy
 		*out = new(configv1.ProxySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OperatorHub != nil {
+		in, out := &in.OperatorHub, &out.OperatorHub
+		*out = new(configv1.OperatorHubSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServerTuning != nil {
+		in, out := &in.APIServerTuning, &out.APIServerTuning
+		*out = new(APIServerTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IngressDefaultCertificate != nil {
+		in, out := &in.IngressDefaultCertificate, &out.IngressDefaultCertificate
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.GuestRBACManifests != nil {
+		in, out := &in.GuestRBACManifests, &out.GuestRBACManifests
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(ConsoleCustomizationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemComponentsPlacement != nil {
+		in, out := &in.SystemComponentsPlacement, &out.SystemComponentsPlacement
+		*out = new(SystemComponentsPlacement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestDefaultLimits != nil {
+		in, out := &in.GuestDefaultLimits, &out.GuestDefaultLimits
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfiguration.
@@ -669,6 +844,11 @@ func (in *ClusterNetworking) DeepCopyInto(out *ClusterNetworking) {
 		*out = new(APIServerNetworking)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PolicyAuditConfig != nil {
+		in, out := &in.PolicyAuditConfig, &out.PolicyAuditConfig
+		*out = new(NetworkPolicyAuditConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworking.
@@ -718,6 +898,67 @@ func (in *ClusterVersionStatus) DeepCopy() *ClusterVersionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleCustomizationSpec) DeepCopyInto(out *ConsoleCustomizationSpec) {
+	*out = *in
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]ConsoleLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]ConsoleNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleCustomizationSpec.
+func (in *ConsoleCustomizationSpec) DeepCopy() *ConsoleCustomizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleCustomizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleLink) DeepCopyInto(out *ConsoleLink) {
+	*out = *in
+	in.ConsoleLinkSpec.DeepCopyInto(&out.ConsoleLinkSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleLink.
+func (in *ConsoleLink) DeepCopy() *ConsoleLink {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleNotification) DeepCopyInto(out *ConsoleNotification) {
+	*out = *in
+	in.ConsoleNotificationSpec.DeepCopyInto(&out.ConsoleNotificationSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleNotification.
+func (in *ConsoleNotification) DeepCopy() *ConsoleNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSSpec) DeepCopyInto(out *DNSSpec) {
 	*out = *in
@@ -738,6 +979,68 @@ func (in *DNSSpec) DeepCopy() *DNSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceReadinessSpec) DeepCopyInto(out *DeviceReadinessSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceReadinessSpec.
+func (in *DeviceReadinessSpec) DeepCopy() *DeviceReadinessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceReadinessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyRotationSpec) DeepCopyInto(out *EncryptionKeyRotationSpec) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyRotationSpec.
+func (in *EncryptionKeyRotationSpec) DeepCopy() *EncryptionKeyRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyRotationStatus) DeepCopyInto(out *EncryptionKeyRotationStatus) {
+	*out = *in
+	in.RotationTime.DeepCopyInto(&out.RotationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyRotationStatus.
+func (in *EncryptionKeyRotationStatus) DeepCopy() *EncryptionKeyRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdCompactionSpec) DeepCopyInto(out *EtcdCompactionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdCompactionSpec.
+func (in *EtcdCompactionSpec) DeepCopy() *EtcdCompactionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdCompactionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdSpec) DeepCopyInto(out *EtcdSpec) {
 	*out = *in
@@ -799,6 +1102,26 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FulcioPolicy) DeepCopyInto(out *FulcioPolicy) {
+	*out = *in
+	if in.CAData != nil {
+		in, out := &in.CAData, &out.CAData
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioPolicy.
+func (in *FulcioPolicy) DeepCopy() *FulcioPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FulcioPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostedCluster) DeepCopyInto(out *HostedCluster) {
 	*out = *in
@@ -881,6 +1204,11 @@ func (in *HostedClusterSpec) DeepCopyInto(out *HostedClusterSpec) {
 	}
 	out.PullSecret = in.PullSecret
 	out.SSHKey = in.SSHKey
+	if in.ServiceAccountAudiences != nil {
+		in, out := &in.ServiceAccountAudiences, &out.ServiceAccountAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ServiceAccountSigningKey != nil {
 		in, out := &in.ServiceAccountSigningKey, &out.ServiceAccountSigningKey
 		*out = new(corev1.LocalObjectReference)
@@ -896,6 +1224,16 @@ func (in *HostedClusterSpec) DeepCopyInto(out *HostedClusterSpec) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.AuditWebhookBatching != nil {
+		in, out := &in.AuditWebhookBatching, &out.AuditWebhookBatching
+		*out = new(AuditWebhookBatchingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudProviderConfig != nil {
+		in, out := &in.CloudProviderConfig, &out.CloudProviderConfig
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 	if in.ImageContentSources != nil {
 		in, out := &in.ImageContentSources, &out.ImageContentSources
 		*out = make([]ImageContentSource, len(*in))
@@ -903,6 +1241,13 @@ func (in *HostedClusterSpec) DeepCopyInto(out *HostedClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ImageSignaturePolicies != nil {
+		in, out := &in.ImageSignaturePolicies, &out.ImageSignaturePolicies
+		*out = make([]ImageSignaturePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AdditionalTrustBundle != nil {
 		in, out := &in.AdditionalTrustBundle, &out.AdditionalTrustBundle
 		*out = new(corev1.LocalObjectReference)
@@ -968,6 +1313,13 @@ func (in *HostedClusterStatus) DeepCopyInto(out *HostedClusterStatus) {
 		*out = new(PlatformStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretEncryptionKeyRotations != nil {
+		in, out := &in.SecretEncryptionKeyRotations, &out.SecretEncryptionKeyRotations
+		*out = make([]EncryptionKeyRotationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedClusterStatus.
@@ -1048,6 +1400,11 @@ func (in *HostedControlPlaneSpec) DeepCopyInto(out *HostedControlPlaneSpec) {
 		**out = **in
 	}
 	out.PullSecret = in.PullSecret
+	if in.ServiceAccountAudiences != nil {
+		in, out := &in.ServiceAccountAudiences, &out.ServiceAccountAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	in.Networking.DeepCopyInto(&out.Networking)
 	out.SSHKey = in.SSHKey
 	in.Platform.DeepCopyInto(&out.Platform)
@@ -1074,6 +1431,16 @@ func (in *HostedControlPlaneSpec) DeepCopyInto(out *HostedControlPlaneSpec) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.AuditWebhookBatching != nil {
+		in, out := &in.AuditWebhookBatching, &out.AuditWebhookBatching
+		*out = new(AuditWebhookBatchingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudProviderConfig != nil {
+		in, out := &in.CloudProviderConfig, &out.CloudProviderConfig
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 	in.Etcd.DeepCopyInto(&out.Etcd)
 	if in.Configuration != nil {
 		in, out := &in.Configuration, &out.Configuration
@@ -1087,6 +1454,13 @@ func (in *HostedControlPlaneSpec) DeepCopyInto(out *HostedControlPlaneSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ImageSignaturePolicies != nil {
+		in, out := &in.ImageSignaturePolicies, &out.ImageSignaturePolicies
+		*out = make([]ImageSignaturePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AdditionalTrustBundle != nil {
 		in, out := &in.AdditionalTrustBundle, &out.AdditionalTrustBundle
 		*out = new(corev1.LocalObjectReference)
@@ -1174,6 +1548,21 @@ func (in *HostedControlPlaneStatus) DeepCopy() *HostedControlPlaneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugePageReservation) DeepCopyInto(out *HugePageReservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugePageReservation.
+func (in *HugePageReservation) DeepCopy() *HugePageReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HugePageReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMCloudKMSAuthSpec) DeepCopyInto(out *IBMCloudKMSAuthSpec) {
 	*out = *in
@@ -1301,6 +1690,36 @@ func (in *ImageContentSource) DeepCopy() *ImageContentSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSignaturePolicy) DeepCopyInto(out *ImageSignaturePolicy) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Fulcio != nil {
+		in, out := &in.Fulcio, &out.Fulcio
+		*out = new(FulcioPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSignaturePolicy.
+func (in *ImageSignaturePolicy) DeepCopy() *ImageSignaturePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSignaturePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InPlaceUpgrade) DeepCopyInto(out *InPlaceUpgrade) {
 	*out = *in
@@ -1727,6 +2146,11 @@ func (in *MachineNetworkEntry) DeepCopy() *MachineNetworkEntry {
 func (in *ManagedEtcdSpec) DeepCopyInto(out *ManagedEtcdSpec) {
 	*out = *in
 	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Compaction != nil {
+		in, out := &in.Compaction, &out.Compaction
+		*out = new(EtcdCompactionSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedEtcdSpec.
@@ -1764,6 +2188,68 @@ func (in *ManagedEtcdStorageSpec) DeepCopy() *ManagedEtcdStorageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsRelabelConfig) DeepCopyInto(out *MetricsRelabelConfig) {
+	*out = *in
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsRelabelConfig.
+func (in *MetricsRelabelConfig) DeepCopy() *MetricsRelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsRelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsSpec) DeepCopyInto(out *MetricsSpec) {
+	*out = *in
+	if in.RelabelConfigs != nil {
+		in, out := &in.RelabelConfigs, &out.RelabelConfigs
+		*out = make([]MetricsRelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsSpec.
+func (in *MetricsSpec) DeepCopy() *MetricsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyAuditConfig) DeepCopyInto(out *NetworkPolicyAuditConfig) {
+	*out = *in
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyAuditConfig.
+func (in *NetworkPolicyAuditConfig) DeepCopy() *NetworkPolicyAuditConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyAuditConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePool) DeepCopyInto(out *NodePool) {
 	*out = *in
@@ -1822,6 +2308,21 @@ func (in *NodePoolCondition) DeepCopy() *NodePoolCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolDeviceReadinessStatus) DeepCopyInto(out *NodePoolDeviceReadinessStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolDeviceReadinessStatus.
+func (in *NodePoolDeviceReadinessStatus) DeepCopy() *NodePoolDeviceReadinessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolDeviceReadinessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePoolList) DeepCopyInto(out *NodePoolList) {
 	*out = *in
@@ -1867,6 +2368,11 @@ func (in *NodePoolManagement) DeepCopyInto(out *NodePoolManagement) {
 		*out = new(InPlaceUpgrade)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxNodeLifetime != nil {
+		in, out := &in.MaxNodeLifetime, &out.MaxNodeLifetime
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolManagement.
@@ -1879,6 +2385,26 @@ func (in *NodePoolManagement) DeepCopy() *NodePoolManagement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolNodeHealthStatus) DeepCopyInto(out *NodePoolNodeHealthStatus) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolNodeHealthStatus.
+func (in *NodePoolNodeHealthStatus) DeepCopy() *NodePoolNodeHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolNodeHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePoolPlatform) DeepCopyInto(out *NodePoolPlatform) {
 	*out = *in
@@ -1977,11 +2503,23 @@ func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ManagedNodeLabels != nil {
+		in, out := &in.ManagedNodeLabels, &out.ManagedNodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
 		*out = make([]Taint, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartupTaints != nil {
+		in, out := &in.StartupTaints, &out.StartupTaints
+		*out = make([]Taint, len(*in))
+		copy(*out, *in)
+	}
 	if in.PausedUntil != nil {
 		in, out := &in.PausedUntil, &out.PausedUntil
 		*out = new(string)
@@ -1992,6 +2530,31 @@ func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
 		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.HugePages != nil {
+		in, out := &in.HugePages, &out.HugePages
+		*out = make([]HugePageReservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.KernelArguments != nil {
+		in, out := &in.KernelArguments, &out.KernelArguments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PIDLimits != nil {
+		in, out := &in.PIDLimits, &out.PIDLimits
+		*out = new(PIDLimitsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserDataSecret != nil {
+		in, out := &in.UserDataSecret, &out.UserDataSecret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.DeviceReadiness != nil {
+		in, out := &in.DeviceReadiness, &out.DeviceReadiness
+		*out = new(DeviceReadinessSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolSpec.
@@ -2019,6 +2582,16 @@ func (in *NodePoolStatus) DeepCopyInto(out *NodePoolStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeHealth != nil {
+		in, out := &in.NodeHealth, &out.NodeHealth
+		*out = new(NodePoolNodeHealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceReadiness != nil {
+		in, out := &in.DeviceReadiness, &out.DeviceReadiness
+		*out = new(NodePoolDeviceReadinessStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolStatus.
@@ -2046,6 +2619,31 @@ func (in *NodePortPublishingStrategy) DeepCopy() *NodePortPublishingStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PIDLimitsSpec) DeepCopyInto(out *PIDLimitsSpec) {
+	*out = *in
+	if in.PodPIDsLimit != nil {
+		in, out := &in.PodPIDsLimit, &out.PodPIDsLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ContainerPIDsLimit != nil {
+		in, out := &in.ContainerPIDsLimit, &out.ContainerPIDsLimit
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIDLimitsSpec.
+func (in *PIDLimitsSpec) DeepCopy() *PIDLimitsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PIDLimitsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeEtcdStorageSpec) DeepCopyInto(out *PersistentVolumeEtcdStorageSpec) {
 	*out = *in
@@ -2136,6 +2734,36 @@ func (in *PlatformStatus) DeepCopy() *PlatformStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionSpec) DeepCopyInto(out *PodSecurityAdmissionSpec) {
+	*out = *in
+	if in.ExemptUsernames != nil {
+		in, out := &in.ExemptUsernames, &out.ExemptUsernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptNamespaces != nil {
+		in, out := &in.ExemptNamespaces, &out.ExemptNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptRuntimeClasses != nil {
+		in, out := &in.ExemptRuntimeClasses, &out.ExemptRuntimeClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionSpec.
+func (in *PodSecurityAdmissionSpec) DeepCopy() *PodSecurityAdmissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PowerVSNodePoolPlatform) DeepCopyInto(out *PowerVSNodePoolPlatform) {
 	*out = *in
@@ -2315,6 +2943,11 @@ func (in *SecretEncryptionSpec) DeepCopyInto(out *SecretEncryptionSpec) {
 		*out = new(AESCBCSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KeyRotation != nil {
+		in, out := &in.KeyRotation, &out.KeyRotation
+		*out = new(EncryptionKeyRotationSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEncryptionSpec.
@@ -2389,6 +3022,35 @@ func (in *ServicePublishingStrategyMapping) DeepCopy() *ServicePublishingStrateg
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemComponentsPlacement) DeepCopyInto(out *SystemComponentsPlacement) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemComponentsPlacement.
+func (in *SystemComponentsPlacement) DeepCopy() *SystemComponentsPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemComponentsPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Taint) DeepCopyInto(out *Taint) {
 	*out = *in
@@ -2,6 +2,7 @@ package configrefs
 
 import (
 	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -18,6 +19,8 @@ type ClusterConfiguration interface {
 	GetOAuth() *configv1.OAuthSpec
 	GetScheduler() *configv1.SchedulerSpec
 	GetProxy() *configv1.ProxySpec
+	GetIngressDefaultCertificate() *corev1.LocalObjectReference
+	GetGuestRBACManifests() *corev1.LocalObjectReference
 }
 
 func SecretRefs(cfg ClusterConfiguration) []string {
@@ -25,6 +28,7 @@ func SecretRefs(cfg ClusterConfiguration) []string {
 	result = result.Union(apiServerSecretRefs(cfg.GetAPIServer()))
 	result = result.Union(authenticationSecretRefs(cfg.GetAuthentication()))
 	result = result.Union(ingressSecretRefs(cfg.GetIngress()))
+	result = result.Union(ingressDefaultCertificateSecretRefs(cfg.GetIngressDefaultCertificate()))
 	result = result.Union(oauthSecretRefs(cfg.GetOAuth()))
 	return result.List()
 }
@@ -37,6 +41,7 @@ func ConfigMapRefs(cfg ClusterConfiguration) []string {
 	result = result.Union(oauthConfigMapRefs(cfg.GetOAuth()))
 	result = result.Union(proxyConfigMapRefs(cfg.GetProxy()))
 	result = result.Union(schedulerConfigMapRefs(cfg.GetScheduler()))
+	result = result.Union(localObjectConfigMapRefs(cfg.GetGuestRBACManifests()))
 	return result.List()
 }
 
@@ -106,6 +111,28 @@ func ingressSecretRefs(spec *configv1.IngressSpec) sets.String {
 	return refs
 }
 
+func ingressDefaultCertificateSecretRefs(ref *corev1.LocalObjectReference) sets.String {
+	refs := sets.NewString()
+	if ref == nil {
+		return refs
+	}
+	if len(ref.Name) > 0 {
+		refs.Insert(ref.Name)
+	}
+	return refs
+}
+
+func localObjectConfigMapRefs(ref *corev1.LocalObjectReference) sets.String {
+	refs := sets.NewString()
+	if ref == nil {
+		return refs
+	}
+	if len(ref.Name) > 0 {
+		refs.Insert(ref.Name)
+	}
+	return refs
+}
+
 func imageConfigMapRefs(spec *configv1.ImageSpec) sets.String {
 	refs := sets.NewString()
 	if spec == nil {
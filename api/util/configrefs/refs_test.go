@@ -8,6 +8,7 @@ import (
 
 	configv1 "github.com/openshift/api/config/v1"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestKnownConfigMapRefs(t *testing.T) {
@@ -238,6 +239,15 @@ func TestConfigMapRefs(t *testing.T) {
 			},
 			refs: []string{"caref", "policyref"},
 		},
+		{
+			name: "guest rbac manifests",
+			config: &hyperv1.ClusterConfiguration{
+				GuestRBACManifests: &corev1.LocalObjectReference{
+					Name: "rbacref",
+				},
+			},
+			refs: []string{"rbacref"},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
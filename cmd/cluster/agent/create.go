@@ -3,6 +3,7 @@ package agent
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +29,8 @@ func NewCreateCommand(opts *core.CreateOptions) *cobra.Command {
 
 	cmd.Flags().StringVar(&opts.AgentPlatform.APIServerAddress, "api-server-address", opts.AgentPlatform.APIServerAddress, "The API server address is the IP address for Kubernetes API communication")
 	cmd.Flags().StringVar(&opts.AgentPlatform.AgentNamespace, "agent-namespace", opts.AgentPlatform.AgentNamespace, "The namespace in which to search for Agents")
+	cmd.Flags().StringVar(&opts.AgentPlatform.APIVIP, "api-vip", opts.AgentPlatform.APIVIP, "The virtual IP used to reach the cluster's API server, surfaced in the guest Infrastructure platform status")
+	cmd.Flags().StringVar(&opts.AgentPlatform.IngressVIP, "ingress-vip", opts.AgentPlatform.IngressVIP, "The virtual IP used to reach the cluster's ingress, surfaced in the guest Infrastructure platform status")
 	_ = cmd.MarkFlagRequired("agent-namespace")
 	_ = cmd.MarkPersistentFlagRequired("pull-secret")
 
@@ -68,9 +71,17 @@ func ApplyPlatformSpecificsValues(ctx context.Context, exampleOptions *fixtures.
 		exampleOptions.BaseDomain = "example.com"
 	}
 
+	for flag, vip := range map[string]string{"api-vip": opts.AgentPlatform.APIVIP, "ingress-vip": opts.AgentPlatform.IngressVIP} {
+		if vip != "" && net.ParseIP(vip) == nil {
+			return fmt.Errorf("%s is not a valid IP address: %s", flag, vip)
+		}
+	}
+
 	exampleOptions.Agent = &fixtures.ExampleAgentOptions{
 		APIServerAddress: opts.AgentPlatform.APIServerAddress,
 		AgentNamespace:   opts.AgentPlatform.AgentNamespace,
+		APIVIP:           opts.AgentPlatform.APIVIP,
+		IngressVIP:       opts.AgentPlatform.IngressVIP,
 	}
 
 	// Validate that the agent namespace exists
@@ -5,11 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/sts"
 	apifixtures "github.com/openshift/hypershift/api/fixtures"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	"github.com/openshift/hypershift/cmd/cluster/core"
 	awsinfra "github.com/openshift/hypershift/cmd/infra/aws"
+	awsutil "github.com/openshift/hypershift/cmd/infra/aws/util"
 	"github.com/openshift/hypershift/cmd/util"
 	"github.com/openshift/hypershift/support/infraid"
 	"github.com/spf13/cobra"
@@ -37,17 +43,29 @@ func NewCreateCommand(opts *core.CreateOptions) *cobra.Command {
 	cmd.Flags().StringVar(&opts.AWSPlatform.Region, "region", opts.AWSPlatform.Region, "Region to use for AWS infrastructure.")
 	cmd.Flags().StringSliceVar(&opts.AWSPlatform.Zones, "zones", opts.AWSPlatform.Zones, "The availability zones in which NodePools will be created")
 	cmd.Flags().StringVar(&opts.AWSPlatform.InstanceType, "instance-type", opts.AWSPlatform.InstanceType, "Instance type for AWS instances.")
+	cmd.Flags().StringSliceVar(&opts.AWSPlatform.InstanceTypes, "node-pool-instance-types", opts.AWSPlatform.InstanceTypes, "Instance types to spread NodePools across for instance type diversification, e.g. for spot capacity. All types must share a CPU architecture and size. Repeatable. Overrides --instance-type")
 	cmd.Flags().StringVar(&opts.AWSPlatform.RootVolumeType, "root-volume-type", opts.AWSPlatform.RootVolumeType, "The type of the root volume (e.g. gp3, io2) for machines in the NodePool")
 	cmd.Flags().Int64Var(&opts.AWSPlatform.RootVolumeIOPS, "root-volume-iops", opts.AWSPlatform.RootVolumeIOPS, "The iops of the root volume when specifying type:io1 for machines in the NodePool")
 	cmd.Flags().Int64Var(&opts.AWSPlatform.RootVolumeSize, "root-volume-size", opts.AWSPlatform.RootVolumeSize, "The size of the root volume (min: 8) for machines in the NodePool")
 	cmd.Flags().StringVar(&opts.AWSPlatform.RootVolumeEncryptionKey, "root-volume-kms-key", opts.AWSPlatform.RootVolumeEncryptionKey, "The KMS key ID or ARN to use for root volume encryption for machines in the NodePool")
 	cmd.Flags().StringSliceVar(&opts.AWSPlatform.AdditionalTags, "additional-tags", opts.AWSPlatform.AdditionalTags, "Additional tags to set on AWS resources")
 	cmd.Flags().StringVar(&opts.AWSPlatform.EndpointAccess, "endpoint-access", opts.AWSPlatform.EndpointAccess, "Access for control plane endpoints (Public, PublicAndPrivate, Private)")
+	cmd.Flags().StringVar(&opts.AWSPlatform.APILoadBalancerType, "api-lb-type", opts.AWSPlatform.APILoadBalancerType, "The type of load balancer to expose the kube-apiserver with (NLB, Classic). Defaults to NLB")
+	cmd.Flags().StringVar(&opts.AWSPlatform.APILoadBalancerScheme, "api-lb-scheme", opts.AWSPlatform.APILoadBalancerScheme, "The scheme of the kube-apiserver load balancer (InternetFacing, Internal). Internal is only valid with Public endpoint access. Defaults to InternetFacing")
+	cmd.Flags().StringSliceVar(&opts.AWSPlatform.ServiceEndpoints, "service-endpoint", opts.AWSPlatform.ServiceEndpoints, "Custom AWS service endpoint overrides in name=url format, e.g. ec2=https://ec2.us-gov-east-1.amazonaws.com")
+	cmd.Flags().StringVar(&opts.AWSPlatform.Partition, "partition", opts.AWSPlatform.Partition, "The AWS partition in which the cluster resides, e.g. aws-us-gov. Defaults to the partition of the region")
+	cmd.Flags().StringVar(&opts.AWSPlatform.SharedVPCNetworkingRoleARN, "shared-vpc-networking-role-arn", opts.AWSPlatform.SharedVPCNetworkingRoleARN, "The ARN of the role in the networking account assumed to manage load balancers and Route 53 records in a shared VPC. Requires --shared-vpc-compute-role-arn")
+	cmd.Flags().StringVar(&opts.AWSPlatform.SharedVPCComputeRoleARN, "shared-vpc-compute-role-arn", opts.AWSPlatform.SharedVPCComputeRoleARN, "The ARN of the role in the compute account assumed to manage worker instances in a shared VPC. Requires --shared-vpc-networking-role-arn")
 	cmd.Flags().StringVar(&opts.AWSPlatform.EtcdKMSKeyARN, "kms-key-arn", opts.AWSPlatform.EtcdKMSKeyARN, "The ARN of the KMS key to use for Etcd encryption. If not supplied, etcd encryption will default to using a generated AESCBC key.")
 	cmd.Flags().BoolVar(&opts.AWSPlatform.EnableProxy, "enable-proxy", opts.AWSPlatform.EnableProxy, "If a proxy should be set up, rather than allowing direct internet access from the nodes")
+	cmd.Flags().StringVar(&opts.AWSPlatform.ProxyUsername, "proxy-username", opts.AWSPlatform.ProxyUsername, "The username the proxy created with --enable-proxy requires for authentication. Requires --proxy-password")
+	cmd.Flags().StringVar(&opts.AWSPlatform.ProxyPassword, "proxy-password", opts.AWSPlatform.ProxyPassword, "The password the proxy created with --enable-proxy requires for authentication. Requires --proxy-username")
 	cmd.Flags().StringVar(&opts.CredentialSecretName, "secret-creds", opts.CredentialSecretName, "A Kubernetes secret with needed AWS platform credentials: aws-creds, pull-secret, and a base-domain value. The secret must exist in the supplied \"--namespace\". If a value is provided through the flag '--pull-secret', that value will override the pull-secret value in 'secret-creds'.")
 	cmd.Flags().StringVar(&opts.AWSPlatform.IssuerURL, "oidc-issuer-url", "", "The OIDC provider issuer URL")
 	cmd.Flags().BoolVar(&opts.AWSPlatform.SingleNATGateway, "single-nat-gateway", opts.AWSPlatform.SingleNATGateway, "If enabled, only a single NAT gateway is created, even if multiple zones are specified")
+	cmd.Flags().BoolVar(&opts.AWSPlatform.WaitForOIDCProvider, "wait-for-oidc-provider", opts.AWSPlatform.WaitForOIDCProvider, "If enabled, wait until the OIDC discovery documents are served from the OIDC provider storage before declaring the creation ready")
+	cmd.Flags().StringVar(&opts.AWSPlatform.Tenancy, "node-pool-tenancy", opts.AWSPlatform.Tenancy, "The EC2 placement tenancy for NodePool instances (default, dedicated, host)")
+	cmd.Flags().StringVar(&opts.AWSPlatform.DedicatedHostID, "node-pool-dedicated-host-id", opts.AWSPlatform.DedicatedHostID, "The ID of an EC2 dedicated host (h-...) to place NodePool instances on. Requires --node-pool-tenancy=host")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
@@ -76,10 +94,36 @@ func CreateCluster(ctx context.Context, opts *core.CreateOptions) error {
 	if err := core.Validate(ctx, opts); err != nil {
 		return err
 	}
-	return core.CreateCluster(ctx, opts, applyPlatformSpecificsValues)
+	if err := core.CreateCluster(ctx, opts, applyPlatformSpecificsValues); err != nil {
+		return err
+	}
+	if opts.AWSPlatform.WaitForOIDCProvider && !opts.Render {
+		return awsinfra.WaitForOIDCProviderReady(ctx, opts.Log, opts.AWSPlatform.IssuerURL)
+	}
+	return nil
 }
 
 func applyPlatformSpecificsValues(ctx context.Context, exampleOptions *apifixtures.ExampleOptions, opts *core.CreateOptions) (err error) {
+	if err := validateAPILoadBalancerOptions(opts); err != nil {
+		return err
+	}
+	if _, err := awsutil.ParseServiceEndpoints(opts.AWSPlatform.ServiceEndpoints); err != nil {
+		return err
+	}
+	if opts.AWSPlatform.Partition != "" {
+		if _, err := awsutil.PartitionForRegion(opts.AWSPlatform.Partition, opts.AWSPlatform.Region); err != nil {
+			return err
+		}
+	}
+	if err := validateSharedVPCOptions(opts); err != nil {
+		return err
+	}
+	if err := validateInstanceTypes(opts.Arch, opts.AWSPlatform.InstanceTypes); err != nil {
+		return err
+	}
+	if err := validateTenancyOptions(opts); err != nil {
+		return err
+	}
 	client, err := util.GetClient()
 	if err != nil {
 		return err
@@ -123,6 +167,16 @@ func applyPlatformSpecificsValues(ctx context.Context, exampleOptions *apifixtur
 			return fmt.Errorf("base-domain flag is required if infra-json is not provided")
 		}
 	}
+	if opts.AWSPlatform.SharedVPCNetworkingRoleARN != "" {
+		if err := validateSharedVPCRoleTrust(opts, AWSKey, AWSSecretKey); err != nil {
+			return err
+		}
+	}
+	if opts.AWSPlatform.DedicatedHostID != "" {
+		if err := validateDedicatedHost(ctx, opts, AWSKey, AWSSecretKey); err != nil {
+			return err
+		}
+	}
 	if infra == nil {
 		if len(infraID) == 0 {
 			infraID = infraid.New(opts.Name)
@@ -139,8 +193,11 @@ func applyPlatformSpecificsValues(ctx context.Context, exampleOptions *apifixtur
 			AdditionalTags:     opts.AWSPlatform.AdditionalTags,
 			Zones:              opts.AWSPlatform.Zones,
 			EnableProxy:        opts.AWSPlatform.EnableProxy,
+			ProxyUsername:      opts.AWSPlatform.ProxyUsername,
+			ProxyPassword:      opts.AWSPlatform.ProxyPassword,
 			SSHKeyFile:         opts.SSHKeyFile,
 			SingleNATGateway:   opts.AWSPlatform.SingleNATGateway,
+			ServiceEndpoints:   opts.AWSPlatform.ServiceEndpoints,
 		}
 		infra, err = opt.CreateInfra(ctx, opts.Log)
 		if err != nil {
@@ -159,6 +216,14 @@ func applyPlatformSpecificsValues(ctx context.Context, exampleOptions *apifixtur
 			return fmt.Errorf("failed to load infra json: %w", err)
 		}
 	} else {
+		// When the issuer URL is user input, the discovery documents are managed
+		// externally and must already be served, so preflight them before any
+		// IAM resources are created against that issuer.
+		if opts.AWSPlatform.WaitForOIDCProvider && opts.AWSPlatform.IssuerURL != "" {
+			if err := awsinfra.WaitForOIDCProviderReady(ctx, opts.Log, opts.AWSPlatform.IssuerURL); err != nil {
+				return err
+			}
+		}
 		opt := awsinfra.CreateIAMOptions{
 			Region:             opts.AWSPlatform.Region,
 			AWSCredentialsFile: opts.AWSPlatform.AWSCredentialsFile,
@@ -171,6 +236,8 @@ func applyPlatformSpecificsValues(ctx context.Context, exampleOptions *apifixtur
 			PublicZoneID:       infra.PublicZoneID,
 			LocalZoneID:        infra.LocalZoneID,
 			KMSKeyARN:          opts.AWSPlatform.EtcdKMSKeyARN,
+			ServiceEndpoints:   opts.AWSPlatform.ServiceEndpoints,
+			Partition:          opts.AWSPlatform.Partition,
 		}
 		iamInfo, err = opt.CreateIAM(ctx, client)
 		if err != nil {
@@ -204,6 +271,9 @@ func applyPlatformSpecificsValues(ctx context.Context, exampleOptions *apifixtur
 	exampleOptions.BaseDomainPrefix = infra.BaseDomainPrefix
 	exampleOptions.MachineCIDR = infra.MachineCIDR
 	exampleOptions.IssuerURL = iamInfo.IssuerURL
+	// Keep the resolved issuer URL around so the post-create OIDC provider
+	// readiness wait has it even when it was auto-detected.
+	opts.AWSPlatform.IssuerURL = iamInfo.IssuerURL
 	exampleOptions.PrivateZoneID = infra.PrivateZoneID
 	exampleOptions.PublicZoneID = infra.PublicZoneID
 	exampleOptions.InfraID = infraID
@@ -219,6 +289,20 @@ func applyPlatformSpecificsValues(ctx context.Context, exampleOptions *apifixtur
 		})
 	}
 
+	var endpointList []hyperv1.AWSServiceEndpoint
+	for _, entry := range opts.AWSPlatform.ServiceEndpoints {
+		name, endpointURL, _ := strings.Cut(entry, "=")
+		endpointList = append(endpointList, hyperv1.AWSServiceEndpoint{Name: name, URL: endpointURL})
+	}
+
+	var sharedVPC *hyperv1.AWSSharedVPC
+	if opts.AWSPlatform.SharedVPCNetworkingRoleARN != "" {
+		sharedVPC = &hyperv1.AWSSharedVPC{
+			NetworkingAccountRoleARN: opts.AWSPlatform.SharedVPCNetworkingRoleARN,
+			ComputeAccountRoleARN:    opts.AWSPlatform.SharedVPCComputeRoleARN,
+		}
+	}
+
 	exampleOptions.AWS = &apifixtures.ExampleAWSOptions{
 		Region:                  infra.Region,
 		Zones:                   zones,
@@ -235,7 +319,146 @@ func applyPlatformSpecificsValues(ctx context.Context, exampleOptions *apifixtur
 		RootVolumeEncryptionKey: opts.AWSPlatform.RootVolumeEncryptionKey,
 		ResourceTags:            tags,
 		EndpointAccess:          opts.AWSPlatform.EndpointAccess,
+		APILoadBalancerType:     opts.AWSPlatform.APILoadBalancerType,
+		APILoadBalancerScheme:   opts.AWSPlatform.APILoadBalancerScheme,
+		InstanceTypes:           opts.AWSPlatform.InstanceTypes,
+		ServiceEndpoints:        endpointList,
+		Partition:               opts.AWSPlatform.Partition,
 		ProxyAddress:            infra.ProxyAddr,
+		SharedVPC:               sharedVPC,
+		Tenancy:                 opts.AWSPlatform.Tenancy,
+		DedicatedHostID:         opts.AWSPlatform.DedicatedHostID,
+	}
+	return nil
+}
+
+// validateAPILoadBalancerOptions checks the --api-lb-type and --api-lb-scheme
+// values and their combination with the endpoint access mode.
+func validateAPILoadBalancerOptions(opts *core.CreateOptions) error {
+	switch hyperv1.AWSLoadBalancerType(opts.AWSPlatform.APILoadBalancerType) {
+	case "", hyperv1.NLBLoadBalancerType, hyperv1.ClassicLoadBalancerType:
+	default:
+		return fmt.Errorf("invalid api-lb-type %q, must be %s or %s", opts.AWSPlatform.APILoadBalancerType, hyperv1.NLBLoadBalancerType, hyperv1.ClassicLoadBalancerType)
+	}
+	switch hyperv1.AWSLoadBalancerScheme(opts.AWSPlatform.APILoadBalancerScheme) {
+	case "", hyperv1.InternetFacingLoadBalancerScheme:
+	case hyperv1.InternalLoadBalancerScheme:
+		if hyperv1.AWSEndpointAccessType(opts.AWSPlatform.EndpointAccess) != hyperv1.Public {
+			return fmt.Errorf("api-lb-scheme %s is only valid with %s endpoint access", hyperv1.InternalLoadBalancerScheme, hyperv1.Public)
+		}
+	default:
+		return fmt.Errorf("invalid api-lb-scheme %q, must be %s or %s", opts.AWSPlatform.APILoadBalancerScheme, hyperv1.InternetFacingLoadBalancerScheme, hyperv1.InternalLoadBalancerScheme)
+	}
+	return nil
+}
+
+var sharedVPCRoleARNRegexp = regexp.MustCompile(`^arn:aws[A-Za-z0-9-]*:iam::\d{12}:role/.+$`)
+
+// validateSharedVPCOptions checks the --shared-vpc-networking-role-arn and
+// --shared-vpc-compute-role-arn values.
+func validateSharedVPCOptions(opts *core.CreateOptions) error {
+	networkingRoleARN := opts.AWSPlatform.SharedVPCNetworkingRoleARN
+	computeRoleARN := opts.AWSPlatform.SharedVPCComputeRoleARN
+	if networkingRoleARN == "" && computeRoleARN == "" {
+		return nil
+	}
+	if networkingRoleARN == "" || computeRoleARN == "" {
+		return fmt.Errorf("--shared-vpc-networking-role-arn and --shared-vpc-compute-role-arn must be specified together")
+	}
+	for _, roleARN := range []string{networkingRoleARN, computeRoleARN} {
+		if !sharedVPCRoleARNRegexp.MatchString(roleARN) {
+			return fmt.Errorf("invalid shared VPC role ARN %q, must be an IAM role ARN", roleARN)
+		}
+	}
+	return nil
+}
+
+var instanceTypeRegexp = regexp.MustCompile(`^([a-z]+\d+)([a-z]*)\.([0-9a-z]+)$`)
+
+// validateInstanceTypes checks the --node-pool-instance-types values. The
+// resulting NodePools are meant to be interchangeable for scheduling, so every
+// type must match the cluster CPU architecture and use the same size, e.g.
+// m5.large and m5a.large but not m5.large and m6g.xlarge.
+func validateInstanceTypes(arch string, instanceTypes []string) error {
+	var size, reference string
+	for _, instanceType := range instanceTypes {
+		match := instanceTypeRegexp.FindStringSubmatch(instanceType)
+		if match == nil {
+			return fmt.Errorf("invalid instance type %q, expected a family.size format such as m5.large", instanceType)
+		}
+		// Graviton instance families carry a "g" in the letters following the
+		// generation number, e.g. m6g, c7g, m6gd.
+		typeArch := hyperv1.ArchitectureAMD64
+		if strings.Contains(match[2], "g") {
+			typeArch = hyperv1.ArchitectureARM64
+		}
+		if typeArch != arch {
+			return fmt.Errorf("instance type %s is %s, which does not match the cluster architecture %s", instanceType, typeArch, arch)
+		}
+		if reference == "" {
+			size, reference = match[3], instanceType
+			continue
+		}
+		if match[3] != size {
+			return fmt.Errorf("instance types %s and %s are not comparable in size", reference, instanceType)
+		}
+	}
+	return nil
+}
+
+// validateTenancyOptions checks the --node-pool-tenancy and
+// --node-pool-dedicated-host-id flag combination before any AWS calls are
+// made.
+func validateTenancyOptions(opts *core.CreateOptions) error {
+	switch opts.AWSPlatform.Tenancy {
+	case "", "default", "dedicated", "host":
+	default:
+		return fmt.Errorf("invalid tenancy %q, must be default, dedicated or host", opts.AWSPlatform.Tenancy)
+	}
+	if opts.AWSPlatform.DedicatedHostID != "" {
+		if opts.AWSPlatform.Tenancy != "host" {
+			return fmt.Errorf("--node-pool-dedicated-host-id requires --node-pool-tenancy=host")
+		}
+		if !strings.HasPrefix(opts.AWSPlatform.DedicatedHostID, "h-") {
+			return fmt.Errorf("invalid dedicated host ID %q: must start with h-", opts.AWSPlatform.DedicatedHostID)
+		}
+	}
+	return nil
+}
+
+// validateDedicatedHost verifies the dedicated host given through
+// --node-pool-dedicated-host-id exists and can accept instances.
+func validateDedicatedHost(ctx context.Context, opts *core.CreateOptions, awsKey, awsSecretKey string) error {
+	hostID := opts.AWSPlatform.DedicatedHostID
+	ec2Client := ec2.New(awsutil.NewSession("cli-create-cluster", opts.AWSPlatform.AWSCredentialsFile, awsKey, awsSecretKey, opts.AWSPlatform.Region))
+	output, err := ec2Client.DescribeHostsWithContext(ctx, &ec2.DescribeHostsInput{
+		HostIds: []*string{aws.String(hostID)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe dedicated host %q: %w", hostID, err)
+	}
+	if len(output.Hosts) == 0 {
+		return fmt.Errorf("dedicated host %q does not exist", hostID)
+	}
+	if state := aws.StringValue(output.Hosts[0].State); state != "available" {
+		return fmt.Errorf("dedicated host %q is not available, current state is %q", hostID, state)
+	}
+	return nil
+}
+
+// validateSharedVPCRoleTrust verifies the shared VPC roles can be assumed with
+// the given credentials, i.e. their trust relationships allow the identity
+// creating the cluster.
+func validateSharedVPCRoleTrust(opts *core.CreateOptions, awsKey, awsSecretKey string) error {
+	stsClient := sts.New(awsutil.NewSession("cli-create-cluster", opts.AWSPlatform.AWSCredentialsFile, awsKey, awsSecretKey, opts.AWSPlatform.Region))
+	for _, roleARN := range []string{opts.AWSPlatform.SharedVPCNetworkingRoleARN, opts.AWSPlatform.SharedVPCComputeRoleARN} {
+		if _, err := stsClient.AssumeRole(&sts.AssumeRoleInput{
+			RoleArn:         aws.String(roleARN),
+			RoleSessionName: aws.String("hypershift-shared-vpc-validation"),
+			DurationSeconds: aws.Int64(900),
+		}); err != nil {
+			return fmt.Errorf("failed to assume shared VPC role %q, verify its trust relationship: %w", roleARN, err)
+		}
 	}
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 )
 
 func Test_isRequiredOption(t *testing.T) {
@@ -34,6 +35,56 @@ func Test_isRequiredOption(t *testing.T) {
 	}
 }
 
+func Test_validateInstanceTypes(t *testing.T) {
+	tests := map[string]struct {
+		arch          string
+		instanceTypes []string
+		expectError   bool
+	}{
+		"when no instance types are given": {
+			arch:          hyperv1.ArchitectureAMD64,
+			instanceTypes: nil,
+			expectError:   false,
+		},
+		"when amd64 instance types share a size": {
+			arch:          hyperv1.ArchitectureAMD64,
+			instanceTypes: []string{"m5.large", "m5a.large", "m6i.large"},
+			expectError:   false,
+		},
+		"when arm64 instance types share a size": {
+			arch:          hyperv1.ArchitectureARM64,
+			instanceTypes: []string{"m6g.xlarge", "c7g.xlarge"},
+			expectError:   false,
+		},
+		"when an instance type is malformed": {
+			arch:          hyperv1.ArchitectureAMD64,
+			instanceTypes: []string{"m5.large", "notatype"},
+			expectError:   true,
+		},
+		"when an instance type does not match the cluster architecture": {
+			arch:          hyperv1.ArchitectureAMD64,
+			instanceTypes: []string{"m5.large", "m6g.large"},
+			expectError:   true,
+		},
+		"when instance types differ in size": {
+			arch:          hyperv1.ArchitectureAMD64,
+			instanceTypes: []string{"m5.large", "m5.2xlarge"},
+			expectError:   true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			err := validateInstanceTypes(test.arch, test.instanceTypes)
+			if test.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
 func Test_ValidateCreateCredentialInfo(t *testing.T) {
 	tests := map[string]struct {
 		inputOptions *core.CreateOptions
@@ -53,6 +53,12 @@ func NewCreateCommands() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&opts.BaseDomain, "base-domain", opts.BaseDomain, "The ingress base domain for the cluster")
 	cmd.PersistentFlags().StringVar(&opts.BaseDomainPrefix, "base-domain-prefix", opts.BaseDomainPrefix, "The ingress base domain prefix for the cluster, defaults to cluster name. Use 'none' for an empty prefix")
 	cmd.PersistentFlags().StringVar(&opts.ExternalDNSDomain, "external-dns-domain", opts.ExternalDNSDomain, "Sets hostname to opinionated values in the specificed domain for services with publishing type LoadBalancer or Route.")
+	cmd.PersistentFlags().BoolVar(&opts.SharedIngress, "shared-ingress", opts.SharedIngress, "If set, the API server is published through the management cluster's shared ingress router with SNI routing instead of a dedicated load balancer per cluster.")
+	cmd.PersistentFlags().StringSliceVar(&opts.ImagePolicyScopes, "image-policy-scope", opts.ImagePolicyScopes, "A registry or repository scope that image signature verification applies to. Can be specified multiple times.")
+	cmd.PersistentFlags().StringVar(&opts.ImagePolicyPublicKeyFile, "image-policy-public-key-file", opts.ImagePolicyPublicKeyFile, "Path to a PEM-encoded cosign public key trusted to sign images in the configured scopes.")
+	cmd.PersistentFlags().StringVar(&opts.ImagePolicyFulcioCAFile, "image-policy-fulcio-ca-file", opts.ImagePolicyFulcioCAFile, "Path to a PEM-encoded Fulcio certificate authority for keyless image signature verification.")
+	cmd.PersistentFlags().StringVar(&opts.ImagePolicyOIDCIssuer, "image-policy-oidc-issuer", opts.ImagePolicyOIDCIssuer, "The OIDC issuer expected in Fulcio certificates, e.g. https://oauth2.sigstore.dev/auth.")
+	cmd.PersistentFlags().StringVar(&opts.ImagePolicySignedEmail, "image-policy-signed-email", opts.ImagePolicySignedEmail, "The email address expected for the Fulcio certificate identity.")
 	cmd.PersistentFlags().StringVar(&opts.NetworkType, "network-type", opts.NetworkType, "Enum specifying the cluster SDN provider. Supports either Calico, OVNKubernetes, OpenShiftSDN or Other.")
 	cmd.PersistentFlags().StringVar(&opts.ReleaseImage, "release-image", opts.ReleaseImage, "The OCP release image for the cluster")
 	cmd.PersistentFlags().StringVar(&opts.ReleaseStream, "release-stream", opts.ReleaseStream, "The OCP release stream for the cluster (e.g. 4.14.0-0.nightly), this flag is ignored if release-image is set")
@@ -65,9 +71,17 @@ func NewCreateCommands() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&opts.ImageContentSources, "image-content-sources", opts.ImageContentSources, "Path to a file with image content sources")
 	cmd.PersistentFlags().Int32Var(&opts.NodePoolReplicas, "node-pool-replicas", opts.NodePoolReplicas, "If 0 or greater, creates a nodepool with that many replicas; else if less than 0, does not create a nodepool.")
 	cmd.PersistentFlags().DurationVar(&opts.NodeDrainTimeout, "node-drain-timeout", opts.NodeDrainTimeout, "The NodeDrainTimeout on any created NodePools")
+	cmd.PersistentFlags().StringToStringVar(&opts.NodeLabels, "node-labels", opts.NodeLabels, "A comma separated list of key=value labels propagated to Nodes of any created NodePools on creation. E.g. role=worker,disk=fast")
+	cmd.PersistentFlags().StringArrayVar(&opts.Taints, "taints", opts.Taints, "A taint propagated to Nodes of any created NodePools on creation, in the form key=value:Effect or key:Effect. Repeat to add multiple taints")
+	cmd.PersistentFlags().Int64Var(&opts.NodePodPIDsLimit, "node-pid-limit", opts.NodePodPIDsLimit, "If set, the maximum number of process IDs any single pod may use on the cluster's nodes, applied through a generated KubeletConfig. Must be at least 1024.")
+	cmd.PersistentFlags().Int64Var(&opts.NodeContainerPIDsLimit, "node-container-pid-limit", opts.NodeContainerPIDsLimit, "If set, the maximum number of process IDs any single container may use on the cluster's nodes, applied through a generated ContainerRuntimeConfig. Must be at least 1024.")
 	cmd.PersistentFlags().StringArrayVar(&opts.Annotations, "annotations", opts.Annotations, "Annotations to apply to the hostedcluster (key=value). Can be specified multiple times.")
+	cmd.PersistentFlags().StringArrayVar(&opts.CCMFeatureGates, "ccm-feature-gate", opts.CCMFeatureGates, "A feature gate (gate=true|false) to set on the guest cluster's cloud-controller-manager. Can be specified multiple times.")
+	cmd.PersistentFlags().StringVar(&opts.AuditLogDestination, "audit-log-destination", opts.AuditLogDestination, "A destination (type=url, type one of syslog, elasticsearch or kafka) to which the guest cluster's logging operator should forward kube-apiserver audit logs")
+	cmd.PersistentFlags().BoolVar(&opts.DisableTelemetry, "disable-telemetry", opts.DisableTelemetry, "If set, the cloud.openshift.com token is removed from the cluster's pull secret so the telemeter client and insights operator do not report remote health data")
 	cmd.PersistentFlags().BoolVar(&opts.FIPS, "fips", opts.FIPS, "Enables FIPS mode for nodes in the cluster")
 	cmd.PersistentFlags().BoolVar(&opts.AutoRepair, "auto-repair", opts.AutoRepair, "Enables machine autorepair with machine health checks")
+	cmd.PersistentFlags().BoolVar(&opts.SerializeNodePoolRollouts, "serialize-nodepool-rollouts", opts.SerializeNodePoolRollouts, "If set, only one NodePool of the cluster rolls out a config or version change at a time")
 	cmd.PersistentFlags().StringVar(&opts.InfrastructureAvailabilityPolicy, "infra-availability-policy", opts.InfrastructureAvailabilityPolicy, "Availability policy for infrastructure services in guest cluster. Supported options: SingleReplica, HighlyAvailable")
 	cmd.PersistentFlags().BoolVar(&opts.GenerateSSH, "generate-ssh", opts.GenerateSSH, "If true, generate SSH keys")
 	cmd.PersistentFlags().StringVar(&opts.EtcdStorageClass, "etcd-storage-class", opts.EtcdStorageClass, "The persistent volume storage class for etcd data volumes")
@@ -78,10 +92,33 @@ func NewCreateCommands() *cobra.Command {
 	cmd.PersistentFlags().StringToStringVar(&opts.NodeSelector, "node-selector", opts.NodeSelector, "A comma separated list of key=value to use as node selector for the Hosted Control Plane pods to stick to. E.g. role=cp,disk=fast")
 	cmd.PersistentFlags().BoolVar(&opts.Wait, "wait", opts.Wait, "If the create command should block until the cluster is up. Requires at least one node.")
 	cmd.PersistentFlags().DurationVar(&opts.Timeout, "timeout", opts.Timeout, "If the --wait flag is set, set the optional timeout to limit the waiting duration. The format is duration; e.g. 30s or 1h30m45s; 0 means no timeout; default = 0")
+	cmd.PersistentFlags().DurationVar(&opts.TimeoutPerPhase, "timeout-per-phase", opts.TimeoutPerPhase, "If set, fail when any single create phase (infra, apply, rollout) takes longer than this duration; 0 means no per-phase timeout")
+	cmd.PersistentFlags().StringVar(&opts.OAuthLoginTemplate, "oauth-login-template", opts.OAuthLoginTemplate, "Path to an HTML file to use as the custom OAuth login template")
+	cmd.PersistentFlags().StringVar(&opts.OAuthProviderSelectionTemplate, "oauth-provider-selection-template", opts.OAuthProviderSelectionTemplate, "Path to an HTML file to use as the custom OAuth provider selection template")
+	cmd.PersistentFlags().StringVar(&opts.OAuthErrorTemplate, "oauth-error-template", opts.OAuthErrorTemplate, "Path to an HTML file to use as the custom OAuth error page template")
 	cmd.PersistentFlags().Var(&opts.NodeUpgradeType, "node-upgrade-type", "The NodePool upgrade strategy for how nodes should behave when upgraded. Supported options: Replace, InPlace")
 	cmd.PersistentFlags().Var(&opts.OLMCatalogPlacement, "olmCatalogPlacement", "The OLM Catalog Placement for the HostedCluster. Supported options: Management, Guest")
 	cmd.PersistentFlags().StringVar(&opts.Arch, "arch", opts.Arch, "The default processor architecture for the NodePool (e.g. arm64, amd64)")
 	cmd.PersistentFlags().StringVar(&opts.PausedUntil, "pausedUntil", opts.PausedUntil, "If a date is provided in RFC3339 format, HostedCluster creation is paused until that date. If the boolean true is provided, HostedCluster creation is paused until the field is removed.")
+	cmd.PersistentFlags().StringSliceVar(&opts.APIAudiences, "api-audiences", opts.APIAudiences, "A comma separated list of additional audiences the API server accepts in ServiceAccount tokens, on top of the issuer URL.")
+	cmd.PersistentFlags().StringToStringVar(&opts.GuestDefaultLimits, "guest-default-limits", opts.GuestDefaultLimits, "Default container resource limits installed as a LimitRange in newly requested guest cluster projects, e.g. cpu=500m,memory=1Gi")
+	cmd.PersistentFlags().BoolVar(&opts.GuestDefaultDenyNetworkPolicy, "guest-default-deny-networkpolicy", opts.GuestDefaultDenyNetworkPolicy, "If set, a default-deny ingress NetworkPolicy is installed into every non-system namespace of the guest cluster")
+	cmd.PersistentFlags().StringVar(&opts.GuestRBACManifests, "guest-rbac-manifests", opts.GuestRBACManifests, "Path to a file or directory with additional ClusterRole and ClusterRoleBinding manifests to apply to the guest cluster during its RBAC bootstrap")
+	cmd.PersistentFlags().StringVar(&opts.FromTemplate, "from-template", opts.FromTemplate, "Path to a YAML cluster template file that seeds the create options, e.g. a standardized cluster size profile. Explicitly set flags override template values")
+
+	// The template is applied before any subcommand runs so its values behave
+	// exactly like defaults: explicitly given flags keep their value.
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if opts.FromTemplate == "" {
+			return nil
+		}
+		template, err := core.LoadClusterTemplate(opts.FromTemplate)
+		if err != nil {
+			return err
+		}
+		template.ApplyTo(opts, cmd.Flags().Changed)
+		return nil
+	}
 
 	cmd.AddCommand(aws.NewCreateCommand(opts))
 	cmd.AddCommand(none.NewCreateCommand(opts))
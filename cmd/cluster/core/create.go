@@ -1,20 +1,25 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"golang.org/x/crypto/ssh"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -29,7 +34,9 @@ import (
 	"github.com/openshift/hypershift/cmd/util"
 	"github.com/openshift/hypershift/cmd/version"
 	hyperapi "github.com/openshift/hypershift/support/api"
+	supportconfig "github.com/openshift/hypershift/support/config"
 	"github.com/openshift/hypershift/support/releaseinfo"
+	supportutil "github.com/openshift/hypershift/support/util"
 )
 
 // ApplyPlatformSpecifics can be used to create platform specific values as well as enriching the fixture with additional values
@@ -38,13 +45,26 @@ type ApplyPlatformSpecifics = func(ctx context.Context, fixture *apifixtures.Exa
 type CreateOptions struct {
 	AdditionalTrustBundle            string
 	Annotations                      []string
+	APIAudiences                     []string
+	AuditLogDestination              string
 	AutoRepair                       bool
+	CCMFeatureGates                  []string
 	ControlPlaneAvailabilityPolicy   string
 	ControlPlaneOperatorImage        string
+	DisableTelemetry                 bool
 	EtcdStorageClass                 string
 	FIPS                             bool
+	FromTemplate                     string
 	GenerateSSH                      bool
+	GuestDefaultDenyNetworkPolicy    bool
+	GuestDefaultLimits               map[string]string
+	GuestRBACManifests               string
 	ImageContentSources              string
+	ImagePolicyScopes                []string
+	ImagePolicyPublicKeyFile         string
+	ImagePolicyFulcioCAFile          string
+	ImagePolicyOIDCIssuer            string
+	ImagePolicySignedEmail           string
 	InfrastructureAvailabilityPolicy string
 	InfrastructureJSON               string
 	InfraID                          string
@@ -55,11 +75,18 @@ type CreateOptions struct {
 	NetworkType                      string
 	NodePoolReplicas                 int32
 	NodeDrainTimeout                 time.Duration
+	NodeLabels                       map[string]string
+	Taints                           []string
+	NodePodPIDsLimit                 int64
+	NodeContainerPIDsLimit           int64
+	TimeoutPerPhase                  time.Duration
 	PullSecretFile                   string
 	ReleaseImage                     string
 	ReleaseStream                    string
 	Render                           bool
+	SerializeNodePoolRollouts        bool
 	SSHKeyFile                       string
+	SharedIngress                    bool
 	ServiceCIDR                      []string
 	ClusterCIDR                      []string
 	ExternalDNSDomain                string
@@ -79,6 +106,9 @@ type CreateOptions struct {
 	NodeUpgradeType                  hyperv1.UpgradeType
 	PausedUntil                      string
 	OLMCatalogPlacement              hyperv1.OLMCatalogPlacement
+	OAuthLoginTemplate               string
+	OAuthProviderSelectionTemplate   string
+	OAuthErrorTemplate               string
 
 	// BeforeApply is called immediately before resources are applied to the
 	// server, giving the user an opportunity to inspect or mutate the resources.
@@ -108,6 +138,8 @@ type PowerVSPlatformOptions struct {
 type AgentPlatformCreateOptions struct {
 	APIServerAddress string
 	AgentNamespace   string
+	APIVIP           string
+	IngressVIP       string
 }
 
 type NonePlatformCreateOptions struct {
@@ -136,23 +168,35 @@ type KubevirtPlatformCreateOptions struct {
 }
 
 type AWSPlatformOptions struct {
-	AWSCredentialsFile      string
-	AdditionalTags          []string
-	IAMJSON                 string
-	InstanceType            string
-	IssuerURL               string
-	PrivateZoneID           string
-	PublicZoneID            string
-	Region                  string
-	RootVolumeIOPS          int64
-	RootVolumeSize          int64
-	RootVolumeType          string
-	RootVolumeEncryptionKey string
-	EndpointAccess          string
-	Zones                   []string
-	EtcdKMSKeyARN           string
-	EnableProxy             bool
-	SingleNATGateway        bool
+	AWSCredentialsFile         string
+	AdditionalTags             []string
+	IAMJSON                    string
+	InstanceType               string
+	InstanceTypes              []string
+	IssuerURL                  string
+	PrivateZoneID              string
+	PublicZoneID               string
+	Region                     string
+	RootVolumeIOPS             int64
+	RootVolumeSize             int64
+	RootVolumeType             string
+	RootVolumeEncryptionKey    string
+	EndpointAccess             string
+	APILoadBalancerType        string
+	APILoadBalancerScheme      string
+	ServiceEndpoints           []string
+	Partition                  string
+	Zones                      []string
+	EtcdKMSKeyARN              string
+	EnableProxy                bool
+	ProxyUsername              string
+	ProxyPassword              string
+	SingleNATGateway           bool
+	WaitForOIDCProvider        bool
+	SharedVPCNetworkingRoleARN string
+	SharedVPCComputeRoleARN    string
+	Tenancy                    string
+	DedicatedHostID            string
 }
 
 type AzurePlatformOptions struct {
@@ -193,6 +237,28 @@ func createCommonFixture(ctx context.Context, opts *CreateOptions) (*apifixtures
 		annotations[hyperv1.ControlPlaneOperatorImageAnnotation] = opts.ControlPlaneOperatorImage
 	}
 
+	if len(opts.CCMFeatureGates) > 0 {
+		if err := supportconfig.ValidateFeatureGates(opts.CCMFeatureGates); err != nil {
+			return nil, err
+		}
+		annotations[hyperv1.CCMFeatureGatesAnnotation] = strings.Join(opts.CCMFeatureGates, ",")
+	}
+
+	if len(opts.AuditLogDestination) > 0 {
+		if err := validateAuditLogDestination(opts.AuditLogDestination); err != nil {
+			return nil, err
+		}
+		annotations[hyperv1.AuditLogForwardingAnnotation] = opts.AuditLogDestination
+	}
+
+	if opts.SerializeNodePoolRollouts {
+		annotations[hyperv1.SerializeNodePoolRolloutsAnnotation] = "true"
+	}
+
+	if err := validateNodePIDLimits(opts); err != nil {
+		return nil, err
+	}
+
 	var pullSecret []byte
 	var err error
 	if len(opts.CredentialSecretName) > 0 {
@@ -208,6 +274,12 @@ func createCommonFixture(ctx context.Context, opts *CreateOptions) (*apifixtures
 			return nil, fmt.Errorf("failed to read pull secret file: %w", err)
 		}
 	}
+	if opts.DisableTelemetry {
+		pullSecret, err = removeTelemetryPullSecretToken(pullSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to disable telemetry: %w", err)
+		}
+	}
 	var sshKey, sshPrivateKey []byte
 	if len(opts.CredentialSecretName) > 0 {
 		var secret *corev1.Secret
@@ -263,6 +335,46 @@ func createCommonFixture(ctx context.Context, opts *CreateOptions) (*apifixtures
 
 	}
 
+	for _, audience := range opts.APIAudiences {
+		if len(strings.TrimSpace(audience)) == 0 {
+			return nil, fmt.Errorf("invalid api audience, audiences must be non-empty strings")
+		}
+	}
+
+	var guestDefaultLimits corev1.ResourceList
+	if len(opts.GuestDefaultLimits) > 0 {
+		guestDefaultLimits = corev1.ResourceList{}
+		for name, value := range opts.GuestDefaultLimits {
+			if len(strings.TrimSpace(name)) == 0 {
+				return nil, fmt.Errorf("invalid guest default limit, resource names must be non-empty strings")
+			}
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid guest default limit quantity %q for resource %q: %w", value, name, err)
+			}
+			guestDefaultLimits[corev1.ResourceName(name)] = quantity
+		}
+	}
+
+	var guestRBACManifests map[string]string
+	if len(opts.GuestRBACManifests) > 0 {
+		guestRBACManifests, err = readGuestRBACManifests(opts.GuestRBACManifests)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.SharedIngress {
+		if opts.NonePlatform.APIServerAddress != "" || opts.AgentPlatform.APIServerAddress != "" {
+			return nil, fmt.Errorf("--shared-ingress cannot be combined with a nodeport API server address")
+		}
+	}
+
+	imageSignaturePolicies, err := imageSignaturePolicyFromFlags(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	// validate pausedUntil value
 	// valid values are either "true" or RFC3339 format date
 	if len(opts.PausedUntil) > 0 && opts.PausedUntil != "true" {
@@ -272,6 +384,32 @@ func createCommonFixture(ctx context.Context, opts *CreateOptions) (*apifixtures
 		}
 	}
 
+	var oauthLoginTemplate, oauthProviderSelectionTemplate, oauthErrorTemplate []byte
+	if len(opts.OAuthLoginTemplate) > 0 {
+		if oauthLoginTemplate, err = readOAuthTemplate(opts.OAuthLoginTemplate); err != nil {
+			return nil, err
+		}
+	}
+	if len(opts.OAuthProviderSelectionTemplate) > 0 {
+		if oauthProviderSelectionTemplate, err = readOAuthTemplate(opts.OAuthProviderSelectionTemplate); err != nil {
+			return nil, err
+		}
+	}
+	if len(opts.OAuthErrorTemplate) > 0 {
+		if oauthErrorTemplate, err = readOAuthTemplate(opts.OAuthErrorTemplate); err != nil {
+			return nil, err
+		}
+	}
+
+	var taints []hyperv1.Taint
+	for _, taint := range opts.Taints {
+		parsed, err := util.ParseTaint(taint)
+		if err != nil {
+			return nil, err
+		}
+		taints = append(taints, parsed)
+	}
+
 	return &apifixtures.ExampleOptions{
 		AdditionalTrustBundle:            string(userCABundle),
 		ImageContentSources:              imageContentSources,
@@ -286,6 +424,10 @@ func createCommonFixture(ctx context.Context, opts *CreateOptions) (*apifixtures
 		NetworkType:                      hyperv1.NetworkType(opts.NetworkType),
 		NodePoolReplicas:                 opts.NodePoolReplicas,
 		NodeDrainTimeout:                 opts.NodeDrainTimeout,
+		NodeLabels:                       opts.NodeLabels,
+		Taints:                           taints,
+		NodePodPIDsLimit:                 opts.NodePodPIDsLimit,
+		NodeContainerPIDsLimit:           opts.NodeContainerPIDsLimit,
 		PullSecret:                       pullSecret,
 		ReleaseImage:                     opts.ReleaseImage,
 		SSHPrivateKey:                    sshPrivateKey,
@@ -298,9 +440,118 @@ func createCommonFixture(ctx context.Context, opts *CreateOptions) (*apifixtures
 		UpgradeType:                      opts.NodeUpgradeType,
 		PausedUntil:                      opts.PausedUntil,
 		OLMCatalogPlacement:              opts.OLMCatalogPlacement,
+		OAuthLoginTemplate:               oauthLoginTemplate,
+		OAuthProviderSelectionTemplate:   oauthProviderSelectionTemplate,
+		OAuthErrorTemplate:               oauthErrorTemplate,
+		ServiceAccountAudiences:          opts.APIAudiences,
+		GuestDefaultDenyNetworkPolicy:    opts.GuestDefaultDenyNetworkPolicy,
+		GuestDefaultLimits:               guestDefaultLimits,
+		GuestRBACManifests:               guestRBACManifests,
+		SharedIngress:                    opts.SharedIngress,
+		ImageSignaturePolicies:           imageSignaturePolicies,
 	}, nil
 }
 
+// imageSignaturePolicyFromFlags builds an image signature policy from the
+// --image-policy-* flags. The flags describe a single policy; more complex
+// setups can edit spec.imageSignaturePolicies on the HostedCluster directly.
+func imageSignaturePolicyFromFlags(opts *CreateOptions) ([]hyperv1.ImageSignaturePolicy, error) {
+	if len(opts.ImagePolicyScopes) == 0 {
+		if opts.ImagePolicyPublicKeyFile != "" || opts.ImagePolicyFulcioCAFile != "" {
+			return nil, fmt.Errorf("--image-policy-scope is required when an image signature root of trust is given")
+		}
+		return nil, nil
+	}
+	if (opts.ImagePolicyPublicKeyFile != "") == (opts.ImagePolicyFulcioCAFile != "") {
+		return nil, fmt.Errorf("exactly one of --image-policy-public-key-file or --image-policy-fulcio-ca-file is required")
+	}
+
+	policy := hyperv1.ImageSignaturePolicy{
+		Name:   "cluster",
+		Scopes: opts.ImagePolicyScopes,
+	}
+	if opts.ImagePolicyPublicKeyFile != "" {
+		publicKey, err := os.ReadFile(opts.ImagePolicyPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read image policy public key file: %w", err)
+		}
+		policy.PublicKey = publicKey
+	} else {
+		if opts.ImagePolicyOIDCIssuer == "" || opts.ImagePolicySignedEmail == "" {
+			return nil, fmt.Errorf("--image-policy-oidc-issuer and --image-policy-signed-email are required with --image-policy-fulcio-ca-file")
+		}
+		caData, err := os.ReadFile(opts.ImagePolicyFulcioCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read image policy fulcio CA file: %w", err)
+		}
+		policy.Fulcio = &hyperv1.FulcioPolicy{
+			CAData:      caData,
+			OIDCIssuer:  opts.ImagePolicyOIDCIssuer,
+			SignedEmail: opts.ImagePolicySignedEmail,
+		}
+	}
+	return []hyperv1.ImageSignaturePolicy{policy}, nil
+}
+
+// readOAuthTemplate reads an OAuth template file and verifies it contains a
+// non-empty HTML document.
+func readOAuthTemplate(path string) ([]byte, error) {
+	template, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OAuth template file: %w", err)
+	}
+	if err := validateOAuthTemplate(template); err != nil {
+		return nil, fmt.Errorf("invalid OAuth template file %s: %w", path, err)
+	}
+	return template, nil
+}
+
+func validateOAuthTemplate(template []byte) error {
+	if len(bytes.TrimSpace(template)) == 0 {
+		return fmt.Errorf("template is empty")
+	}
+	if !strings.Contains(strings.ToLower(string(template)), "<html") {
+		return fmt.Errorf("template does not contain an <html> element")
+	}
+	return nil
+}
+
+// readGuestRBACManifests reads a file or directory of ClusterRole and
+// ClusterRoleBinding manifests and returns them keyed by file name. Manifests
+// of any other kind are rejected.
+func readGuestRBACManifests(path string) (map[string]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read guest rbac manifests path: %w", err)
+	}
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read guest rbac manifests directory: %w", err)
+		}
+		files = nil
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+	manifests := map[string]string{}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read guest rbac manifest file: %w", err)
+		}
+		manifests[filepath.Base(file)] = string(content)
+	}
+	if _, err := supportutil.ParseGuestRBACManifests(manifests); err != nil {
+		return nil, fmt.Errorf("invalid guest rbac manifests: %w", err)
+	}
+	return manifests, nil
+}
+
 func generateSSHKeys() ([]byte, []byte, error) {
 	privateKey, err := rsa.GenerateKey(rand.Reader, 4096)
 	if err != nil {
@@ -323,12 +574,12 @@ func generateSSHKeys() ([]byte, []byte, error) {
 	return publicBytes, privatePEM, nil
 }
 
-func apply(ctx context.Context, l logr.Logger, exampleOptions *apifixtures.ExampleOptions, waitForRollout bool, mutate func(crclient.Object)) error {
+func apply(ctx context.Context, l logr.Logger, exampleOptions *apifixtures.ExampleOptions, mutate func(crclient.Object)) (*hyperv1.HostedCluster, error) {
 	exampleObjects := exampleOptions.Resources().AsObjects()
 
 	client, err := util.GetClient()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if mutate != nil {
 		for _, object := range exampleObjects {
@@ -347,27 +598,31 @@ func apply(ctx context.Context, l logr.Logger, exampleOptions *apifixtures.Examp
 			err = client.Patch(ctx, object, crclient.Apply, crclient.ForceOwnership, crclient.FieldOwner("hypershift-cli"))
 		}
 		if err != nil {
-			return fmt.Errorf("failed to apply object %q: %w", key, err)
+			return nil, fmt.Errorf("failed to apply object %q: %w", key, err)
 		}
 		l.Info("Applied Kube resource", "kind", object.GetObjectKind().GroupVersionKind().Kind, "namespace", key.Namespace, "name", key.Name)
 	}
 
-	if waitForRollout {
-		l.Info("Waiting for cluster rollout")
-		return wait.PollInfiniteWithContext(ctx, 30*time.Second, func(ctx context.Context) (bool, error) {
-			hostedCluster := hostedCluster.DeepCopy()
-			if err := client.Get(ctx, crclient.ObjectKeyFromObject(hostedCluster), hostedCluster); err != nil {
-				return false, fmt.Errorf("failed to get hostedcluster %s: %w", crclient.ObjectKeyFromObject(hostedCluster), err)
-			}
-			rolledOut := hostedCluster.Status.Version != nil && len(hostedCluster.Status.Version.History) > 0 && hostedCluster.Status.Version.History[0].CompletionTime != nil
-			if !rolledOut {
-				l.Info("Cluster rollout not finished yet, checking again in 30 seconds...")
-			}
-			return rolledOut, nil
-		})
-	}
+	return hostedCluster, nil
+}
 
-	return nil
+func waitForClusterRollout(ctx context.Context, l logr.Logger, hostedCluster *hyperv1.HostedCluster) error {
+	client, err := util.GetClient()
+	if err != nil {
+		return err
+	}
+	l.Info("Waiting for cluster rollout")
+	return wait.PollInfiniteWithContext(ctx, 30*time.Second, func(ctx context.Context) (bool, error) {
+		hostedCluster := hostedCluster.DeepCopy()
+		if err := client.Get(ctx, crclient.ObjectKeyFromObject(hostedCluster), hostedCluster); err != nil {
+			return false, fmt.Errorf("failed to get hostedcluster %s: %w", crclient.ObjectKeyFromObject(hostedCluster), err)
+		}
+		rolledOut := hostedCluster.Status.Version != nil && len(hostedCluster.Status.Version.History) > 0 && hostedCluster.Status.Version.History[0].CompletionTime != nil
+		if !rolledOut {
+			l.Info("Cluster rollout not finished yet, checking again in 30 seconds...")
+		}
+		return rolledOut, nil
+	})
 }
 
 func GetAPIServerAddressByNode(ctx context.Context, l logr.Logger) (string, error) {
@@ -435,6 +690,86 @@ func Validate(ctx context.Context, opts *CreateOptions) error {
 	return nil
 }
 
+// validateNodePIDLimits checks the node PID limit flags against the floor the
+// machine config operator enforces.
+func validateNodePIDLimits(opts *CreateOptions) error {
+	if opts.NodePodPIDsLimit != 0 && opts.NodePodPIDsLimit < 1024 {
+		return fmt.Errorf("--node-pid-limit must be at least 1024, got %d", opts.NodePodPIDsLimit)
+	}
+	if opts.NodeContainerPIDsLimit != 0 && opts.NodeContainerPIDsLimit < 1024 {
+		return fmt.Errorf("--node-container-pid-limit must be at least 1024, got %d", opts.NodeContainerPIDsLimit)
+	}
+	if opts.NodePodPIDsLimit != 0 && opts.NodeContainerPIDsLimit > opts.NodePodPIDsLimit {
+		return fmt.Errorf("--node-container-pid-limit %d must not exceed --node-pid-limit %d", opts.NodeContainerPIDsLimit, opts.NodePodPIDsLimit)
+	}
+	return nil
+}
+
+// validateAuditLogDestination checks that an audit log forwarding destination
+// has the form type=url with a supported destination type.
+func validateAuditLogDestination(destination string) error {
+	pair := strings.SplitN(destination, "=", 2)
+	if len(pair) != 2 {
+		return fmt.Errorf("invalid audit log destination %q, must be of the form type=url", destination)
+	}
+	switch pair[0] {
+	case "syslog", "elasticsearch", "kafka":
+	default:
+		return fmt.Errorf("invalid audit log destination type %q, must be one of syslog, elasticsearch or kafka", pair[0])
+	}
+	destinationURL, err := url.Parse(pair[1])
+	if err != nil {
+		return fmt.Errorf("invalid audit log destination url %q: %w", pair[1], err)
+	}
+	if destinationURL.Scheme == "" || destinationURL.Host == "" {
+		return fmt.Errorf("audit log destination url %q must include a scheme and host", pair[1])
+	}
+	return nil
+}
+
+// telemetryPullSecretEntry is the pull secret entry holding the token that
+// the telemeter client and the insights operator use to report to Red Hat.
+const telemetryPullSecretEntry = "cloud.openshift.com"
+
+// removeTelemetryPullSecretToken drops the cloud.openshift.com token from the
+// pull secret, which is the supported way to opt a cluster out of remote
+// health reporting. The remaining entries are untouched, so release payload
+// and operator subscription pulls keep working.
+func removeTelemetryPullSecretToken(pullSecret []byte) ([]byte, error) {
+	var dockerConfig map[string]interface{}
+	if err := json.Unmarshal(pullSecret, &dockerConfig); err != nil {
+		return nil, fmt.Errorf("cannot parse pull secret: %w", err)
+	}
+	auths, ok := dockerConfig["auths"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("pull secret does not contain auths")
+	}
+	delete(auths, telemetryPullSecretEntry)
+	return json.Marshal(dockerConfig)
+}
+
+// runCreatePhase runs one named phase of cluster creation, logging its duration
+// and enforcing the per-phase timeout when one is configured.
+func runCreatePhase(ctx context.Context, opts *CreateOptions, name string, run func(context.Context) error) error {
+	if opts.TimeoutPerPhase > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.TimeoutPerPhase)
+		defer cancel()
+	}
+	opts.Log.Info("Starting create phase", "phase", name)
+	start := time.Now()
+	err := run(ctx)
+	duration := time.Since(start).Round(time.Second)
+	if err != nil {
+		if opts.TimeoutPerPhase > 0 && ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("create phase %s timed out after %s", name, opts.TimeoutPerPhase)
+		}
+		return fmt.Errorf("create phase %s failed after %s: %w", name, duration, err)
+	}
+	opts.Log.Info("Completed create phase", "phase", name, "duration", duration.String())
+	return nil
+}
+
 func CreateCluster(ctx context.Context, opts *CreateOptions, platformSpecificApply ApplyPlatformSpecifics) error {
 	if opts.Wait && opts.NodePoolReplicas < 1 {
 		return errors.New("--wait requires --node-pool-replicas > 0")
@@ -446,7 +781,9 @@ func CreateCluster(ctx context.Context, opts *CreateOptions, platformSpecificApp
 	}
 
 	// Apply platform specific options and create platform specific resources
-	if err := platformSpecificApply(ctx, exampleOptions, opts); err != nil {
+	if err := runCreatePhase(ctx, opts, "infra", func(ctx context.Context) error {
+		return platformSpecificApply(ctx, exampleOptions, opts)
+	}); err != nil {
 		return err
 	}
 
@@ -463,7 +800,22 @@ func CreateCluster(ctx context.Context, opts *CreateOptions, platformSpecificApp
 	}
 
 	// Otherwise, apply the objects
-	return apply(ctx, opts.Log, exampleOptions, opts.Wait, opts.BeforeApply)
+	var hostedCluster *hyperv1.HostedCluster
+	if err := runCreatePhase(ctx, opts, "apply", func(ctx context.Context) error {
+		var err error
+		hostedCluster, err = apply(ctx, opts.Log, exampleOptions, opts.BeforeApply)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if opts.Wait {
+		return runCreatePhase(ctx, opts, "rollout", func(ctx context.Context) error {
+			return waitForClusterRollout(ctx, opts.Log, hostedCluster)
+		})
+	}
+
+	return nil
 }
 
 func defaultNetworkType(ctx context.Context, opts *CreateOptions, releaseProvider releaseinfo.Provider, readFile func(string) ([]byte, error)) error {
@@ -2,12 +2,75 @@ package core
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/openshift/hypershift/support/releaseinfo"
 	"github.com/openshift/hypershift/support/releaseinfo/fake"
 )
 
+func TestRunCreatePhase(t *testing.T) {
+	opts := &CreateOptions{Log: logr.Discard()}
+	if err := runCreatePhase(context.Background(), opts, "infra", func(ctx context.Context) error {
+		return nil
+	}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	err := runCreatePhase(context.Background(), opts, "infra", func(ctx context.Context) error {
+		return errors.New("boom")
+	})
+	if err == nil || !strings.Contains(err.Error(), "create phase infra failed") {
+		t.Errorf("expected phase failure error, got %v", err)
+	}
+
+	opts.TimeoutPerPhase = time.Millisecond
+	err = runCreatePhase(context.Background(), opts, "rollout", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err == nil || !strings.Contains(err.Error(), "create phase rollout timed out") {
+		t.Errorf("expected phase timeout error, got %v", err)
+	}
+}
+
+func TestValidateOAuthTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		expectError bool
+	}{
+		{
+			name:     "valid html template",
+			template: "<html><body>{{ .Error }}</body></html>",
+		},
+		{
+			name:        "empty template",
+			template:    "  \n",
+			expectError: true,
+		},
+		{
+			name:        "template without html element",
+			template:    "just some text",
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateOAuthTemplate([]byte(test.template))
+			if test.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
 func TestDefaultNetworkType(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -62,3 +125,50 @@ func TestDefaultNetworkType(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveTelemetryPullSecretToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		pullSecret  string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:       "telemetry token is removed",
+			pullSecret: `{"auths":{"cloud.openshift.com":{"auth":"dGVsZW1ldHJ5"},"quay.io":{"auth":"cXVheQ=="}}}`,
+			expected:   `{"auths":{"quay.io":{"auth":"cXVheQ=="}}}`,
+		},
+		{
+			name:       "pull secret without telemetry token is unchanged",
+			pullSecret: `{"auths":{"quay.io":{"auth":"cXVheQ=="}}}`,
+			expected:   `{"auths":{"quay.io":{"auth":"cXVheQ=="}}}`,
+		},
+		{
+			name:        "invalid pull secret",
+			pullSecret:  `not json`,
+			expectError: true,
+		},
+		{
+			name:        "pull secret without auths",
+			pullSecret:  `{}`,
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := removeTelemetryPullSecretToken([]byte(test.pullSecret))
+			if test.expectError {
+				if err == nil {
+					t.Errorf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if string(result) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, string(result))
+			}
+		})
+	}
+}
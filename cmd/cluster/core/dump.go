@@ -150,7 +150,7 @@ func dumpGuestCluster(ctx context.Context, opts *DumpOptions) error {
 	if err := c.Get(ctx, client.ObjectKeyFromObject(hostedCluster), hostedCluster); err != nil {
 		return fmt.Errorf("failed to get hosted cluster %s/%s: %w", opts.Namespace, opts.Name, err)
 	}
-	cpNamespace := manifests.HostedControlPlaneNamespace(opts.Namespace, opts.Name)
+	cpNamespace := manifests.HostedControlPlaneNamespaceFor(hostedCluster)
 	localPort := rand.Intn(45000-32767) + 32767
 	kubeconfigFileName, err := createGuestKubeconfig(ctx, c, cpNamespace, localPort, opts.Log)
 	if err != nil {
@@ -321,7 +321,13 @@ func DumpCluster(ctx context.Context, opts *DumpOptions) error {
 
 	cmd.Run(ctx, objectType(&corev1.Node{}))
 
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespace(opts.Namespace, opts.Name)
+	// The dump must follow the control-plane-namespace annotation if set; on
+	// failure the zero-valued cluster yields the derived default namespace.
+	hostedCluster := &hyperv1.HostedCluster{ObjectMeta: metav1.ObjectMeta{Namespace: opts.Namespace, Name: opts.Name}}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(hostedCluster), hostedCluster); err != nil {
+		opts.Log.Error(err, "Cannot get hosted cluster")
+	}
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceFor(hostedCluster)
 
 	kubevirtExternalInfraClusters, localKubevirtInUse := shouldDumpKubevirt(nodePools)
 
@@ -330,6 +336,7 @@ func DumpCluster(ctx context.Context, opts *DumpOptions) error {
 		&capiv1.MachineDeployment{},
 		&capiv1.Machine{},
 		&capiv1.MachineSet{},
+		&capiv1.MachineHealthCheck{},
 		&hyperv1.HostedControlPlane{},
 		&capiaws.AWSMachine{},
 		&capiaws.AWSMachineTemplate{},
@@ -0,0 +1,206 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ClusterTemplate is a file-based profile of create options, e.g. a
+// standardized small/medium/large cluster tier. Every field is optional;
+// unset fields leave the corresponding option untouched and explicitly given
+// CLI flags always take precedence over template values.
+type ClusterTemplate struct {
+	// ControlPlaneAvailabilityPolicy seeds --control-plane-availability-policy.
+	ControlPlaneAvailabilityPolicy string `json:"controlPlaneAvailabilityPolicy,omitempty"`
+	// InfrastructureAvailabilityPolicy seeds --infra-availability-policy.
+	InfrastructureAvailabilityPolicy string `json:"infrastructureAvailabilityPolicy,omitempty"`
+	// NetworkType seeds --network-type.
+	NetworkType string `json:"networkType,omitempty"`
+	// ServiceCIDR seeds --service-cidr.
+	ServiceCIDR []string `json:"serviceCIDR,omitempty"`
+	// ClusterCIDR seeds --cluster-cidr.
+	ClusterCIDR []string `json:"clusterCIDR,omitempty"`
+	// NodePoolReplicas seeds --node-pool-replicas.
+	NodePoolReplicas *int32 `json:"nodePoolReplicas,omitempty"`
+	// ReleaseImage seeds --release-image.
+	ReleaseImage string `json:"releaseImage,omitempty"`
+	// ReleaseStream seeds --release-stream.
+	ReleaseStream string `json:"releaseStream,omitempty"`
+	// Arch seeds --arch.
+	Arch string `json:"arch,omitempty"`
+	// FIPS seeds --fips.
+	FIPS *bool `json:"fips,omitempty"`
+	// AutoRepair seeds --auto-repair.
+	AutoRepair *bool `json:"autoRepair,omitempty"`
+	// EtcdStorageClass seeds --etcd-storage-class.
+	EtcdStorageClass string `json:"etcdStorageClass,omitempty"`
+	// Annotations seeds --annotations as key=value pairs.
+	Annotations []string `json:"annotations,omitempty"`
+	// AWS carries the AWS specific parts of the profile.
+	AWS *AWSClusterTemplate `json:"aws,omitempty"`
+}
+
+// AWSClusterTemplate is the AWS specific part of a ClusterTemplate.
+type AWSClusterTemplate struct {
+	// InstanceType seeds --instance-type.
+	InstanceType string `json:"instanceType,omitempty"`
+	// InstanceTypes seeds --node-pool-instance-types.
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+	// RootVolumeType seeds --root-volume-type.
+	RootVolumeType string `json:"rootVolumeType,omitempty"`
+	// RootVolumeSize seeds --root-volume-size.
+	RootVolumeSize *int64 `json:"rootVolumeSize,omitempty"`
+	// RootVolumeIOPS seeds --root-volume-iops.
+	RootVolumeIOPS *int64 `json:"rootVolumeIOPS,omitempty"`
+	// Zones seeds --zones.
+	Zones []string `json:"zones,omitempty"`
+	// AdditionalTags seeds --additional-tags as key=value pairs.
+	AdditionalTags []string `json:"additionalTags,omitempty"`
+	// EndpointAccess seeds --endpoint-access.
+	EndpointAccess string `json:"endpointAccess,omitempty"`
+	// Tenancy seeds --node-pool-tenancy.
+	Tenancy string `json:"tenancy,omitempty"`
+}
+
+// LoadClusterTemplate reads and validates the cluster template at the given
+// path. Unknown fields are rejected so typos do not silently drop values.
+func LoadClusterTemplate(path string) (*ClusterTemplate, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cluster template: %w", err)
+	}
+	template := &ClusterTemplate{}
+	if err := yaml.UnmarshalStrict(raw, template); err != nil {
+		return nil, fmt.Errorf("failed to parse cluster template %s: %w", path, err)
+	}
+	if err := template.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cluster template %s: %w", path, err)
+	}
+	return template, nil
+}
+
+// Validate checks the template values against the same constraints the
+// corresponding flags enforce.
+func (t *ClusterTemplate) Validate() error {
+	for _, policy := range []string{t.ControlPlaneAvailabilityPolicy, t.InfrastructureAvailabilityPolicy} {
+		switch policy {
+		case "", "SingleReplica", "HighlyAvailable":
+		default:
+			return fmt.Errorf("invalid availability policy %q, must be SingleReplica or HighlyAvailable", policy)
+		}
+	}
+	switch t.NetworkType {
+	case "", "OVNKubernetes", "OpenShiftSDN", "Calico", "Other":
+	default:
+		return fmt.Errorf("invalid network type %q", t.NetworkType)
+	}
+	switch t.Arch {
+	case "", "amd64", "arm64":
+	default:
+		return fmt.Errorf("invalid arch %q, must be amd64 or arm64", t.Arch)
+	}
+	if t.NodePoolReplicas != nil && *t.NodePoolReplicas < 0 {
+		return fmt.Errorf("invalid node pool replicas %d, must not be negative", *t.NodePoolReplicas)
+	}
+	for _, annotation := range t.Annotations {
+		if !strings.Contains(annotation, "=") {
+			return fmt.Errorf("invalid annotation %q, must be in key=value format", annotation)
+		}
+	}
+	if t.AWS != nil {
+		switch t.AWS.EndpointAccess {
+		case "", "Public", "PublicAndPrivate", "Private":
+		default:
+			return fmt.Errorf("invalid endpoint access %q, must be Public, PublicAndPrivate or Private", t.AWS.EndpointAccess)
+		}
+		switch t.AWS.Tenancy {
+		case "", "default", "dedicated", "host":
+		default:
+			return fmt.Errorf("invalid tenancy %q, must be default, dedicated or host", t.AWS.Tenancy)
+		}
+		for _, tag := range t.AWS.AdditionalTags {
+			if !strings.Contains(tag, "=") {
+				return fmt.Errorf("invalid additional tag %q, must be in key=value format", tag)
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyTo seeds the create options with the template values. flagChanged
+// reports whether a flag was explicitly set on the command line; such flags
+// keep their value.
+func (t *ClusterTemplate) ApplyTo(opts *CreateOptions, flagChanged func(name string) bool) {
+	if t.ControlPlaneAvailabilityPolicy != "" && !flagChanged("control-plane-availability-policy") {
+		opts.ControlPlaneAvailabilityPolicy = t.ControlPlaneAvailabilityPolicy
+	}
+	if t.InfrastructureAvailabilityPolicy != "" && !flagChanged("infra-availability-policy") {
+		opts.InfrastructureAvailabilityPolicy = t.InfrastructureAvailabilityPolicy
+	}
+	if t.NetworkType != "" && !flagChanged("network-type") {
+		opts.NetworkType = t.NetworkType
+	}
+	if len(t.ServiceCIDR) > 0 && !flagChanged("service-cidr") {
+		opts.ServiceCIDR = t.ServiceCIDR
+	}
+	if len(t.ClusterCIDR) > 0 && !flagChanged("cluster-cidr") {
+		opts.ClusterCIDR = t.ClusterCIDR
+	}
+	if t.NodePoolReplicas != nil && !flagChanged("node-pool-replicas") {
+		opts.NodePoolReplicas = *t.NodePoolReplicas
+	}
+	if t.ReleaseImage != "" && !flagChanged("release-image") {
+		opts.ReleaseImage = t.ReleaseImage
+	}
+	if t.ReleaseStream != "" && !flagChanged("release-stream") {
+		opts.ReleaseStream = t.ReleaseStream
+	}
+	if t.Arch != "" && !flagChanged("arch") {
+		opts.Arch = t.Arch
+	}
+	if t.FIPS != nil && !flagChanged("fips") {
+		opts.FIPS = *t.FIPS
+	}
+	if t.AutoRepair != nil && !flagChanged("auto-repair") {
+		opts.AutoRepair = *t.AutoRepair
+	}
+	if t.EtcdStorageClass != "" && !flagChanged("etcd-storage-class") {
+		opts.EtcdStorageClass = t.EtcdStorageClass
+	}
+	if len(t.Annotations) > 0 && !flagChanged("annotations") {
+		opts.Annotations = t.Annotations
+	}
+	if t.AWS == nil {
+		return
+	}
+	if t.AWS.InstanceType != "" && !flagChanged("instance-type") {
+		opts.AWSPlatform.InstanceType = t.AWS.InstanceType
+	}
+	if len(t.AWS.InstanceTypes) > 0 && !flagChanged("node-pool-instance-types") {
+		opts.AWSPlatform.InstanceTypes = t.AWS.InstanceTypes
+	}
+	if t.AWS.RootVolumeType != "" && !flagChanged("root-volume-type") {
+		opts.AWSPlatform.RootVolumeType = t.AWS.RootVolumeType
+	}
+	if t.AWS.RootVolumeSize != nil && !flagChanged("root-volume-size") {
+		opts.AWSPlatform.RootVolumeSize = *t.AWS.RootVolumeSize
+	}
+	if t.AWS.RootVolumeIOPS != nil && !flagChanged("root-volume-iops") {
+		opts.AWSPlatform.RootVolumeIOPS = *t.AWS.RootVolumeIOPS
+	}
+	if len(t.AWS.Zones) > 0 && !flagChanged("zones") {
+		opts.AWSPlatform.Zones = t.AWS.Zones
+	}
+	if len(t.AWS.AdditionalTags) > 0 && !flagChanged("additional-tags") {
+		opts.AWSPlatform.AdditionalTags = t.AWS.AdditionalTags
+	}
+	if t.AWS.EndpointAccess != "" && !flagChanged("endpoint-access") {
+		opts.AWSPlatform.EndpointAccess = t.AWS.EndpointAccess
+	}
+	if t.AWS.Tenancy != "" && !flagChanged("node-pool-tenancy") {
+		opts.AWSPlatform.Tenancy = t.AWS.Tenancy
+	}
+}
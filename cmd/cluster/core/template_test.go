@@ -0,0 +1,89 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/utils/pointer"
+)
+
+func writeTemplate(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "template.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write template file: %v", err)
+	}
+	return path
+}
+
+func TestLoadClusterTemplate(t *testing.T) {
+	path := writeTemplate(t, `
+controlPlaneAvailabilityPolicy: HighlyAvailable
+nodePoolReplicas: 3
+aws:
+  instanceType: m5.xlarge
+  zones:
+  - us-east-1a
+  - us-east-1b
+`)
+	template, err := LoadClusterTemplate(path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if template.ControlPlaneAvailabilityPolicy != "HighlyAvailable" {
+		t.Errorf("unexpected availability policy %q", template.ControlPlaneAvailabilityPolicy)
+	}
+	if template.NodePoolReplicas == nil || *template.NodePoolReplicas != 3 {
+		t.Errorf("unexpected node pool replicas %v", template.NodePoolReplicas)
+	}
+	if template.AWS == nil || template.AWS.InstanceType != "m5.xlarge" || len(template.AWS.Zones) != 2 {
+		t.Errorf("unexpected aws template %+v", template.AWS)
+	}
+
+	if _, err := LoadClusterTemplate(writeTemplate(t, "unknownField: true\n")); err == nil || !strings.Contains(err.Error(), "failed to parse") {
+		t.Errorf("expected unknown field error, got %v", err)
+	}
+	if _, err := LoadClusterTemplate(writeTemplate(t, "networkType: NotANetwork\n")); err == nil || !strings.Contains(err.Error(), "invalid cluster template") {
+		t.Errorf("expected validation error, got %v", err)
+	}
+	if _, err := LoadClusterTemplate(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("expected error for a missing file")
+	}
+}
+
+func TestClusterTemplateApplyTo(t *testing.T) {
+	template := &ClusterTemplate{
+		ControlPlaneAvailabilityPolicy:   "HighlyAvailable",
+		InfrastructureAvailabilityPolicy: "HighlyAvailable",
+		NodePoolReplicas:                 pointer.Int32(3),
+		ServiceCIDR:                      []string{"172.32.0.0/16"},
+		AWS: &AWSClusterTemplate{
+			InstanceType: "m5.xlarge",
+			Zones:        []string{"us-east-1a"},
+		},
+	}
+	opts := &CreateOptions{
+		ControlPlaneAvailabilityPolicy: "SingleReplica",
+		ServiceCIDR:                    []string{"172.31.0.0/16"},
+	}
+	changedFlags := map[string]bool{"control-plane-availability-policy": true}
+	template.ApplyTo(opts, func(name string) bool { return changedFlags[name] })
+
+	if opts.ControlPlaneAvailabilityPolicy != "SingleReplica" {
+		t.Errorf("explicitly set flag was overridden, got %q", opts.ControlPlaneAvailabilityPolicy)
+	}
+	if opts.InfrastructureAvailabilityPolicy != "HighlyAvailable" {
+		t.Errorf("template value was not applied, got %q", opts.InfrastructureAvailabilityPolicy)
+	}
+	if opts.NodePoolReplicas != 3 {
+		t.Errorf("expected 3 node pool replicas, got %d", opts.NodePoolReplicas)
+	}
+	if len(opts.ServiceCIDR) != 1 || opts.ServiceCIDR[0] != "172.32.0.0/16" {
+		t.Errorf("unexpected service CIDR %v", opts.ServiceCIDR)
+	}
+	if opts.AWSPlatform.InstanceType != "m5.xlarge" || len(opts.AWSPlatform.Zones) != 1 {
+		t.Errorf("unexpected aws options %+v", opts.AWSPlatform)
+	}
+}
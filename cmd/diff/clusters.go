@@ -0,0 +1,211 @@
+package diff
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/cmd/util"
+	hyperapi "github.com/openshift/hypershift/support/api"
+)
+
+type ClusterOptions struct {
+	Filenames []string
+	Cluster   string
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "diff",
+		Short:        "Compares desired HyperShift manifests against live state",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(NewDiffClusterCommand())
+	return cmd
+}
+
+func NewDiffClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "cluster",
+		Short:        "Compares HostedCluster and NodePool manifests against the live objects",
+		SilenceUsage: true,
+	}
+
+	opts := ClusterOptions{}
+
+	cmd.Flags().StringArrayVarP(&opts.Filenames, "filename", "f", opts.Filenames, "A manifest file or directory with HostedCluster and NodePool manifests. Can be specified multiple times.")
+	cmd.Flags().StringVar(&opts.Cluster, "cluster", opts.Cluster, "If set to a namespace/name, only diff that HostedCluster and its NodePools.")
+	_ = cmd.MarkFlagRequired("filename")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return DiffCluster(cmd.Context(), &opts, os.Stdout)
+	}
+
+	return cmd
+}
+
+func DiffCluster(ctx context.Context, opts *ClusterOptions, out io.Writer) error {
+	client, err := util.GetClient()
+	if err != nil {
+		return err
+	}
+	return diffCluster(ctx, client, opts, out)
+}
+
+// diffCluster compares the spec of every HostedCluster and NodePool manifest
+// against the live object. Comparing specs only keeps status and other
+// operator-managed fields out of the diff.
+func diffCluster(ctx context.Context, client crclient.Client, opts *ClusterOptions, out io.Writer) error {
+	objects, err := readManifests(opts.Filenames)
+	if err != nil {
+		return err
+	}
+
+	drift := false
+	for _, obj := range objects {
+		var header, diff string
+		switch desired := obj.(type) {
+		case *hyperv1.HostedCluster:
+			if !matchesCluster(opts.Cluster, desired.Namespace, desired.Name) {
+				continue
+			}
+			header = fmt.Sprintf("HostedCluster %s/%s", desired.Namespace, desired.Name)
+			live := &hyperv1.HostedCluster{}
+			if err := client.Get(ctx, crclient.ObjectKeyFromObject(desired), live); err != nil {
+				if apierrors.IsNotFound(err) {
+					drift = true
+					fmt.Fprintf(out, "%s: does not exist\n", header)
+					continue
+				}
+				return fmt.Errorf("failed to get live object for %s: %w", header, err)
+			}
+			diff = cmp.Diff(live.Spec, desired.Spec)
+		case *hyperv1.NodePool:
+			if !matchesCluster(opts.Cluster, desired.Namespace, desired.Spec.ClusterName) {
+				continue
+			}
+			header = fmt.Sprintf("NodePool %s/%s", desired.Namespace, desired.Name)
+			live := &hyperv1.NodePool{}
+			if err := client.Get(ctx, crclient.ObjectKeyFromObject(desired), live); err != nil {
+				if apierrors.IsNotFound(err) {
+					drift = true
+					fmt.Fprintf(out, "%s: does not exist\n", header)
+					continue
+				}
+				return fmt.Errorf("failed to get live object for %s: %w", header, err)
+			}
+			diff = cmp.Diff(live.Spec, desired.Spec)
+		default:
+			// Kinds other than HostedCluster and NodePool are not diffed.
+			continue
+		}
+		if diff == "" {
+			continue
+		}
+		drift = true
+		fmt.Fprintf(out, "%s (-live +desired):\n%s\n", header, diff)
+	}
+
+	if !drift {
+		fmt.Fprintln(out, "No differences found")
+	}
+	return nil
+}
+
+// matchesCluster reports whether a namespace/name pair matches the --cluster
+// filter. An empty filter matches everything.
+func matchesCluster(filter, namespace, name string) bool {
+	if filter == "" {
+		return true
+	}
+	return filter == fmt.Sprintf("%s/%s", namespace, name)
+}
+
+// readManifests decodes the manifests in the given files and directories.
+// Documents with a kind that is not registered in the HyperShift scheme are
+// ignored.
+func readManifests(paths []string) ([]crclient.Object, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			switch filepath.Ext(entry.Name()) {
+			case ".yaml", ".yml", ".json":
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	var objects []crclient.Object
+	for _, file := range files {
+		fileObjects, err := readManifestFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifests from %s: %w", file, err)
+		}
+		objects = append(objects, fileObjects...)
+	}
+	return objects, nil
+}
+
+func readManifestFile(path string) ([]crclient.Object, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var objects []crclient.Object
+	reader := yamlutil.NewYAMLReader(bufio.NewReader(file))
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		obj, _, err := hyperapi.TolerantYAMLSerializer.Decode(doc, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				continue
+			}
+			return nil, err
+		}
+		clientObject, ok := obj.(crclient.Object)
+		if !ok {
+			continue
+		}
+		objects = append(objects, clientObject)
+	}
+	return objects, nil
+}
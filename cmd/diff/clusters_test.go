@@ -0,0 +1,100 @@
+package diff
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	hyperapi "github.com/openshift/hypershift/support/api"
+)
+
+func TestMatchesCluster(t *testing.T) {
+	if !matchesCluster("", "clusters", "example") {
+		t.Error("expected an empty filter to match everything")
+	}
+	if !matchesCluster("clusters/example", "clusters", "example") {
+		t.Error("expected clusters/example to match")
+	}
+	if matchesCluster("clusters/example", "clusters", "other") {
+		t.Error("expected clusters/other not to match")
+	}
+}
+
+func TestDiffCluster(t *testing.T) {
+	live := &hyperv1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example"},
+		Spec: hyperv1.HostedClusterSpec{
+			Release: hyperv1.Release{Image: "old-image"},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).WithObjects(live).Build()
+
+	manifestDir := t.TempDir()
+	manifest := `apiVersion: hypershift.openshift.io/v1beta1
+kind: HostedCluster
+metadata:
+  namespace: clusters
+  name: example
+spec:
+  release:
+    image: new-image
+`
+	if err := os.WriteFile(filepath.Join(manifestDir, "cluster.yaml"), []byte(manifest), 0644); err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+
+	var out bytes.Buffer
+	opts := &ClusterOptions{Filenames: []string{manifestDir}}
+	if err := diffCluster(context.Background(), client, opts, &out); err != nil {
+		t.Fatalf("diffCluster failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "HostedCluster clusters/example (-live +desired):") {
+		t.Errorf("expected a diff header, got: %s", out.String())
+	}
+	if !strings.Contains(out.String(), "new-image") || !strings.Contains(out.String(), "old-image") {
+		t.Errorf("expected the diff to include both images, got: %s", out.String())
+	}
+
+	// A filter for another cluster hides the drift.
+	out.Reset()
+	opts.Cluster = "clusters/other"
+	if err := diffCluster(context.Background(), client, opts, &out); err != nil {
+		t.Fatalf("diffCluster failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "No differences found") {
+		t.Errorf("expected no differences for a non-matching filter, got: %s", out.String())
+	}
+}
+
+func TestDiffClusterMissingLiveObject(t *testing.T) {
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).Build()
+
+	manifestDir := t.TempDir()
+	manifest := `apiVersion: hypershift.openshift.io/v1beta1
+kind: NodePool
+metadata:
+  namespace: clusters
+  name: example-pool
+spec:
+  clusterName: example
+`
+	if err := os.WriteFile(filepath.Join(manifestDir, "nodepool.yaml"), []byte(manifest), 0644); err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+
+	var out bytes.Buffer
+	opts := &ClusterOptions{Filenames: []string{manifestDir}}
+	if err := diffCluster(context.Background(), client, opts, &out); err != nil {
+		t.Fatalf("diffCluster failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "NodePool clusters/example-pool: does not exist") {
+		t.Errorf("expected a missing object message, got: %s", out.String())
+	}
+}
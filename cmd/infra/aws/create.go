@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -36,8 +38,11 @@ type CreateInfraOptions struct {
 	OutputFile         string
 	AdditionalTags     []string
 	EnableProxy        bool
+	ProxyUsername      string
+	ProxyPassword      string
 	SSHKeyFile         string
 	SingleNATGateway   bool
+	ServiceEndpoints   []string
 
 	additionalEC2Tags []*ec2.Tag
 }
@@ -95,7 +100,10 @@ func NewCreateCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.BaseDomainPrefix, "base-domain-prefix", opts.BaseDomainPrefix, "The ingress base domain prefix for the cluster, defaults to cluster name. Use 'none' for an empty prefix")
 	cmd.Flags().StringSliceVar(&opts.Zones, "zones", opts.Zones, "The availability zones in which NodePool can be created")
 	cmd.Flags().BoolVar(&opts.EnableProxy, "enable-proxy", opts.EnableProxy, "If a proxy should be set up, rather than allowing direct internet access from the nodes")
+	cmd.Flags().StringVar(&opts.ProxyUsername, "proxy-username", opts.ProxyUsername, "The username the proxy created with --enable-proxy requires for authentication. Requires --proxy-password")
+	cmd.Flags().StringVar(&opts.ProxyPassword, "proxy-password", opts.ProxyPassword, "The password the proxy created with --enable-proxy requires for authentication. Requires --proxy-username")
 	cmd.Flags().BoolVar(&opts.SingleNATGateway, "single-nat-gateway", opts.SingleNATGateway, "If enabled, only a single NAT gateway is created, even if multiple zones are specified")
+	cmd.Flags().StringSliceVar(&opts.ServiceEndpoints, "service-endpoint", opts.ServiceEndpoints, "Custom service endpoint overrides in name=url format, e.g. ec2=https://ec2.us-gov-east-1.amazonaws.com")
 
 	cmd.MarkFlagRequired("infra-id")
 	cmd.MarkFlagRequired("aws-creds")
@@ -142,12 +150,19 @@ func (o *CreateInfraOptions) Run(ctx context.Context, l logr.Logger) error {
 func (o *CreateInfraOptions) CreateInfra(ctx context.Context, l logr.Logger) (*CreateInfraOutput, error) {
 	l.Info("Creating infrastructure", "id", o.InfraID)
 
-	awsSession := awsutil.NewSession("cli-create-infra", o.AWSCredentialsFile, o.AWSKey, o.AWSSecretKey, o.Region)
+	if err := ValidateProxyCredentials(o.EnableProxy, o.ProxyUsername, o.ProxyPassword); err != nil {
+		return nil, err
+	}
+
+	serviceEndpoints, err := awsutil.ParseServiceEndpoints(o.ServiceEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	awsSession := awsutil.NewSessionWithEndpoints("cli-create-infra", o.AWSCredentialsFile, o.AWSKey, o.AWSSecretKey, o.Region, serviceEndpoints)
 	ec2Client := ec2.New(awsSession, awsutil.NewConfig())
 	route53Client := route53.New(awsSession, awsutil.NewAWSRoute53Config())
 
-	var err error
-	if err = o.parseAdditionalTags(); err != nil {
+	if err := o.parseAdditionalTags(); err != nil {
 		return nil, err
 	}
 
@@ -321,12 +336,16 @@ func (o *CreateInfraOptions) createProxyHost(ctx context.Context, l logr.Logger,
 	}
 	l.Info("Authorized security group for proxy")
 
+	userData := fmt.Sprintf(proxyConfigurationScript, sshKeys)
+	if o.ProxyUsername != "" {
+		userData = fmt.Sprintf(authenticatedProxyConfigurationScript, o.ProxyUsername, o.ProxyPassword, sshKeys)
+	}
 	result, err := client.RunInstancesWithContext(ctx, &ec2.RunInstancesInput{
 		ImageId:      aws.String("resolve:ssm:/aws/service/ami-amazon-linux-latest/amzn2-ami-hvm-x86_64-gp2"),
 		MaxCount:     aws.Int64(1),
 		MinCount:     aws.Int64(1),
 		InstanceType: aws.String("t2.micro"),
-		UserData:     aws.String(base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(proxyConfigurationScript, sshKeys)))),
+		UserData:     aws.String(base64.StdEncoding.EncodeToString([]byte(userData))),
 		NetworkInterfaces: []*ec2.InstanceNetworkInterfaceSpecification{
 			{
 				DeviceIndex:              aws.Int64(0),
@@ -340,9 +359,44 @@ func (o *CreateInfraOptions) createProxyHost(ctx context.Context, l logr.Logger,
 	if err != nil {
 		return "", fmt.Errorf("failed to launch proxy host: %w", err)
 	}
-	l.Info("Created proxy host")
 
-	return fmt.Sprintf("http://%s:3128", *result.Instances[0].PrivateIpAddress), nil
+	proxyAddr := fmt.Sprintf("http://%s:3128", *result.Instances[0].PrivateIpAddress)
+	if o.ProxyUsername != "" {
+		proxyAddr = fmt.Sprintf("http://%s:%s@%s:3128", o.ProxyUsername, o.ProxyPassword, *result.Instances[0].PrivateIpAddress)
+	}
+	l.Info("Created proxy host", "address", RedactedProxyURL(proxyAddr))
+
+	return proxyAddr, nil
+}
+
+// ValidateProxyCredentials verifies that proxy credentials are either absent or
+// come as a username/password pair whose characters can be embedded verbatim in
+// the userinfo section of the proxy URL.
+func ValidateProxyCredentials(enableProxy bool, username, password string) error {
+	if username == "" && password == "" {
+		return nil
+	}
+	if !enableProxy {
+		return errors.New("proxy credentials require --enable-proxy")
+	}
+	if username == "" || password == "" {
+		return errors.New("both a proxy username and a proxy password must be specified")
+	}
+	for _, credential := range []string{username, password} {
+		if strings.ContainsAny(credential, ":/@ \t") {
+			return fmt.Errorf("proxy credentials may not contain ':', '/', '@' or whitespace")
+		}
+	}
+	return nil
+}
+
+// RedactedProxyURL replaces any credentials in the given proxy URL so it can be
+// included in logs.
+func RedactedProxyURL(addr string) string {
+	if u, err := url.Parse(addr); err == nil && u.User != nil {
+		return u.Redacted()
+	}
+	return addr
 }
 
 func ec2Backoff() wait.Backoff {
@@ -376,3 +430,18 @@ echo -e '%s' >/home/ec2-user/.ssh/authorized_keys
 chmod 0600 /home/ec2-user/.ssh/authorized_keys
 chown -R ec2-user:ec2-user /home/ec2-user/.ssh
 `
+
+const authenticatedProxyConfigurationScript = `#!/bin/bash
+yum install -y squid httpd-tools
+htpasswd -bc /etc/squid/passwords '%s' '%s'
+# By default, squid only allows connect on port 443
+sed -E 's/(^http_access deny CONNECT.*)/#\1/' -i /etc/squid/squid.conf
+# Require basic auth for all requests
+sed -E '/^http_access deny all/i auth_param basic program /usr/lib64/squid/basic_ncsa_auth /etc/squid/passwords\nacl authenticated proxy_auth REQUIRED\nhttp_access allow authenticated\nhttp_access deny !authenticated' -i /etc/squid/squid.conf
+systemctl enable --now squid
+mkdir -p /home/ec2-user/.ssh
+chmod 0700 /home/ec2-user/.ssh
+echo -e '%s' >/home/ec2-user/.ssh/authorized_keys
+chmod 0600 /home/ec2-user/.ssh/authorized_keys
+chown -R ec2-user:ec2-user /home/ec2-user/.ssh
+`
@@ -34,9 +34,12 @@ type CreateIAMOptions struct {
 	LocalZoneID                     string
 	InfraID                         string
 	IssuerURL                       string
+	OIDCProviderARN                 string
 	OutputFile                      string
 	KMSKeyARN                       string
 	AdditionalTags                  []string
+	ServiceEndpoints                []string
+	Partition                       string
 
 	additionalIAMTags []*iam.Tag
 }
@@ -69,6 +72,7 @@ func NewCreateIAMCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.OIDCStorageProviderS3BucketName, "oidc-storage-provider-s3-bucket-name", "", "The name of the bucket in which the OIDC discovery document is stored")
 	cmd.Flags().StringVar(&opts.OIDCStorageProviderS3Region, "oidc-storage-provider-s3-region", "", "The region of the bucket in which the OIDC discovery document is stored")
 	cmd.Flags().StringVar(&opts.IssuerURL, "oidc-issuer-url", "", "The OIDC provider issuer URL")
+	cmd.Flags().StringVar(&opts.OIDCProviderARN, "oidc-provider-arn", "", "The ARN of an existing IAM OIDC provider to reuse instead of creating one. The provider is validated and never deleted on destroy. When --oidc-issuer-url is also given it must match the provider's issuer.")
 	cmd.Flags().StringVar(&opts.Region, "region", opts.Region, "Region where cluster infra should be created")
 	cmd.Flags().StringVar(&opts.OutputFile, "output-file", opts.OutputFile, "Path to file that will contain output information from infra resources (optional)")
 	cmd.Flags().StringVar(&opts.PublicZoneID, "public-zone-id", opts.PublicZoneID, "The id of the clusters public route53 zone")
@@ -76,6 +80,8 @@ func NewCreateIAMCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.LocalZoneID, "local-zone-id", opts.LocalZoneID, "The id of the clusters local route53 zone")
 	cmd.Flags().StringVar(&opts.KMSKeyARN, "kms-key-arn", opts.KMSKeyARN, "The ARN of the KMS key to use for Etcd encryption. If not supplied, etcd encryption will default to using a generated AESCBC key.")
 	cmd.Flags().StringSliceVar(&opts.AdditionalTags, "additional-tags", opts.AdditionalTags, "Additional tags to set on AWS resources")
+	cmd.Flags().StringSliceVar(&opts.ServiceEndpoints, "service-endpoint", opts.ServiceEndpoints, "Custom service endpoint overrides in name=url format, e.g. iam=https://iam.us-gov.amazonaws.com")
+	cmd.Flags().StringVar(&opts.Partition, "partition", opts.Partition, "The AWS partition in which IAM resources are created, e.g. aws-us-gov. Defaults to the partition of the region")
 
 	cmd.MarkFlagRequired("aws-creds")
 	cmd.MarkFlagRequired("infra-id")
@@ -155,7 +161,14 @@ func (o *CreateIAMOptions) CreateIAM(ctx context.Context, client crclient.Client
 		return nil, err
 	}
 
-	awsSession := awsutil.NewSession("cli-create-iam", o.AWSCredentialsFile, o.AWSKey, o.AWSSecretKey, o.Region)
+	serviceEndpoints, err := awsutil.ParseServiceEndpoints(o.ServiceEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	if o.Partition, err = awsutil.PartitionForRegion(o.Partition, o.Region); err != nil {
+		return nil, err
+	}
+	awsSession := awsutil.NewSessionWithEndpoints("cli-create-iam", o.AWSCredentialsFile, o.AWSKey, o.AWSSecretKey, o.Region, serviceEndpoints)
 	awsConfig := awsutil.NewConfig()
 	iamClient := iam.New(awsSession, awsConfig)
 
@@ -39,6 +39,7 @@ type DestroyInfraOptions struct {
 	BaseDomain          string
 	BaseDomainPrefix    string
 	AwsInfraGracePeriod time.Duration
+	ServiceEndpoints    []string
 	Log                 logr.Logger
 }
 
@@ -62,6 +63,7 @@ func NewDestroyCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.BaseDomain, "base-domain", opts.BaseDomain, "The ingress base domain for the cluster")
 	cmd.Flags().StringVar(&opts.BaseDomainPrefix, "base-domain-prefix", opts.BaseDomainPrefix, "The ingress base domain prefix for the cluster, defaults to cluster name. se 'none' for an empty prefix")
 	cmd.Flags().DurationVar(&opts.AwsInfraGracePeriod, "aws-infra-grace-period", opts.AwsInfraGracePeriod, "Timeout for destroying infrastructure in minutes")
+	cmd.Flags().StringSliceVar(&opts.ServiceEndpoints, "service-endpoint", opts.ServiceEndpoints, "Custom service endpoint overrides in name=url format, e.g. ec2=https://ec2.us-gov-east-1.amazonaws.com")
 
 	cmd.MarkFlagRequired("infra-id")
 	cmd.MarkFlagRequired("aws-creds")
@@ -106,7 +108,11 @@ func (o *DestroyInfraOptions) Run(ctx context.Context) error {
 }
 
 func (o *DestroyInfraOptions) DestroyInfra(ctx context.Context) error {
-	awsSession := awsutil.NewSession("cli-destroy-infra", o.AWSCredentialsFile, o.AWSKey, o.AWSSecretKey, o.Region)
+	serviceEndpoints, err := awsutil.ParseServiceEndpoints(o.ServiceEndpoints)
+	if err != nil {
+		return err
+	}
+	awsSession := awsutil.NewSessionWithEndpoints("cli-destroy-infra", o.AWSCredentialsFile, o.AWSKey, o.AWSSecretKey, o.Region, serviceEndpoints)
 	awsConfig := awsutil.NewConfig()
 	ec2Client := ec2.New(awsSession, awsConfig)
 	elbClient := elb.New(awsSession, awsConfig)
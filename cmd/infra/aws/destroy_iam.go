@@ -24,6 +24,7 @@ type DestroyIAMOptions struct {
 	AWSKey             string
 	AWSSecretKey       string
 	InfraID            string
+	ServiceEndpoints   []string
 	Log                logr.Logger
 }
 
@@ -44,6 +45,7 @@ func NewDestroyIAMCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.AWSCredentialsFile, "aws-creds", opts.AWSCredentialsFile, "Path to an AWS credentials file (required)")
 	cmd.Flags().StringVar(&opts.InfraID, "infra-id", opts.InfraID, "Infrastructure ID to use for AWS resources.")
 	cmd.Flags().StringVar(&opts.Region, "region", opts.Region, "Region where cluster infra lives")
+	cmd.Flags().StringSliceVar(&opts.ServiceEndpoints, "service-endpoint", opts.ServiceEndpoints, "Custom service endpoint overrides in name=url format, e.g. iam=https://iam.us-gov.amazonaws.com")
 
 	cmd.MarkFlagRequired("aws-creds")
 	cmd.MarkFlagRequired("infra-id")
@@ -74,11 +76,14 @@ func (o *DestroyIAMOptions) Run(ctx context.Context) error {
 }
 
 func (o *DestroyIAMOptions) DestroyIAM(ctx context.Context) error {
-	awsSession := awsutil.NewSession("cli-destroy-iam", o.AWSCredentialsFile, o.AWSKey, o.AWSSecretKey, o.Region)
+	serviceEndpoints, err := awsutil.ParseServiceEndpoints(o.ServiceEndpoints)
+	if err != nil {
+		return err
+	}
+	awsSession := awsutil.NewSessionWithEndpoints("cli-destroy-iam", o.AWSCredentialsFile, o.AWSKey, o.AWSSecretKey, o.Region, serviceEndpoints)
 	awsConfig := awsutil.NewConfig()
 	iamClient := iam.New(awsSession, awsConfig)
 
-	var err error
 	err = o.DestroyOIDCResources(ctx, iamClient)
 	if err != nil {
 		return err
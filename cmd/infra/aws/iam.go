@@ -296,7 +296,7 @@ const (
 }`
 )
 
-func ingressPermPolicy(publicZone, privateZone string) string {
+func ingressPermPolicy(partition, publicZone, privateZone string) string {
 	publicZone = ensureHostedZonePrefix(publicZone)
 	privateZone = ensureHostedZonePrefix(privateZone)
 	return fmt.Sprintf(`{
@@ -317,15 +317,15 @@ func ingressPermPolicy(publicZone, privateZone string) string {
 				"route53:ChangeResourceRecordSets"
 			],
 			"Resource": [
-				"arn:aws:route53:::%s",
-				"arn:aws:route53:::%s"
+				"arn:%[1]s:route53:::%[2]s",
+				"arn:%[1]s:route53:::%[3]s"
 			]
 		}
 	]
-}`, publicZone, privateZone)
+}`, partition, publicZone, privateZone)
 }
 
-func controlPlaneOperatorPolicy(hostedZone string) string {
+func controlPlaneOperatorPolicy(partition, hostedZone string) string {
 	hostedZone = ensureHostedZonePrefix(hostedZone)
 	return fmt.Sprintf(`{
 	"Version": "2012-10-17",
@@ -356,10 +356,10 @@ func controlPlaneOperatorPolicy(hostedZone string) string {
 				"route53:ChangeResourceRecordSets",
 				"route53:ListResourceRecordSets"
 			],
-			"Resource": "arn:aws:route53:::%s"
+			"Resource": "arn:%s:route53:::%s"
 		}
 	]
-}`, hostedZone)
+}`, partition, hostedZone)
 }
 
 func kmsProviderPolicy(kmsKeyARN string) string {
@@ -399,9 +399,33 @@ func DefaultProfileName(infraID string) string {
 // inputs: none
 // outputs rsa keypair
 func (o *CreateIAMOptions) CreateOIDCResources(iamClient iamiface.IAMAPI) (*CreateIAMOutput, error) {
+	partition := o.Partition
+	if partition == "" {
+		partition = "aws"
+	}
 	var providerName string
 	var providerARN string
-	if o.IssuerURL == "" {
+	if o.OIDCProviderARN != "" {
+		// Reuse a pre-existing provider, e.g. one shared by several clusters
+		// with a common issuer. The provider is validated but never created or
+		// deleted by us.
+		provider, err := iamClient.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(o.OIDCProviderARN),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get OIDC provider %s: %w", o.OIDCProviderARN, err)
+		}
+		providerIssuerURL := "https://" + aws.StringValue(provider.Url)
+		if o.IssuerURL == "" {
+			o.IssuerURL = providerIssuerURL
+			log.Log.Info("Detected Issuer URL from existing OIDC provider", "issuer", o.IssuerURL)
+		} else if o.IssuerURL != providerIssuerURL {
+			return nil, fmt.Errorf("OIDC provider %s serves issuer %s which does not match the given issuer URL %s", o.OIDCProviderARN, providerIssuerURL, o.IssuerURL)
+		}
+		providerName = strings.TrimPrefix(o.IssuerURL, "https://")
+		providerARN = o.OIDCProviderARN
+		log.Log.Info("Reusing existing OIDC provider", "provider", providerARN)
+	} else if o.IssuerURL == "" {
 		o.IssuerURL = oidcDiscoveryURL(o.OIDCStorageProviderS3BucketName, o.OIDCStorageProviderS3Region, o.InfraID)
 		log.Log.Info("Detected Issuer URL", "issuer", o.IssuerURL)
 
@@ -441,7 +465,7 @@ func (o *CreateIAMOptions) CreateOIDCResources(iamClient iamiface.IAMAPI) (*Crea
 	// TODO: The policies and secrets for these roles can be extracted from the
 	// release payload, avoiding this current hardcoding.
 	ingressTrustPolicy := oidcTrustPolicy(providerARN, providerName, "system:serviceaccount:openshift-ingress-operator:ingress-operator")
-	arn, err := o.CreateOIDCRole(iamClient, "openshift-ingress", ingressTrustPolicy, ingressPermPolicy(o.PublicZoneID, o.PrivateZoneID))
+	arn, err := o.CreateOIDCRole(iamClient, "openshift-ingress", ingressTrustPolicy, ingressPermPolicy(partition, o.PublicZoneID, o.PrivateZoneID))
 	if err != nil {
 		return nil, err
 	}
@@ -478,7 +502,7 @@ func (o *CreateIAMOptions) CreateOIDCResources(iamClient iamiface.IAMAPI) (*Crea
 	output.Roles.NodePoolManagementARN = arn
 
 	controlPlaneOperatorTrustPolicy := oidcTrustPolicy(providerARN, providerName, "system:serviceaccount:kube-system:control-plane-operator")
-	arn, err = o.CreateOIDCRole(iamClient, "control-plane-operator", controlPlaneOperatorTrustPolicy, controlPlaneOperatorPolicy(o.LocalZoneID))
+	arn, err = o.CreateOIDCRole(iamClient, "control-plane-operator", controlPlaneOperatorTrustPolicy, controlPlaneOperatorPolicy(partition, o.LocalZoneID))
 	if err != nil {
 		return nil, err
 	}
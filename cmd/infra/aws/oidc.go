@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openshift/hypershift/support/oidc"
+)
+
+const (
+	oidcProviderReadyPollInterval = 10 * time.Second
+	oidcProviderReadyTimeout      = 5 * time.Minute
+)
+
+// oidcDiscoveryDocuments are the paths relative to the issuer URL that must be
+// served before the STS/OIDC flow for the cluster can succeed.
+var oidcDiscoveryDocuments = []string{
+	"/.well-known/openid-configuration",
+	oidc.JWKSURI,
+}
+
+// WaitForOIDCProviderReady polls the OIDC discovery documents under the given
+// issuer URL until they are all served successfully. S3 and CloudFront can take
+// a while to serve freshly uploaded documents, which otherwise surfaces late as
+// service account token authentication failures in the guest cluster.
+func WaitForOIDCProviderReady(ctx context.Context, log logr.Logger, issuerURL string) error {
+	for _, path := range oidcDiscoveryDocuments {
+		documentURL := issuerURL + path
+		log.Info("Waiting for OIDC discovery document", "url", documentURL)
+		var lastError error
+		err := wait.PollImmediateWithContext(ctx, oidcProviderReadyPollInterval, oidcProviderReadyTimeout, func(ctx context.Context) (bool, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, documentURL, nil)
+			if err != nil {
+				return false, err
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				lastError = err
+				return false, nil
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				lastError = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+				return false, nil
+			}
+			return true, nil
+		})
+		if err != nil {
+			if lastError != nil {
+				return fmt.Errorf("OIDC discovery document %s is not being served: %v: %w", documentURL, err, lastError)
+			}
+			return fmt.Errorf("OIDC discovery document %s is not being served: %w", documentURL, err)
+		}
+		log.Info("OIDC discovery document is being served", "url", documentURL)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package util
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	utilpointer "k8s.io/utils/pointer"
@@ -8,11 +11,19 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 )
 
 func NewSession(agent string, credentialsFile string, credKey string, credSecretKey string, region string) *session.Session {
+	return NewSessionWithEndpoints(agent, credentialsFile, credKey, credSecretKey, region, nil)
+}
+
+// NewSessionWithEndpoints is NewSession with custom service endpoints, e.g.
+// for GovCloud or isolated regions. Services without an override resolve to
+// their default endpoint.
+func NewSessionWithEndpoints(agent string, credentialsFile string, credKey string, credSecretKey string, region string, serviceEndpoints map[string]string) *session.Session {
 	sessionOpts := session.Options{}
 	if credentialsFile != "" {
 		sessionOpts.SharedConfigFiles = append(sessionOpts.SharedConfigFiles, credentialsFile)
@@ -23,6 +34,14 @@ func NewSession(agent string, credentialsFile string, credKey string, credSecret
 	if region != "" {
 		sessionOpts.Config.Region = utilpointer.String(region)
 	}
+	if len(serviceEndpoints) > 0 {
+		sessionOpts.Config.EndpointResolver = endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+			if url, ok := serviceEndpoints[service]; ok {
+				return endpoints.ResolvedEndpoint{URL: url, SigningRegion: region}, nil
+			}
+			return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+		})
+	}
 	awsSession := session.Must(session.NewSessionWithOptions(sessionOpts))
 	awsSession.Handlers.Build.PushBackNamed(request.NamedHandler{
 		Name: "openshift.io/hypershift",
@@ -31,6 +50,48 @@ func NewSession(agent string, credentialsFile string, credKey string, credSecret
 	return awsSession
 }
 
+// ParseServiceEndpoints parses name=url service endpoint overrides as passed
+// through --service-endpoint flags.
+func ParseServiceEndpoints(entries []string) (map[string]string, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	serviceEndpoints := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		name, endpoint, found := strings.Cut(entry, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid service endpoint %q, must be in name=url format", entry)
+		}
+		if _, ok := serviceEndpoints[name]; ok {
+			return nil, fmt.Errorf("duplicate service endpoint for %s", name)
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return nil, fmt.Errorf("invalid service endpoint URL %q, must be a https URL", endpoint)
+		}
+		serviceEndpoints[name] = endpoint
+	}
+	return serviceEndpoints, nil
+}
+
+// PartitionForRegion returns the AWS partition the region belongs to,
+// validating any explicitly requested partition against it. Regions the SDK
+// does not know about require an explicit partition and default to the
+// commercial aws partition.
+func PartitionForRegion(partition, region string) (string, error) {
+	resolved, known := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if partition == "" {
+		if !known {
+			return endpoints.AwsPartitionID, nil
+		}
+		return resolved.ID(), nil
+	}
+	if known && resolved.ID() != partition {
+		return "", fmt.Errorf("partition %s is not consistent with region %s, which belongs to partition %s", partition, region, resolved.ID())
+	}
+	return partition, nil
+}
+
 // NewAWSRoute53Config generates an AWS config with slightly different Retryer timings
 func NewAWSRoute53Config() *aws.Config {
 	awsRoute53Config := NewConfig()
@@ -0,0 +1,210 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/blang/semver"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/cmd/util"
+)
+
+type ClustersOptions struct {
+	Namespace string
+	Selector  string
+	Output    string
+	OutOfDate string
+}
+
+// ClusterInfo is a fleet inventory entry for a single HostedCluster.
+type ClusterInfo struct {
+	Namespace           string            `json:"namespace"`
+	Name                string            `json:"name"`
+	Platform            string            `json:"platform"`
+	ControlPlaneVersion string            `json:"controlPlaneVersion,omitempty"`
+	NodePoolVersions    map[string]string `json:"nodePoolVersions,omitempty"`
+	Available           string            `json:"available"`
+	NodeCount           int32             `json:"nodeCount"`
+	Ready               string            `json:"ready"`
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "Lists HyperShift resources",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(NewListClustersCommand())
+	cmd.AddCommand(NewListNodePoolsCommand())
+	return cmd
+}
+
+func NewListClustersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "clusters",
+		Short:        "Lists HostedClusters with their control plane and NodePool versions",
+		SilenceUsage: true,
+	}
+
+	opts := ClustersOptions{}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "A HostedCluster namespace. Defaults to all namespaces.")
+	cmd.Flags().StringVar(&opts.Selector, "selector", opts.Selector, "A label selector to filter HostedClusters, e.g. environment=production.")
+	cmd.Flags().StringVar(&opts.Output, "output", opts.Output, "Output format. Supports 'json', defaults to a table.")
+	cmd.Flags().StringVar(&opts.OutOfDate, "out-of-date", opts.OutOfDate, "If set to a semantic version, only list clusters whose control plane is behind that version.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return ListClusters(cmd.Context(), &opts)
+	}
+
+	return cmd
+}
+
+func ListClusters(ctx context.Context, opts *ClustersOptions) error {
+	client, err := util.GetClient()
+	if err != nil {
+		return err
+	}
+
+	var listOpts []crclient.ListOption
+	if opts.Namespace != "" {
+		listOpts = append(listOpts, crclient.InNamespace(opts.Namespace))
+	}
+	var clusterListOpts []crclient.ListOption
+	clusterListOpts = append(clusterListOpts, listOpts...)
+	if opts.Selector != "" {
+		selector, err := labels.Parse(opts.Selector)
+		if err != nil {
+			return fmt.Errorf("invalid --selector %q: %w", opts.Selector, err)
+		}
+		clusterListOpts = append(clusterListOpts, crclient.MatchingLabelsSelector{Selector: selector})
+	}
+	var clusters hyperv1.HostedClusterList
+	if err := client.List(ctx, &clusters, clusterListOpts...); err != nil {
+		return fmt.Errorf("failed to list hostedclusters: %w", err)
+	}
+	var nodePools hyperv1.NodePoolList
+	if err := client.List(ctx, &nodePools, listOpts...); err != nil {
+		return fmt.Errorf("failed to list nodepools: %w", err)
+	}
+
+	infos := clusterInfos(&clusters, &nodePools)
+
+	if opts.OutOfDate != "" {
+		targetVersion, err := semver.Parse(opts.OutOfDate)
+		if err != nil {
+			return fmt.Errorf("invalid --out-of-date version %q: %w", opts.OutOfDate, err)
+		}
+		infos = outOfDateClusters(infos, targetVersion)
+	}
+
+	switch opts.Output {
+	case "json":
+		encoded, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode clusters: %w", err)
+		}
+		fmt.Println(string(encoded))
+	case "":
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "NAMESPACE\tNAME\tPLATFORM\tVERSION\tNODEPOOL VERSIONS\tNODES\tAVAILABLE\tREADY")
+		for _, info := range infos {
+			nodePoolVersions := make([]string, 0, len(info.NodePoolVersions))
+			for name, version := range info.NodePoolVersions {
+				if version == "" {
+					version = "<unknown>"
+				}
+				nodePoolVersions = append(nodePoolVersions, fmt.Sprintf("%s=%s", name, version))
+			}
+			version := info.ControlPlaneVersion
+			if version == "" {
+				version = "<unknown>"
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", info.Namespace, info.Name, info.Platform, version, strings.Join(nodePoolVersions, ","), info.NodeCount, info.Available, info.Ready)
+		}
+		return writer.Flush()
+	default:
+		return fmt.Errorf("unsupported output format %q", opts.Output)
+	}
+
+	return nil
+}
+
+// clusterInfos builds an inventory entry for every HostedCluster, associating
+// NodePools by namespace and cluster name.
+func clusterInfos(clusters *hyperv1.HostedClusterList, nodePools *hyperv1.NodePoolList) []ClusterInfo {
+	infos := make([]ClusterInfo, 0, len(clusters.Items))
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		info := ClusterInfo{
+			Namespace: cluster.Namespace,
+			Name:      cluster.Name,
+			Platform:  string(cluster.Spec.Platform.Type),
+			Available: "Unknown",
+		}
+		if cluster.Status.Version != nil && len(cluster.Status.Version.History) > 0 {
+			info.ControlPlaneVersion = cluster.Status.Version.History[0].Version
+		}
+		if available := meta.FindStatusCondition(cluster.Status.Conditions, string(hyperv1.HostedClusterAvailable)); available != nil {
+			info.Available = string(available.Status)
+		}
+		info.Ready = readiness(cluster)
+		for j := range nodePools.Items {
+			nodePool := &nodePools.Items[j]
+			if nodePool.Namespace != cluster.Namespace || nodePool.Spec.ClusterName != cluster.Name {
+				continue
+			}
+			if info.NodePoolVersions == nil {
+				info.NodePoolVersions = map[string]string{}
+			}
+			info.NodePoolVersions[nodePool.Name] = nodePool.Status.Version
+			info.NodeCount += nodePool.Status.Replicas
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// readiness summarizes whether a HostedCluster is fully ready: available and
+// not degraded. Missing conditions yield Unknown.
+func readiness(cluster *hyperv1.HostedCluster) string {
+	available := meta.FindStatusCondition(cluster.Status.Conditions, string(hyperv1.HostedClusterAvailable))
+	degraded := meta.FindStatusCondition(cluster.Status.Conditions, string(hyperv1.HostedClusterDegraded))
+	switch {
+	case available == nil || degraded == nil ||
+		available.Status == metav1.ConditionUnknown || degraded.Status == metav1.ConditionUnknown:
+		return string(metav1.ConditionUnknown)
+	case available.Status == metav1.ConditionTrue && degraded.Status == metav1.ConditionFalse:
+		return string(metav1.ConditionTrue)
+	default:
+		return string(metav1.ConditionFalse)
+	}
+}
+
+// outOfDateClusters keeps the clusters whose control plane version is behind
+// the target version. Clusters without a reported version are kept since they
+// cannot be confirmed up to date.
+func outOfDateClusters(infos []ClusterInfo, targetVersion semver.Version) []ClusterInfo {
+	var outOfDate []ClusterInfo
+	for _, info := range infos {
+		if info.ControlPlaneVersion != "" {
+			version, err := semver.Parse(info.ControlPlaneVersion)
+			if err == nil && !version.LT(targetVersion) {
+				continue
+			}
+		}
+		outOfDate = append(outOfDate, info)
+	}
+	return outOfDate
+}
@@ -0,0 +1,132 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/blang/semver"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+func TestClusterInfos(t *testing.T) {
+	clusters := &hyperv1.HostedClusterList{
+		Items: []hyperv1.HostedCluster{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example"},
+				Spec: hyperv1.HostedClusterSpec{
+					Platform: hyperv1.PlatformSpec{Type: hyperv1.AWSPlatform},
+				},
+				Status: hyperv1.HostedClusterStatus{
+					Version: &hyperv1.ClusterVersionStatus{
+						History: []configv1.UpdateHistory{{Version: "4.14.1"}},
+					},
+					Conditions: []metav1.Condition{
+						{Type: string(hyperv1.HostedClusterAvailable), Status: metav1.ConditionTrue},
+						{Type: string(hyperv1.HostedClusterDegraded), Status: metav1.ConditionFalse},
+					},
+				},
+			},
+		},
+	}
+	nodePools := &hyperv1.NodePoolList{
+		Items: []hyperv1.NodePool{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example-pool"},
+				Spec:       hyperv1.NodePoolSpec{ClusterName: "example"},
+				Status:     hyperv1.NodePoolStatus{Version: "4.14.0", Replicas: 3},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "other-pool"},
+				Spec:       hyperv1.NodePoolSpec{ClusterName: "other"},
+			},
+		},
+	}
+
+	infos := clusterInfos(clusters, nodePools)
+	if len(infos) != 1 {
+		t.Fatalf("expected 1 cluster info, got %d", len(infos))
+	}
+	info := infos[0]
+	if info.Platform != string(hyperv1.AWSPlatform) {
+		t.Errorf("expected platform %s, got %s", hyperv1.AWSPlatform, info.Platform)
+	}
+	if info.ControlPlaneVersion != "4.14.1" {
+		t.Errorf("expected control plane version 4.14.1, got %s", info.ControlPlaneVersion)
+	}
+	if info.Available != string(metav1.ConditionTrue) {
+		t.Errorf("expected available %s, got %s", metav1.ConditionTrue, info.Available)
+	}
+	if len(info.NodePoolVersions) != 1 || info.NodePoolVersions["example-pool"] != "4.14.0" {
+		t.Errorf("expected nodepool versions for example-pool only, got %v", info.NodePoolVersions)
+	}
+	if info.NodeCount != 3 {
+		t.Errorf("expected node count 3, got %d", info.NodeCount)
+	}
+	if info.Ready != string(metav1.ConditionTrue) {
+		t.Errorf("expected ready %s, got %s", metav1.ConditionTrue, info.Ready)
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	testCases := []struct {
+		name       string
+		conditions []metav1.Condition
+		expected   string
+	}{
+		{
+			name:     "no conditions",
+			expected: string(metav1.ConditionUnknown),
+		},
+		{
+			name: "available and not degraded",
+			conditions: []metav1.Condition{
+				{Type: string(hyperv1.HostedClusterAvailable), Status: metav1.ConditionTrue},
+				{Type: string(hyperv1.HostedClusterDegraded), Status: metav1.ConditionFalse},
+			},
+			expected: string(metav1.ConditionTrue),
+		},
+		{
+			name: "available but degraded",
+			conditions: []metav1.Condition{
+				{Type: string(hyperv1.HostedClusterAvailable), Status: metav1.ConditionTrue},
+				{Type: string(hyperv1.HostedClusterDegraded), Status: metav1.ConditionTrue},
+			},
+			expected: string(metav1.ConditionFalse),
+		},
+		{
+			name: "degraded state unknown",
+			conditions: []metav1.Condition{
+				{Type: string(hyperv1.HostedClusterAvailable), Status: metav1.ConditionTrue},
+				{Type: string(hyperv1.HostedClusterDegraded), Status: metav1.ConditionUnknown},
+			},
+			expected: string(metav1.ConditionUnknown),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &hyperv1.HostedCluster{
+				Status: hyperv1.HostedClusterStatus{Conditions: tc.conditions},
+			}
+			if ready := readiness(cluster); ready != tc.expected {
+				t.Errorf("expected readiness %s, got %s", tc.expected, ready)
+			}
+		})
+	}
+}
+
+func TestOutOfDateClusters(t *testing.T) {
+	infos := []ClusterInfo{
+		{Name: "current", ControlPlaneVersion: "4.14.1"},
+		{Name: "behind", ControlPlaneVersion: "4.13.5"},
+		{Name: "unknown"},
+	}
+	outOfDate := outOfDateClusters(infos, semver.MustParse("4.14.0"))
+	if len(outOfDate) != 2 {
+		t.Fatalf("expected 2 out of date clusters, got %d", len(outOfDate))
+	}
+	if outOfDate[0].Name != "behind" || outOfDate[1].Name != "unknown" {
+		t.Errorf("expected behind and unknown clusters, got %v", outOfDate)
+	}
+}
@@ -0,0 +1,144 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/cmd/util"
+)
+
+type NodePoolsOptions struct {
+	Namespace   string
+	ClusterName string
+	Output      string
+}
+
+// NodePoolInfo is a fleet inventory entry for a single NodePool.
+type NodePoolInfo struct {
+	Namespace    string `json:"namespace"`
+	Name         string `json:"name"`
+	Cluster      string `json:"cluster"`
+	DesiredNodes string `json:"desiredNodes"`
+	CurrentNodes int32  `json:"currentNodes"`
+	Version      string `json:"version,omitempty"`
+	Ready        string `json:"ready"`
+}
+
+func NewListNodePoolsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "nodepools",
+		Short:        "Lists NodePools with their replica counts and versions",
+		SilenceUsage: true,
+	}
+
+	opts := NodePoolsOptions{}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "A NodePool namespace. Defaults to all namespaces.")
+	cmd.Flags().StringVar(&opts.ClusterName, "cluster-name", opts.ClusterName, "If set, only list NodePools of this HostedCluster.")
+	cmd.Flags().StringVar(&opts.Output, "output", opts.Output, "Output format. Supports 'json', defaults to a table.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return ListNodePools(cmd.Context(), &opts)
+	}
+
+	return cmd
+}
+
+func ListNodePools(ctx context.Context, opts *NodePoolsOptions) error {
+	client, err := util.GetClient()
+	if err != nil {
+		return err
+	}
+
+	var listOpts []crclient.ListOption
+	if opts.Namespace != "" {
+		listOpts = append(listOpts, crclient.InNamespace(opts.Namespace))
+	}
+	var nodePools hyperv1.NodePoolList
+	if err := client.List(ctx, &nodePools, listOpts...); err != nil {
+		return fmt.Errorf("failed to list nodepools: %w", err)
+	}
+
+	infos := nodePoolInfos(&nodePools, opts.ClusterName)
+
+	switch opts.Output {
+	case "json":
+		encoded, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode nodepools: %w", err)
+		}
+		fmt.Println(string(encoded))
+	case "":
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "NAMESPACE\tNAME\tCLUSTER\tDESIRED NODES\tCURRENT NODES\tVERSION\tREADY")
+		for _, info := range infos {
+			version := info.Version
+			if version == "" {
+				version = "<unknown>"
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", info.Namespace, info.Name, info.Cluster, info.DesiredNodes, info.CurrentNodes, version, info.Ready)
+		}
+		return writer.Flush()
+	default:
+		return fmt.Errorf("unsupported output format %q", opts.Output)
+	}
+
+	return nil
+}
+
+// nodePoolInfos builds an inventory entry for every NodePool, optionally
+// filtered by the HostedCluster the pools belong to.
+func nodePoolInfos(nodePools *hyperv1.NodePoolList, clusterName string) []NodePoolInfo {
+	infos := make([]NodePoolInfo, 0, len(nodePools.Items))
+	for i := range nodePools.Items {
+		nodePool := &nodePools.Items[i]
+		if clusterName != "" && nodePool.Spec.ClusterName != clusterName {
+			continue
+		}
+		info := NodePoolInfo{
+			Namespace:    nodePool.Namespace,
+			Name:         nodePool.Name,
+			Cluster:      nodePool.Spec.ClusterName,
+			DesiredNodes: desiredNodes(nodePool),
+			CurrentNodes: nodePool.Status.Replicas,
+			Version:      nodePool.Status.Version,
+			Ready:        string(metav1.ConditionUnknown),
+		}
+		for _, condition := range nodePool.Status.Conditions {
+			if condition.Type == hyperv1.NodePoolReadyConditionType {
+				info.Ready = conditionStatusString(condition.Status)
+				break
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// desiredNodes renders the wanted size of a NodePool: the replica count, or
+// the autoscaling range when the autoscaler owns the size.
+func desiredNodes(nodePool *hyperv1.NodePool) string {
+	if nodePool.Spec.AutoScaling != nil {
+		return fmt.Sprintf("%d-%d", nodePool.Spec.AutoScaling.Min, nodePool.Spec.AutoScaling.Max)
+	}
+	if nodePool.Spec.Replicas != nil {
+		return fmt.Sprintf("%d", *nodePool.Spec.Replicas)
+	}
+	return "0"
+}
+
+func conditionStatusString(status corev1.ConditionStatus) string {
+	if status == "" {
+		return string(metav1.ConditionUnknown)
+	}
+	return string(status)
+}
@@ -0,0 +1,73 @@
+package list
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+func TestNodePoolInfos(t *testing.T) {
+	nodePools := &hyperv1.NodePoolList{
+		Items: []hyperv1.NodePool{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example-pool"},
+				Spec: hyperv1.NodePoolSpec{
+					ClusterName: "example",
+					Replicas:    k8sutilspointer.Int32(3),
+				},
+				Status: hyperv1.NodePoolStatus{
+					Replicas: 2,
+					Version:  "4.14.0",
+					Conditions: []hyperv1.NodePoolCondition{
+						{Type: hyperv1.NodePoolReadyConditionType, Status: corev1.ConditionTrue},
+					},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "autoscaling-pool"},
+				Spec: hyperv1.NodePoolSpec{
+					ClusterName: "example",
+					AutoScaling: &hyperv1.NodePoolAutoScaling{Min: 1, Max: 5},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "other-pool"},
+				Spec:       hyperv1.NodePoolSpec{ClusterName: "other"},
+			},
+		},
+	}
+
+	infos := nodePoolInfos(nodePools, "example")
+	if len(infos) != 2 {
+		t.Fatalf("expected 2 nodepool infos, got %d", len(infos))
+	}
+	info := infos[0]
+	if info.DesiredNodes != "3" {
+		t.Errorf("expected desired nodes 3, got %s", info.DesiredNodes)
+	}
+	if info.CurrentNodes != 2 {
+		t.Errorf("expected current nodes 2, got %d", info.CurrentNodes)
+	}
+	if info.Version != "4.14.0" {
+		t.Errorf("expected version 4.14.0, got %s", info.Version)
+	}
+	if info.Ready != string(corev1.ConditionTrue) {
+		t.Errorf("expected ready %s, got %s", corev1.ConditionTrue, info.Ready)
+	}
+	autoscaling := infos[1]
+	if autoscaling.DesiredNodes != "1-5" {
+		t.Errorf("expected desired nodes 1-5, got %s", autoscaling.DesiredNodes)
+	}
+	if autoscaling.Ready != string(metav1.ConditionUnknown) {
+		t.Errorf("expected ready %s, got %s", metav1.ConditionUnknown, autoscaling.Ready)
+	}
+
+	all := nodePoolInfos(nodePools, "")
+	if len(all) != 3 {
+		t.Errorf("expected 3 nodepool infos without a cluster filter, got %d", len(all))
+	}
+}
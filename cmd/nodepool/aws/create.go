@@ -19,6 +19,7 @@ type AWSPlatformCreateOptions struct {
 	RootVolumeIOPS          int64
 	RootVolumeSize          int64
 	RootVolumeEncryptionKey string
+	PlacementGroup          string
 }
 
 func NewCreateCommand(coreOpts *core.CreateNodePoolOptions) *cobra.Command {
@@ -42,6 +43,7 @@ func NewCreateCommand(coreOpts *core.CreateNodePoolOptions) *cobra.Command {
 	cmd.Flags().Int64Var(&platformOpts.RootVolumeIOPS, "root-volume-iops", platformOpts.RootVolumeIOPS, "The iops of the root volume for machines in the NodePool")
 	cmd.Flags().Int64Var(&platformOpts.RootVolumeSize, "root-volume-size", platformOpts.RootVolumeSize, "The size of the root volume (min: 8) for machines in the NodePool")
 	cmd.Flags().StringVar(&platformOpts.RootVolumeEncryptionKey, "root-volume-kms-key", platformOpts.RootVolumeEncryptionKey, "The KMS key ID or ARN to use for root volume encryption for machines in the NodePool")
+	cmd.Flags().StringVar(&platformOpts.PlacementGroup, "placement-group", platformOpts.PlacementGroup, "The name of an existing EC2 placement group in which to launch machines in the NodePool")
 
 	cmd.RunE = coreOpts.CreateRunFunc(platformOpts)
 
@@ -111,6 +113,7 @@ func (o *AWSPlatformCreateOptions) UpdateNodePool(ctx context.Context, nodePool
 			IOPS:          o.RootVolumeIOPS,
 			EncryptionKey: o.RootVolumeEncryptionKey,
 		},
+		PlacementGroup: o.PlacementGroup,
 	}
 	return nil
 }
@@ -1,29 +1,41 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	"github.com/openshift/hypershift/cmd/log"
 	"github.com/openshift/hypershift/cmd/util"
 	hyperapi "github.com/openshift/hypershift/support/api"
+	mcfgv1 "github.com/openshift/hypershift/thirdparty/machineconfigoperator/pkg/apis/machineconfiguration.openshift.io/v1"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type CreateNodePoolOptions struct {
-	Name            string
-	Namespace       string
-	ClusterName     string
-	NodeCount       int32
-	ReleaseImage    string
-	Render          bool
-	NodeUpgradeType hyperv1.UpgradeType
-	Arch            string
+	Name                      string
+	Namespace                 string
+	ClusterName               string
+	NodeCount                 int32
+	ReleaseImage              string
+	Render                    bool
+	NodeUpgradeType           hyperv1.UpgradeType
+	Arch                      string
+	ContainerRuntimeHandler   string
+	PauseMachineConfigUpdates bool
+	PauseMachineImageUpdates  bool
+	UserDataSecret            string
+	NodeDrainTimeout          time.Duration
+	AutoRepair                bool
+	NodeLabels                map[string]string
+	Taints                    []string
 }
 
 type PlatformOptions interface {
@@ -84,6 +96,7 @@ func (o *CreateNodePoolOptions) CreateNodePool(ctx context.Context, platformOpts
 		Spec: hyperv1.NodePoolSpec{
 			Management: hyperv1.NodePoolManagement{
 				UpgradeType: o.NodeUpgradeType,
+				AutoRepair:  o.AutoRepair,
 			},
 			ClusterName: o.ClusterName,
 			Replicas:    &o.NodeCount,
@@ -93,15 +106,67 @@ func (o *CreateNodePoolOptions) CreateNodePool(ctx context.Context, platformOpts
 			Platform: hyperv1.NodePoolPlatform{
 				Type: hcluster.Spec.Platform.Type,
 			},
-			Arch: o.Arch,
+			Arch:       o.Arch,
+			NodeLabels: o.NodeLabels,
 		},
 	}
 
+	for _, taint := range o.Taints {
+		parsed, err := util.ParseTaint(taint)
+		if err != nil {
+			return err
+		}
+		nodePool.Spec.Taints = append(nodePool.Spec.Taints, parsed)
+	}
+
+	if o.NodeDrainTimeout > 0 {
+		nodePool.Spec.NodeDrainTimeout = &metav1.Duration{Duration: o.NodeDrainTimeout}
+	}
+
+	if o.PauseMachineConfigUpdates {
+		nodePool.Annotations = map[string]string{
+			hyperv1.NodePoolPauseMachineConfigUpdatesAnnotation: "true",
+		}
+	}
+
+	if o.PauseMachineImageUpdates {
+		if nodePool.Annotations == nil {
+			nodePool.Annotations = map[string]string{}
+		}
+		nodePool.Annotations[hyperv1.NodePoolPauseMachineImageUpdatesAnnotation] = "true"
+	}
+
+	if len(o.UserDataSecret) > 0 {
+		userDataSecret := &corev1.Secret{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: o.Namespace, Name: o.UserDataSecret}, userDataSecret); err != nil {
+			return fmt.Errorf("failed to get userData Secret %s/%s: %w", o.Namespace, o.UserDataSecret, err)
+		}
+		if _, hasValue := userDataSecret.Data["value"]; !hasValue {
+			return fmt.Errorf("userData Secret %s/%s must have a %q key", o.Namespace, o.UserDataSecret, "value")
+		}
+		nodePool.Spec.UserDataSecret = &corev1.LocalObjectReference{Name: o.UserDataSecret}
+	}
+
 	if err := platformOpts.UpdateNodePool(ctx, nodePool, hcluster, client); err != nil {
 		return err
 	}
 
+	var containerRuntimeConfigMap *corev1.ConfigMap
+	if len(o.ContainerRuntimeHandler) > 0 {
+		containerRuntimeConfigMap, err = o.containerRuntimeConfigMap()
+		if err != nil {
+			return err
+		}
+		nodePool.Spec.Config = append(nodePool.Spec.Config, corev1.LocalObjectReference{Name: containerRuntimeConfigMap.Name})
+	}
+
 	if o.Render {
+		if containerRuntimeConfigMap != nil {
+			if err := hyperapi.YamlSerializer.Encode(containerRuntimeConfigMap, os.Stdout); err != nil {
+				panic(err)
+			}
+			fmt.Println("---")
+		}
 		err := hyperapi.YamlSerializer.Encode(nodePool, os.Stdout)
 		if err != nil {
 			panic(err)
@@ -110,6 +175,12 @@ func (o *CreateNodePoolOptions) CreateNodePool(ctx context.Context, platformOpts
 		return nil
 	}
 
+	if containerRuntimeConfigMap != nil {
+		if err := client.Create(ctx, containerRuntimeConfigMap); err != nil {
+			return fmt.Errorf("failed to create container runtime ConfigMap: %w", err)
+		}
+	}
+
 	err = client.Create(ctx, nodePool)
 	if err != nil {
 		return err
@@ -118,3 +189,47 @@ func (o *CreateNodePoolOptions) CreateNodePool(ctx context.Context, platformOpts
 	fmt.Printf("NodePool %s created\n", o.Name)
 	return nil
 }
+
+// containerRuntimeConfigMap builds a ConfigMap holding a ContainerRuntimeConfig
+// that selects the requested low-level runtime for nodes in this pool.
+func (o *CreateNodePoolOptions) containerRuntimeConfigMap() (*corev1.ConfigMap, error) {
+	switch o.ContainerRuntimeHandler {
+	case string(mcfgv1.ContainerRuntimeDefaultRuntimeRunc), string(mcfgv1.ContainerRuntimeDefaultRuntimeCrun):
+	default:
+		return nil, fmt.Errorf("unsupported container runtime handler %q, supported values are %q and %q",
+			o.ContainerRuntimeHandler, mcfgv1.ContainerRuntimeDefaultRuntimeRunc, mcfgv1.ContainerRuntimeDefaultRuntimeCrun)
+	}
+
+	containerRuntimeConfig := &mcfgv1.ContainerRuntimeConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ContainerRuntimeConfig",
+			APIVersion: mcfgv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: o.Name + "-container-runtime",
+		},
+		Spec: mcfgv1.ContainerRuntimeConfigSpec{
+			ContainerRuntimeConfig: &mcfgv1.ContainerRuntimeConfiguration{
+				DefaultRuntime: mcfgv1.ContainerRuntimeDefaultRuntime(o.ContainerRuntimeHandler),
+			},
+		},
+	}
+	manifest := &bytes.Buffer{}
+	if err := hyperapi.YamlSerializer.Encode(containerRuntimeConfig, manifest); err != nil {
+		return nil, fmt.Errorf("failed to encode ContainerRuntimeConfig: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.Namespace,
+			Name:      o.Name + "-container-runtime",
+		},
+		Data: map[string]string{
+			"config": manifest.String(),
+		},
+	}, nil
+}
@@ -41,6 +41,14 @@ func NewCreateCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&opts.ReleaseImage, "release-image", opts.ReleaseImage, "The release image for nodes. If empty, defaults to the same release image as the HostedCluster.")
 	cmd.PersistentFlags().Var(&opts.NodeUpgradeType, "node-upgrade-type", "The NodePool upgrade strategy for how nodes should behave when upgraded. Supported options: Replace, InPlace")
 	cmd.PersistentFlags().StringVar(&opts.Arch, "arch", opts.Arch, "The processor architecture for the NodePool (e.g. arm64, amd64)")
+	cmd.PersistentFlags().StringVar(&opts.ContainerRuntimeHandler, "container-runtime-handler", opts.ContainerRuntimeHandler, "If set, configure the low-level container runtime for nodes in this pool via a ContainerRuntimeConfig. Supported options: runc, crun")
+	cmd.PersistentFlags().BoolVar(&opts.PauseMachineConfigUpdates, "pause-machineconfig-updates", opts.PauseMachineConfigUpdates, "If set, machine config changes are not rolled out to nodes in this pool until the hypershift.openshift.io/pause-machineconfig-updates annotation is removed")
+	cmd.PersistentFlags().BoolVar(&opts.PauseMachineImageUpdates, "pause-machineimage-updates", opts.PauseMachineImageUpdates, "If set, nodes in this pool keep their current boot image on release upgrades until the hypershift.openshift.io/pause-machineimage-updates annotation is removed")
+	cmd.PersistentFlags().StringVar(&opts.UserDataSecret, "user-data-secret", opts.UserDataSecret, "If set, the name of a Secret in the NodePool namespace with a 'value' key whose content is used as the machine user data instead of the generated ignition pointer config")
+	cmd.PersistentFlags().DurationVar(&opts.NodeDrainTimeout, "node-drain-timeout", opts.NodeDrainTimeout, "The NodeDrainTimeout for the NodePool")
+	cmd.PersistentFlags().StringToStringVar(&opts.NodeLabels, "node-labels", opts.NodeLabels, "A comma separated list of key=value labels propagated to Nodes in this pool on creation. E.g. role=worker,disk=fast")
+	cmd.PersistentFlags().StringArrayVar(&opts.Taints, "taints", opts.Taints, "A taint propagated to Nodes in this pool on creation, in the form key=value:Effect or key:Effect. Repeat to add multiple taints")
+	cmd.PersistentFlags().BoolVar(&opts.AutoRepair, "auto-repair", opts.AutoRepair, "Enables machine autorepair with machine health checks")
 
 	cmd.PersistentFlags().BoolVar(&opts.Render, "render", false, "Render output as YAML to stdout instead of applying")
 
@@ -0,0 +1,175 @@
+package pause
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/cmd/log"
+	"github.com/openshift/hypershift/cmd/util"
+)
+
+// ClusterOptions identifies the HostedCluster to pause or resume and, when
+// pausing, for how long.
+type ClusterOptions struct {
+	Namespace string
+	Name      string
+
+	// Until is the value written to spec.pausedUntil: "true" to pause
+	// indefinitely, an RFC3339 date, or a duration such as "4h" that is
+	// resolved against the current time.
+	Until string
+}
+
+func NewPauseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "pause",
+		Short:        "Pauses reconciliation of HyperShift resources",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(newPauseClusterCommand())
+	return cmd
+}
+
+func NewResumeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "resume",
+		Short:        "Resumes reconciliation of HyperShift resources",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(newResumeClusterCommand())
+	return cmd
+}
+
+func newPauseClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "cluster",
+		Short:        "Pauses reconciliation of a HostedCluster and its NodePools",
+		SilenceUsage: true,
+	}
+
+	opts := &ClusterOptions{
+		Namespace: "clusters",
+		Until:     "true",
+	}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "The namespace of the HostedCluster")
+	cmd.Flags().StringVar(&opts.Name, "name", opts.Name, "The name of the HostedCluster")
+	cmd.Flags().StringVar(&opts.Until, "until", opts.Until, "How long to pause reconciliation: 'true' for indefinitely, an RFC3339 date, or a duration such as 4h")
+
+	cmd.MarkFlagRequired("name")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		c, err := util.GetClient()
+		if err != nil {
+			return err
+		}
+		return PauseCluster(cmd.Context(), c, opts)
+	}
+
+	return cmd
+}
+
+func newResumeClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "cluster",
+		Short:        "Resumes reconciliation of a HostedCluster and its NodePools",
+		SilenceUsage: true,
+	}
+
+	opts := &ClusterOptions{
+		Namespace: "clusters",
+	}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "The namespace of the HostedCluster")
+	cmd.Flags().StringVar(&opts.Name, "name", opts.Name, "The name of the HostedCluster")
+
+	cmd.MarkFlagRequired("name")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		c, err := util.GetClient()
+		if err != nil {
+			return err
+		}
+		return ResumeCluster(cmd.Context(), c, opts)
+	}
+
+	return cmd
+}
+
+// pausedUntilValue normalizes the --until flag into a value understood by the
+// pausedUntil API field: a Boolean or an RFC3339 date. Durations are resolved
+// against the current time.
+func pausedUntilValue(until string, now time.Time) (string, error) {
+	if _, err := strconv.ParseBool(until); err == nil {
+		return until, nil
+	}
+	if _, err := time.Parse(time.RFC3339, until); err == nil {
+		return until, nil
+	}
+	if duration, err := time.ParseDuration(until); err == nil {
+		if duration <= 0 {
+			return "", fmt.Errorf("invalid value %q for --until: duration must be positive", until)
+		}
+		return now.Add(duration).UTC().Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("invalid value %q for --until: must be a Boolean, an RFC3339 date, or a duration", until)
+}
+
+// PauseCluster sets spec.pausedUntil on the HostedCluster and all its
+// NodePools so the operator stops reconciling them until the pause is lifted.
+func PauseCluster(ctx context.Context, c crclient.Client, opts *ClusterOptions) error {
+	value, err := pausedUntilValue(opts.Until, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := setPausedUntil(ctx, c, opts, &value); err != nil {
+		return err
+	}
+	log.Log.Info("Paused reconciliation", "namespace", opts.Namespace, "name", opts.Name, "pausedUntil", value)
+	return nil
+}
+
+// ResumeCluster clears spec.pausedUntil on the HostedCluster and all its
+// NodePools so the operator reconciles them again.
+func ResumeCluster(ctx context.Context, c crclient.Client, opts *ClusterOptions) error {
+	if err := setPausedUntil(ctx, c, opts, nil); err != nil {
+		return err
+	}
+	log.Log.Info("Resumed reconciliation", "namespace", opts.Namespace, "name", opts.Name)
+	return nil
+}
+
+func setPausedUntil(ctx context.Context, c crclient.Client, opts *ClusterOptions, value *string) error {
+	hostedCluster := &hyperv1.HostedCluster{}
+	if err := c.Get(ctx, crclient.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, hostedCluster); err != nil {
+		return fmt.Errorf("failed to get hostedcluster %s/%s: %w", opts.Namespace, opts.Name, err)
+	}
+	hostedCluster.Spec.PausedUntil = value
+	if err := c.Update(ctx, hostedCluster); err != nil {
+		return fmt.Errorf("failed to update hostedcluster %s/%s: %w", opts.Namespace, opts.Name, err)
+	}
+
+	nodePoolList := &hyperv1.NodePoolList{}
+	if err := c.List(ctx, nodePoolList, crclient.InNamespace(opts.Namespace)); err != nil {
+		return fmt.Errorf("failed to list nodepools: %w", err)
+	}
+	for i := range nodePoolList.Items {
+		nodePool := &nodePoolList.Items[i]
+		if nodePool.Spec.ClusterName != opts.Name {
+			continue
+		}
+		nodePool.Spec.PausedUntil = value
+		if err := c.Update(ctx, nodePool); err != nil {
+			return fmt.Errorf("failed to update nodepool %s/%s: %w", nodePool.Namespace, nodePool.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package pause
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	hyperapi "github.com/openshift/hypershift/support/api"
+)
+
+func TestPausedUntilValue(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		until       string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "boolean",
+			until:    "true",
+			expected: "true",
+		},
+		{
+			name:     "RFC3339 date",
+			until:    "2024-03-02T12:00:00Z",
+			expected: "2024-03-02T12:00:00Z",
+		},
+		{
+			name:     "duration resolves against now",
+			until:    "4h",
+			expected: "2024-03-01T16:00:00Z",
+		},
+		{
+			name:        "negative duration",
+			until:       "-4h",
+			expectError: true,
+		},
+		{
+			name:        "garbage",
+			until:       "tomorrow",
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := pausedUntilValue(tc.until, now)
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got value %q", value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if value != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, value)
+			}
+		})
+	}
+}
+
+func TestPauseAndResumeCluster(t *testing.T) {
+	hostedCluster := &hyperv1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example"},
+	}
+	nodePool := &hyperv1.NodePool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example-pool"},
+		Spec:       hyperv1.NodePoolSpec{ClusterName: "example"},
+	}
+	otherNodePool := &hyperv1.NodePool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "other-pool"},
+		Spec:       hyperv1.NodePoolSpec{ClusterName: "other"},
+	}
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).WithObjects(hostedCluster, nodePool, otherNodePool).Build()
+
+	opts := &ClusterOptions{Namespace: "clusters", Name: "example", Until: "true"}
+	if err := PauseCluster(context.Background(), client, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pausedCluster := &hyperv1.HostedCluster{}
+	if err := client.Get(context.Background(), crclient.ObjectKeyFromObject(hostedCluster), pausedCluster); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pausedCluster.Spec.PausedUntil == nil || *pausedCluster.Spec.PausedUntil != "true" {
+		t.Errorf("expected hostedcluster pausedUntil to be \"true\", got %v", pausedCluster.Spec.PausedUntil)
+	}
+	pausedPool := &hyperv1.NodePool{}
+	if err := client.Get(context.Background(), crclient.ObjectKeyFromObject(nodePool), pausedPool); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pausedPool.Spec.PausedUntil == nil || *pausedPool.Spec.PausedUntil != "true" {
+		t.Errorf("expected nodepool pausedUntil to be \"true\", got %v", pausedPool.Spec.PausedUntil)
+	}
+	untouchedPool := &hyperv1.NodePool{}
+	if err := client.Get(context.Background(), crclient.ObjectKeyFromObject(otherNodePool), untouchedPool); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if untouchedPool.Spec.PausedUntil != nil {
+		t.Errorf("expected nodepool of another cluster to stay untouched, got pausedUntil %q", *untouchedPool.Spec.PausedUntil)
+	}
+
+	if err := ResumeCluster(context.Background(), client, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resumedCluster := &hyperv1.HostedCluster{}
+	if err := client.Get(context.Background(), crclient.ObjectKeyFromObject(hostedCluster), resumedCluster); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resumedCluster.Spec.PausedUntil != nil {
+		t.Errorf("expected hostedcluster pausedUntil to be cleared, got %q", *resumedCluster.Spec.PausedUntil)
+	}
+	resumedPool := &hyperv1.NodePool{}
+	if err := client.Get(context.Background(), crclient.ObjectKeyFromObject(nodePool), resumedPool); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resumedPool.Spec.PausedUntil != nil {
+		t.Errorf("expected nodepool pausedUntil to be cleared, got %q", *resumedPool.Spec.PausedUntil)
+	}
+}
@@ -0,0 +1,201 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	apifixtures "github.com/openshift/hypershift/api/fixtures"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	awsutil "github.com/openshift/hypershift/cmd/infra/aws/util"
+	"github.com/openshift/hypershift/cmd/util"
+)
+
+// CredentialsOptions holds the new cloud credentials to cut a HostedCluster
+// over to.
+type CredentialsOptions struct {
+	Namespace string
+	Name      string
+
+	// AWS role ARNs to replace the current RolesRef entries. Empty values
+	// keep the current ARN.
+	KubeCloudControllerRoleARN  string
+	NodePoolManagementRoleARN   string
+	ControlPlaneOperatorRoleARN string
+	NetworkRoleARN              string
+	StorageRoleARN              string
+
+	AWSCredentialsFile string
+	AWSRegion          string
+
+	// AzureCredentialsFile points to a credentials file holding the new Azure
+	// client credentials.
+	AzureCredentialsFile string
+}
+
+// RoleValidatorFunc validates that a role ARN exists and is usable before the
+// cluster is cut over to it.
+type RoleValidatorFunc func(ctx context.Context, arn string) error
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "rotate",
+		Short:        "Commands to rotate HyperShift resources",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(NewRotateCredentialsCommand())
+	return cmd
+}
+
+func NewRotateCredentialsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "credentials",
+		Short:        "Rotates the cloud credentials of a HostedCluster and restarts its control plane components",
+		SilenceUsage: true,
+	}
+
+	opts := &CredentialsOptions{
+		Namespace: "clusters",
+		AWSRegion: "us-east-1",
+	}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "The namespace of the HostedCluster")
+	cmd.Flags().StringVar(&opts.Name, "name", opts.Name, "The name of the HostedCluster")
+	cmd.Flags().StringVar(&opts.KubeCloudControllerRoleARN, "kube-cloud-controller-role-arn", opts.KubeCloudControllerRoleARN, "The new ARN of the role for the cloud controller manager (AWS)")
+	cmd.Flags().StringVar(&opts.NodePoolManagementRoleARN, "node-pool-management-role-arn", opts.NodePoolManagementRoleARN, "The new ARN of the role for node pool management (AWS)")
+	cmd.Flags().StringVar(&opts.ControlPlaneOperatorRoleARN, "control-plane-operator-role-arn", opts.ControlPlaneOperatorRoleARN, "The new ARN of the role for the control plane operator (AWS)")
+	cmd.Flags().StringVar(&opts.NetworkRoleARN, "network-role-arn", opts.NetworkRoleARN, "The new ARN of the role for the cloud network config controller (AWS)")
+	cmd.Flags().StringVar(&opts.StorageRoleARN, "storage-role-arn", opts.StorageRoleARN, "The new ARN of the role for the EBS CSI driver (AWS)")
+	cmd.Flags().StringVar(&opts.AWSCredentialsFile, "aws-creds", opts.AWSCredentialsFile, "Path to an AWS credentials file used to validate new role ARNs (required when rotating AWS roles)")
+	cmd.Flags().StringVar(&opts.AWSRegion, "aws-region", opts.AWSRegion, "The AWS region used when validating new role ARNs")
+	cmd.Flags().StringVar(&opts.AzureCredentialsFile, "azure-creds", opts.AzureCredentialsFile, "Path to a credentials file holding the new Azure client credentials")
+
+	cmd.MarkFlagRequired("name")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		c, err := util.GetClient()
+		if err != nil {
+			return err
+		}
+		var validateRole RoleValidatorFunc
+		if opts.AWSCredentialsFile != "" {
+			awsSession := awsutil.NewSession("cli-rotate-credentials", opts.AWSCredentialsFile, "", "", opts.AWSRegion)
+			iamClient := iam.New(awsSession, awsutil.NewConfig())
+			validateRole = func(ctx context.Context, arn string) error {
+				roleName := arn[strings.LastIndex(arn, "/")+1:]
+				if _, err := iamClient.GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)}); err != nil {
+					return fmt.Errorf("failed to validate role %s: %w", arn, err)
+				}
+				return nil
+			}
+		}
+		return RotateCredentials(cmd.Context(), c, opts, validateRole)
+	}
+
+	return cmd
+}
+
+// RotateCredentials validates the new credentials, updates the HostedCluster
+// and its referenced credential secrets, and triggers a rolling restart of the
+// control plane components so they pick up the new credentials.
+func RotateCredentials(ctx context.Context, c crclient.Client, opts *CredentialsOptions, validateRole RoleValidatorFunc) error {
+	hostedCluster := &hyperv1.HostedCluster{}
+	if err := c.Get(ctx, crclient.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, hostedCluster); err != nil {
+		return fmt.Errorf("failed to get hostedcluster %s/%s: %w", opts.Namespace, opts.Name, err)
+	}
+
+	switch hostedCluster.Spec.Platform.Type {
+	case hyperv1.AWSPlatform:
+		if err := rotateAWSRoles(ctx, hostedCluster, opts, validateRole); err != nil {
+			return err
+		}
+	case hyperv1.AzurePlatform:
+		if err := rotateAzureCredentials(ctx, c, hostedCluster, opts); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("credential rotation is not supported for platform %s", hostedCluster.Spec.Platform.Type)
+	}
+
+	if hostedCluster.Annotations == nil {
+		hostedCluster.Annotations = map[string]string{}
+	}
+	hostedCluster.Annotations[hyperv1.RestartDateAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if err := c.Update(ctx, hostedCluster); err != nil {
+		return fmt.Errorf("failed to update hostedcluster %s/%s: %w", opts.Namespace, opts.Name, err)
+	}
+	return nil
+}
+
+func rotateAWSRoles(ctx context.Context, hostedCluster *hyperv1.HostedCluster, opts *CredentialsOptions, validateRole RoleValidatorFunc) error {
+	rolesRef := &hostedCluster.Spec.Platform.AWS.RolesRef
+	newARNs := map[*string]string{}
+	for current, arn := range map[*string]string{
+		&rolesRef.KubeCloudControllerARN:  opts.KubeCloudControllerRoleARN,
+		&rolesRef.NodePoolManagementARN:   opts.NodePoolManagementRoleARN,
+		&rolesRef.ControlPlaneOperatorARN: opts.ControlPlaneOperatorRoleARN,
+		&rolesRef.NetworkARN:              opts.NetworkRoleARN,
+		&rolesRef.StorageARN:              opts.StorageRoleARN,
+	} {
+		if arn != "" {
+			newARNs[current] = arn
+		}
+	}
+	if len(newARNs) == 0 {
+		return fmt.Errorf("no new role ARNs provided, nothing to rotate")
+	}
+	if validateRole == nil {
+		return fmt.Errorf("an AWS credentials file is required to validate new role ARNs")
+	}
+	for _, arn := range newARNs {
+		if err := validateRole(ctx, arn); err != nil {
+			return err
+		}
+	}
+	for current, arn := range newARNs {
+		*current = arn
+	}
+	return nil
+}
+
+func rotateAzureCredentials(ctx context.Context, c crclient.Client, hostedCluster *hyperv1.HostedCluster, opts *CredentialsOptions) error {
+	if opts.AzureCredentialsFile == "" {
+		return fmt.Errorf("an Azure credentials file is required to rotate Azure credentials")
+	}
+	credsRaw, err := os.ReadFile(opts.AzureCredentialsFile)
+	if err != nil {
+		return fmt.Errorf("failed to read --azure-creds file %s: %w", opts.AzureCredentialsFile, err)
+	}
+	var creds apifixtures.AzureCreds
+	if err := yaml.Unmarshal(credsRaw, &creds); err != nil {
+		return fmt.Errorf("failed to unmarshal --azure-creds file: %w", err)
+	}
+	if creds.SubscriptionID == "" || creds.TenantID == "" || creds.ClientID == "" || creds.ClientSecret == "" {
+		return fmt.Errorf("the Azure credentials file must set subscriptionId, tenantId, clientId and clientSecret")
+	}
+	secret := &corev1.Secret{}
+	secretKey := crclient.ObjectKey{Namespace: hostedCluster.Namespace, Name: hostedCluster.Spec.Platform.Azure.Credentials.Name}
+	if err := c.Get(ctx, secretKey, secret); err != nil {
+		return fmt.Errorf("failed to get credentials secret %s: %w", secretKey, err)
+	}
+	secret.Data = map[string][]byte{
+		"AZURE_SUBSCRIPTION_ID": []byte(creds.SubscriptionID),
+		"AZURE_TENANT_ID":       []byte(creds.TenantID),
+		"AZURE_CLIENT_ID":       []byte(creds.ClientID),
+		"AZURE_CLIENT_SECRET":   []byte(creds.ClientSecret),
+	}
+	if err := c.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update credentials secret %s: %w", secretKey, err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	hyperapi "github.com/openshift/hypershift/support/api"
+)
+
+func awsHostedCluster() *hyperv1.HostedCluster {
+	return &hyperv1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example"},
+		Spec: hyperv1.HostedClusterSpec{
+			Platform: hyperv1.PlatformSpec{
+				Type: hyperv1.AWSPlatform,
+				AWS: &hyperv1.AWSPlatformSpec{
+					RolesRef: hyperv1.AWSRolesRef{
+						KubeCloudControllerARN:  "arn:aws:iam::123456789012:role/old-kcc",
+						NodePoolManagementARN:   "arn:aws:iam::123456789012:role/old-npm",
+						ControlPlaneOperatorARN: "arn:aws:iam::123456789012:role/old-cpo",
+						NetworkARN:              "arn:aws:iam::123456789012:role/old-network",
+						StorageARN:              "arn:aws:iam::123456789012:role/old-storage",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRotateAWSCredentials(t *testing.T) {
+	hostedCluster := awsHostedCluster()
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).WithObjects(hostedCluster).Build()
+
+	opts := &CredentialsOptions{
+		Namespace:                  "clusters",
+		Name:                       "example",
+		KubeCloudControllerRoleARN: "arn:aws:iam::123456789012:role/new-kcc",
+		StorageRoleARN:             "arn:aws:iam::123456789012:role/new-storage",
+	}
+	var validated []string
+	validateRole := func(ctx context.Context, arn string) error {
+		validated = append(validated, arn)
+		return nil
+	}
+	if err := RotateCredentials(context.Background(), client, opts, validateRole); err != nil {
+		t.Fatalf("RotateCredentials failed: %v", err)
+	}
+	if len(validated) != 2 {
+		t.Errorf("expected 2 roles to be validated, got %v", validated)
+	}
+
+	updated := &hyperv1.HostedCluster{}
+	if err := client.Get(context.Background(), crclient.ObjectKey{Namespace: "clusters", Name: "example"}, updated); err != nil {
+		t.Fatalf("failed to get hostedcluster: %v", err)
+	}
+	rolesRef := updated.Spec.Platform.AWS.RolesRef
+	if rolesRef.KubeCloudControllerARN != opts.KubeCloudControllerRoleARN {
+		t.Errorf("expected cloud controller ARN to be rotated, got %s", rolesRef.KubeCloudControllerARN)
+	}
+	if rolesRef.StorageARN != opts.StorageRoleARN {
+		t.Errorf("expected storage ARN to be rotated, got %s", rolesRef.StorageARN)
+	}
+	if rolesRef.NodePoolManagementARN != "arn:aws:iam::123456789012:role/old-npm" {
+		t.Errorf("expected node pool management ARN to be unchanged, got %s", rolesRef.NodePoolManagementARN)
+	}
+	if updated.Annotations[hyperv1.RestartDateAnnotation] == "" {
+		t.Error("expected the restart-date annotation to be set")
+	}
+}
+
+func TestRotateAWSCredentialsValidationFailure(t *testing.T) {
+	hostedCluster := awsHostedCluster()
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).WithObjects(hostedCluster).Build()
+
+	opts := &CredentialsOptions{
+		Namespace:                  "clusters",
+		Name:                       "example",
+		KubeCloudControllerRoleARN: "arn:aws:iam::123456789012:role/does-not-exist",
+	}
+	validateRole := func(ctx context.Context, arn string) error {
+		return fmt.Errorf("role %s does not exist", arn)
+	}
+	if err := RotateCredentials(context.Background(), client, opts, validateRole); err == nil {
+		t.Fatal("expected an error when role validation fails")
+	}
+
+	updated := &hyperv1.HostedCluster{}
+	if err := client.Get(context.Background(), crclient.ObjectKey{Namespace: "clusters", Name: "example"}, updated); err != nil {
+		t.Fatalf("failed to get hostedcluster: %v", err)
+	}
+	if updated.Spec.Platform.AWS.RolesRef.KubeCloudControllerARN != "arn:aws:iam::123456789012:role/old-kcc" {
+		t.Error("expected the cluster to be left unchanged when validation fails")
+	}
+	if updated.Annotations[hyperv1.RestartDateAnnotation] != "" {
+		t.Error("expected no restart-date annotation when validation fails")
+	}
+}
+
+func TestRotateAWSCredentialsNoARNs(t *testing.T) {
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).WithObjects(awsHostedCluster()).Build()
+	opts := &CredentialsOptions{Namespace: "clusters", Name: "example"}
+	if err := RotateCredentials(context.Background(), client, opts, nil); err == nil {
+		t.Fatal("expected an error when no new ARNs are provided")
+	}
+}
+
+func TestRotateAzureCredentials(t *testing.T) {
+	hostedCluster := &hyperv1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example"},
+		Spec: hyperv1.HostedClusterSpec{
+			Platform: hyperv1.PlatformSpec{
+				Type: hyperv1.AzurePlatform,
+				Azure: &hyperv1.AzurePlatformSpec{
+					Credentials: corev1.LocalObjectReference{Name: "example-cloud-credentials"},
+				},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example-cloud-credentials"},
+		Data: map[string][]byte{
+			"AZURE_SUBSCRIPTION_ID": []byte("old-subscription"),
+			"AZURE_TENANT_ID":       []byte("old-tenant"),
+			"AZURE_CLIENT_ID":       []byte("old-client"),
+			"AZURE_CLIENT_SECRET":   []byte("old-secret"),
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).WithObjects(hostedCluster, secret).Build()
+
+	credsFile := filepath.Join(t.TempDir(), "azure-creds")
+	creds := `subscriptionId: new-subscription
+tenantId: new-tenant
+clientId: new-client
+clientSecret: new-secret
+`
+	if err := os.WriteFile(credsFile, []byte(creds), 0600); err != nil {
+		t.Fatalf("failed to write credentials file: %v", err)
+	}
+
+	opts := &CredentialsOptions{Namespace: "clusters", Name: "example", AzureCredentialsFile: credsFile}
+	if err := RotateCredentials(context.Background(), client, opts, nil); err != nil {
+		t.Fatalf("RotateCredentials failed: %v", err)
+	}
+
+	updatedSecret := &corev1.Secret{}
+	if err := client.Get(context.Background(), crclient.ObjectKey{Namespace: "clusters", Name: "example-cloud-credentials"}, updatedSecret); err != nil {
+		t.Fatalf("failed to get credentials secret: %v", err)
+	}
+	if string(updatedSecret.Data["AZURE_CLIENT_SECRET"]) != "new-secret" {
+		t.Errorf("expected the client secret to be rotated, got %s", updatedSecret.Data["AZURE_CLIENT_SECRET"])
+	}
+	updated := &hyperv1.HostedCluster{}
+	if err := client.Get(context.Background(), crclient.ObjectKey{Namespace: "clusters", Name: "example"}, updated); err != nil {
+		t.Fatalf("failed to get hostedcluster: %v", err)
+	}
+	if updated.Annotations[hyperv1.RestartDateAnnotation] == "" {
+		t.Error("expected the restart-date annotation to be set")
+	}
+}
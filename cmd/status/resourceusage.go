@@ -0,0 +1,167 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/cmd/util"
+	"github.com/openshift/hypershift/hypershift-operator/controllers/manifests"
+)
+
+type ResourceUsageOptions struct {
+	Namespace string
+	Name      string
+	Output    string
+}
+
+// ClusterResourceUsage is the management cluster resource footprint of a
+// single hosted control plane, summed from its pod resource requests.
+type ClusterResourceUsage struct {
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	Pods           int    `json:"pods"`
+	CPURequests    string `json:"cpuRequests"`
+	MemoryRequests string `json:"memoryRequests"`
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "status",
+		Short:        "Reports status of HyperShift resources on the management cluster",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(NewResourceUsageCommand())
+	return cmd
+}
+
+func NewResourceUsageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "resource-usage",
+		Short:        "Sums the CPU/memory requests of hosted control plane pods for capacity planning and chargeback",
+		SilenceUsage: true,
+	}
+
+	opts := ResourceUsageOptions{}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "A HostedCluster namespace. Defaults to all namespaces.")
+	cmd.Flags().StringVar(&opts.Name, "name", opts.Name, "A HostedCluster name. Defaults to all clusters.")
+	cmd.Flags().StringVar(&opts.Output, "output", opts.Output, "Output format. Supports 'json', defaults to a table.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return ReportResourceUsage(cmd.Context(), &opts)
+	}
+
+	return cmd
+}
+
+func ReportResourceUsage(ctx context.Context, opts *ResourceUsageOptions) error {
+	client, err := util.GetClient()
+	if err != nil {
+		return err
+	}
+
+	var listOpts []crclient.ListOption
+	if opts.Namespace != "" {
+		listOpts = append(listOpts, crclient.InNamespace(opts.Namespace))
+	}
+	var clusters hyperv1.HostedClusterList
+	if err := client.List(ctx, &clusters, listOpts...); err != nil {
+		return fmt.Errorf("failed to list hostedclusters: %w", err)
+	}
+
+	var usages []ClusterResourceUsage
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if opts.Name != "" && cluster.Name != opts.Name {
+			continue
+		}
+		controlPlaneNamespace := manifests.HostedControlPlaneNamespaceFor(cluster)
+		var pods corev1.PodList
+		if err := client.List(ctx, &pods, crclient.InNamespace(controlPlaneNamespace)); err != nil {
+			return fmt.Errorf("failed to list pods in %s: %w", controlPlaneNamespace, err)
+		}
+		usages = append(usages, clusterResourceUsage(cluster, &pods))
+	}
+	if opts.Name != "" && len(usages) == 0 {
+		return fmt.Errorf("hostedcluster %s not found", opts.Name)
+	}
+
+	switch opts.Output {
+	case "json":
+		encoded, err := json.MarshalIndent(usages, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode resource usage: %w", err)
+		}
+		fmt.Println(string(encoded))
+	case "":
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "NAMESPACE\tNAME\tPODS\tCPU REQUESTS\tMEMORY REQUESTS")
+		for _, usage := range usages {
+			fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%s\n", usage.Namespace, usage.Name, usage.Pods, usage.CPURequests, usage.MemoryRequests)
+		}
+		if len(usages) > 1 {
+			total := totalResourceUsage(usages)
+			fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%s\n", "", "TOTAL", total.Pods, total.CPURequests, total.MemoryRequests)
+		}
+		return writer.Flush()
+	default:
+		return fmt.Errorf("unsupported output format %q", opts.Output)
+	}
+
+	return nil
+}
+
+// clusterResourceUsage sums the resource requests of the cluster's control
+// plane pods. Pods that already ran to completion no longer consume their
+// requests and are skipped.
+func clusterResourceUsage(cluster *hyperv1.HostedCluster, pods *corev1.PodList) ClusterResourceUsage {
+	usage := ClusterResourceUsage{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Name,
+	}
+	cpu := resource.Quantity{}
+	memory := resource.Quantity{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		usage.Pods++
+		for _, container := range pod.Spec.Containers {
+			if request, ok := container.Resources.Requests[corev1.ResourceCPU]; ok {
+				cpu.Add(request)
+			}
+			if request, ok := container.Resources.Requests[corev1.ResourceMemory]; ok {
+				memory.Add(request)
+			}
+		}
+	}
+	usage.CPURequests = cpu.String()
+	usage.MemoryRequests = memory.String()
+	return usage
+}
+
+// totalResourceUsage aggregates per-cluster usage into a fleet-wide total.
+func totalResourceUsage(usages []ClusterResourceUsage) ClusterResourceUsage {
+	total := ClusterResourceUsage{}
+	cpu := resource.Quantity{}
+	memory := resource.Quantity{}
+	for _, usage := range usages {
+		total.Pods += usage.Pods
+		cpu.Add(resource.MustParse(usage.CPURequests))
+		memory.Add(resource.MustParse(usage.MemoryRequests))
+	}
+	total.CPURequests = cpu.String()
+	total.MemoryRequests = memory.String()
+	return total
+}
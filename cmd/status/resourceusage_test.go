@@ -0,0 +1,81 @@
+package status
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+func TestClusterResourceUsage(t *testing.T) {
+	cluster := &hyperv1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: "example"},
+	}
+	requests := func(cpu, memory string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		}
+	}
+	pods := &corev1.PodList{
+		Items: []corev1.Pod{
+			{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "kube-apiserver", Resources: requests("350m", "2Gi")},
+						{Name: "konnectivity-server", Resources: requests("10m", "50Mi")},
+					},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "etcd", Resources: requests("600m", "600Mi")},
+					},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "completed", Resources: requests("1", "1Gi")},
+					},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+			},
+		},
+	}
+
+	usage := clusterResourceUsage(cluster, pods)
+	if usage.Pods != 2 {
+		t.Errorf("expected 2 pods, got %d", usage.Pods)
+	}
+	if expected := resource.MustParse("960m"); usage.CPURequests != expected.String() {
+		t.Errorf("expected cpu requests %s, got %s", expected.String(), usage.CPURequests)
+	}
+	if expected := resource.MustParse("2698Mi"); usage.MemoryRequests != expected.String() {
+		t.Errorf("expected memory requests %s, got %s", expected.String(), usage.MemoryRequests)
+	}
+}
+
+func TestTotalResourceUsage(t *testing.T) {
+	total := totalResourceUsage([]ClusterResourceUsage{
+		{Pods: 2, CPURequests: "500m", MemoryRequests: "1Gi"},
+		{Pods: 3, CPURequests: "1500m", MemoryRequests: "3Gi"},
+	})
+	if total.Pods != 5 {
+		t.Errorf("expected 5 pods, got %d", total.Pods)
+	}
+	if expected := resource.MustParse("2"); total.CPURequests != expected.String() {
+		t.Errorf("expected cpu requests %s, got %s", expected.String(), total.CPURequests)
+	}
+	if expected := resource.MustParse("4Gi"); total.MemoryRequests != expected.String() {
+		t.Errorf("expected memory requests %s, got %s", expected.String(), total.MemoryRequests)
+	}
+}
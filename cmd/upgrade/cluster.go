@@ -0,0 +1,206 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/util/wait"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/cmd/log"
+	"github.com/openshift/hypershift/cmd/util"
+)
+
+// ClusterOptions drives a full upgrade of a hosted cluster: the control plane
+// first, then the NodePools once the new control plane version is rolled out.
+type ClusterOptions struct {
+	Namespace string
+	Name      string
+
+	// ToImage is the release image to upgrade to.
+	ToImage string
+
+	// NodePoolConcurrency is how many NodePools are upgraded in parallel once
+	// the control plane upgrade is complete.
+	NodePoolConcurrency int
+
+	Log logr.Logger
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "upgrade",
+		Short:        "Commands to upgrade HyperShift resources",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(NewUpgradeClusterCommand())
+	return cmd
+}
+
+func NewUpgradeClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "cluster",
+		Short:        "Upgrades a HostedCluster's control plane and then its NodePools",
+		SilenceUsage: true,
+	}
+
+	opts := &ClusterOptions{
+		Namespace:           "clusters",
+		NodePoolConcurrency: 1,
+		Log:                 log.Log,
+	}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "The namespace of the HostedCluster")
+	cmd.Flags().StringVar(&opts.Name, "name", opts.Name, "The name of the HostedCluster")
+	cmd.Flags().StringVar(&opts.ToImage, "to", opts.ToImage, "The release image to upgrade the cluster to")
+	cmd.Flags().IntVar(&opts.NodePoolConcurrency, "node-pool-concurrency", opts.NodePoolConcurrency, "How many NodePools to upgrade in parallel after the control plane upgrade completes")
+
+	cmd.MarkFlagRequired("name")
+	cmd.MarkFlagRequired("to")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		c, err := util.GetClient()
+		if err != nil {
+			return err
+		}
+		return UpgradeCluster(cmd.Context(), c, opts)
+	}
+
+	return cmd
+}
+
+// UpgradeCluster codifies the manual upgrade runbook: it moves the
+// HostedCluster to the target release, waits for the control plane rollout to
+// complete, and then rolls the cluster's NodePools to the same release with
+// the configured concurrency.
+func UpgradeCluster(ctx context.Context, c crclient.Client, opts *ClusterOptions) error {
+	if opts.NodePoolConcurrency < 1 {
+		return fmt.Errorf("node pool concurrency must be at least 1")
+	}
+
+	hostedCluster := &hyperv1.HostedCluster{}
+	if err := c.Get(ctx, crclient.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, hostedCluster); err != nil {
+		return fmt.Errorf("failed to get hostedcluster %s/%s: %w", opts.Namespace, opts.Name, err)
+	}
+
+	if hostedCluster.Spec.Release.Image == opts.ToImage {
+		opts.Log.Info("Control plane already targets the release", "image", opts.ToImage)
+	} else {
+		hostedCluster.Spec.Release.Image = opts.ToImage
+		if err := c.Update(ctx, hostedCluster); err != nil {
+			return fmt.Errorf("failed to update hostedcluster %s/%s: %w", opts.Namespace, opts.Name, err)
+		}
+		opts.Log.Info("Updated control plane release", "image", opts.ToImage)
+	}
+
+	targetVersion, err := waitForControlPlaneUpgrade(ctx, c, opts)
+	if err != nil {
+		return err
+	}
+	opts.Log.Info("Control plane upgrade complete", "version", targetVersion)
+
+	nodePools, err := clusterNodePools(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+	if len(nodePools) == 0 {
+		opts.Log.Info("No NodePools to upgrade")
+		return nil
+	}
+
+	queue := make(chan *hyperv1.NodePool, len(nodePools))
+	for i := range nodePools {
+		queue <- &nodePools[i]
+	}
+	close(queue)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	for i := 0; i < opts.NodePoolConcurrency; i++ {
+		eg.Go(func() error {
+			for nodePool := range queue {
+				if err := upgradeNodePool(egCtx, c, opts, nodePool, targetVersion); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	opts.Log.Info("Cluster upgrade complete", "version", targetVersion)
+	return nil
+}
+
+// waitForControlPlaneUpgrade polls the HostedCluster until the target release
+// is the most recent completed entry in the version history, and returns the
+// version NodePools should converge to.
+func waitForControlPlaneUpgrade(ctx context.Context, c crclient.Client, opts *ClusterOptions) (string, error) {
+	opts.Log.Info("Waiting for control plane upgrade to complete")
+	var targetVersion string
+	err := wait.PollImmediateInfiniteWithContext(ctx, 30*time.Second, func(ctx context.Context) (bool, error) {
+		hostedCluster := &hyperv1.HostedCluster{}
+		if err := c.Get(ctx, crclient.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, hostedCluster); err != nil {
+			return false, fmt.Errorf("failed to get hostedcluster %s/%s: %w", opts.Namespace, opts.Name, err)
+		}
+		version := hostedCluster.Status.Version
+		complete := version != nil &&
+			version.Desired.Image == opts.ToImage &&
+			len(version.History) > 0 &&
+			version.History[0].Image == opts.ToImage &&
+			version.History[0].State == configv1.CompletedUpdate
+		if !complete {
+			opts.Log.Info("Control plane upgrade not finished yet, checking again in 30 seconds...")
+			return false, nil
+		}
+		targetVersion = version.History[0].Version
+		return true, nil
+	})
+	return targetVersion, err
+}
+
+// clusterNodePools returns the NodePools that belong to the given HostedCluster.
+func clusterNodePools(ctx context.Context, c crclient.Client, namespace, name string) ([]hyperv1.NodePool, error) {
+	nodePoolList := &hyperv1.NodePoolList{}
+	if err := c.List(ctx, nodePoolList, crclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list nodepools in namespace %s: %w", namespace, err)
+	}
+	var nodePools []hyperv1.NodePool
+	for _, nodePool := range nodePoolList.Items {
+		if nodePool.Spec.ClusterName == name {
+			nodePools = append(nodePools, nodePool)
+		}
+	}
+	return nodePools, nil
+}
+
+// upgradeNodePool moves one NodePool to the target release and waits until its
+// reported version matches the control plane's.
+func upgradeNodePool(ctx context.Context, c crclient.Client, opts *ClusterOptions, nodePool *hyperv1.NodePool, targetVersion string) error {
+	if nodePool.Spec.Release.Image != opts.ToImage {
+		nodePool.Spec.Release.Image = opts.ToImage
+		if err := c.Update(ctx, nodePool); err != nil {
+			return fmt.Errorf("failed to update nodepool %s/%s: %w", nodePool.Namespace, nodePool.Name, err)
+		}
+	}
+	opts.Log.Info("Waiting for NodePool upgrade to complete", "nodepool", nodePool.Name)
+	err := wait.PollImmediateInfiniteWithContext(ctx, 30*time.Second, func(ctx context.Context) (bool, error) {
+		latest := &hyperv1.NodePool{}
+		if err := c.Get(ctx, crclient.ObjectKeyFromObject(nodePool), latest); err != nil {
+			return false, fmt.Errorf("failed to get nodepool %s/%s: %w", nodePool.Namespace, nodePool.Name, err)
+		}
+		return latest.Status.Version == targetVersion, nil
+	})
+	if err != nil {
+		return err
+	}
+	opts.Log.Info("NodePool upgrade complete", "nodepool", nodePool.Name, "version", targetVersion)
+	return nil
+}
@@ -0,0 +1,54 @@
+package upgrade
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/cmd/log"
+	hyperapi "github.com/openshift/hypershift/support/api"
+)
+
+func TestClusterNodePools(t *testing.T) {
+	nodePool := func(name, clusterName string) *hyperv1.NodePool {
+		return &hyperv1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "clusters", Name: name},
+			Spec:       hyperv1.NodePoolSpec{ClusterName: clusterName},
+		}
+	}
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).WithObjects(
+		nodePool("example-a", "example"),
+		nodePool("example-b", "example"),
+		nodePool("other-a", "other"),
+	).Build()
+
+	nodePools, err := clusterNodePools(context.Background(), client, "clusters", "example")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(nodePools) != 2 {
+		t.Errorf("expected 2 nodepools, got %d", len(nodePools))
+	}
+	for _, nodePool := range nodePools {
+		if nodePool.Spec.ClusterName != "example" {
+			t.Errorf("unexpected nodepool %s for cluster %s", nodePool.Name, nodePool.Spec.ClusterName)
+		}
+	}
+}
+
+func TestUpgradeClusterInvalidConcurrency(t *testing.T) {
+	client := fake.NewClientBuilder().WithScheme(hyperapi.Scheme).Build()
+	opts := &ClusterOptions{
+		Namespace:           "clusters",
+		Name:                "example",
+		ToImage:             "image-4.15.0",
+		NodePoolConcurrency: 0,
+		Log:                 log.Log,
+	}
+	if err := UpgradeCluster(context.Background(), client, opts); err == nil {
+		t.Error("expected error for invalid node pool concurrency, got none")
+	}
+}
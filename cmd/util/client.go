@@ -4,12 +4,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
-	cr "sigs.k8s.io/controller-runtime"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	crconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
 
 	hyperapi "github.com/openshift/hypershift/api"
 )
@@ -18,9 +19,21 @@ const (
 	AutoInfraLabelName = "hypershift.openshift.io/auto-created-for-infra"
 )
 
-// GetConfig creates a REST config from current context
+// managementKubeconfigContext selects a context from the management cluster
+// kubeconfig. When empty, the current context is used.
+var managementKubeconfigContext string
+
+// BindManagementKubeconfigContextFlag registers the --management-kubeconfig-context
+// flag which selects the kubeconfig context used by commands that talk to the
+// management cluster.
+func BindManagementKubeconfigContextFlag(flags *pflag.FlagSet) {
+	flags.StringVar(&managementKubeconfigContext, "management-kubeconfig-context", managementKubeconfigContext, "The kubeconfig context to use for the management cluster (defaults to the current context)")
+}
+
+// GetConfig creates a REST config from the management kubeconfig context if one
+// was specified, otherwise from the current context
 func GetConfig() (*rest.Config, error) {
-	cfg, err := cr.GetConfig()
+	cfg, err := crconfig.GetConfigWithContext(managementKubeconfigContext)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,35 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+// ParseTaint parses a taint in the form key=value:Effect or key:Effect, the
+// same format accepted by kubectl taint.
+func ParseTaint(taint string) (hyperv1.Taint, error) {
+	parts := strings.Split(taint, ":")
+	if len(parts) != 2 || parts[0] == "" {
+		return hyperv1.Taint{}, fmt.Errorf("invalid taint %q, expected key=value:Effect or key:Effect", taint)
+	}
+	effect := corev1.TaintEffect(parts[1])
+	switch effect {
+	case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+	default:
+		return hyperv1.Taint{}, fmt.Errorf("invalid taint effect %q, must be one of %s, %s or %s", parts[1], corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute)
+	}
+	key := parts[0]
+	value := ""
+	if keyValue := strings.SplitN(parts[0], "=", 2); len(keyValue) == 2 {
+		key = keyValue[0]
+		value = keyValue[1]
+	}
+	if key == "" {
+		return hyperv1.Taint{}, fmt.Errorf("invalid taint %q, key must not be empty", taint)
+	}
+	return hyperv1.Taint{Key: key, Value: value, Effect: effect}, nil
+}
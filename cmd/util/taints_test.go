@@ -0,0 +1,61 @@
+package util
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+func TestParseTaint(t *testing.T) {
+	testCases := []struct {
+		name        string
+		taint       string
+		expected    hyperv1.Taint
+		expectError bool
+	}{
+		{
+			name:     "key, value and effect",
+			taint:    "dedicated=infra:NoSchedule",
+			expected: hyperv1.Taint{Key: "dedicated", Value: "infra", Effect: corev1.TaintEffectNoSchedule},
+		},
+		{
+			name:     "key and effect without value",
+			taint:    "dedicated:NoExecute",
+			expected: hyperv1.Taint{Key: "dedicated", Effect: corev1.TaintEffectNoExecute},
+		},
+		{
+			name:        "missing effect",
+			taint:       "dedicated=infra",
+			expectError: true,
+		},
+		{
+			name:        "invalid effect",
+			taint:       "dedicated=infra:Sometimes",
+			expectError: true,
+		},
+		{
+			name:        "empty key",
+			taint:       "=infra:NoSchedule",
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := ParseTaint(tc.taint)
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", parsed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if parsed != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, parsed)
+			}
+		})
+	}
+}
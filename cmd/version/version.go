@@ -1,14 +1,19 @@
 package version
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 
-	"github.com/openshift/hypershift/pkg/version"
 	"github.com/spf13/cobra"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift/hypershift/cmd/util"
+	supportedversion "github.com/openshift/hypershift/hypershift-operator/controllers/manifests/supportedversion"
+	"github.com/openshift/hypershift/pkg/version"
 )
 
 var (
@@ -60,8 +65,34 @@ func LookupDefaultOCPVersion(releaseStream string) (OCPVersion, error) {
 	return version, nil
 }
 
+// fetchServerSupportedVersions reads the OCP versions the installed HyperShift
+// operator supports from the supported-versions ConfigMap it maintains.
+func fetchServerSupportedVersions(ctx context.Context, namespace string) ([]string, error) {
+	c, err := util.GetClient()
+	if err != nil {
+		return nil, err
+	}
+	cm := supportedversion.ConfigMap(namespace)
+	if err := c.Get(ctx, crclient.ObjectKeyFromObject(cm), cm); err != nil {
+		return nil, err
+	}
+	content, exists := cm.Data["supported-versions"]
+	if !exists {
+		return nil, fmt.Errorf("configmap %s/%s has no supported-versions key", cm.Namespace, cm.Name)
+	}
+	var versions struct {
+		Versions []string `json:"versions"`
+	}
+	if err := json.Unmarshal([]byte(content), &versions); err != nil {
+		return nil, fmt.Errorf("cannot parse supported versions: %w", err)
+	}
+	return versions.Versions, nil
+}
+
 func NewVersionCommand() *cobra.Command {
 	var commitOnly bool
+	var clientOnly bool
+	namespace := "hypershift"
 	cmd := &cobra.Command{
 		Use:          "version",
 		Short:        "Prints HyperShift CLI version",
@@ -71,9 +102,20 @@ func NewVersionCommand() *cobra.Command {
 				fmt.Printf("%s\n", version.GetRevision())
 				return
 			}
-			fmt.Printf("%s\n", version.String())
+			fmt.Printf("Client Version: %s\n", version.String())
+			if clientOnly {
+				return
+			}
+			supportedVersions, err := fetchServerSupportedVersions(cmd.Context(), namespace)
+			if err != nil {
+				fmt.Printf("Server supported OCP versions: unavailable (%v)\n", err)
+				return
+			}
+			fmt.Printf("Server supported OCP versions: %s\n", strings.Join(supportedVersions, ", "))
 		},
 	}
 	cmd.Flags().BoolVar(&commitOnly, "commit-only", commitOnly, "Output only the code commit")
+	cmd.Flags().BoolVar(&clientOnly, "client-only", clientOnly, "Output only the client version")
+	cmd.Flags().StringVar(&namespace, "namespace", namespace, "The namespace in which the HyperShift operator is installed")
 	return cmd
 }
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	"github.com/openshift/hypershift/control-plane-operator/controllers/hostedcontrolplane/imageprovider"
@@ -21,6 +22,14 @@ func ReconcileCCMServiceAccount(sa *corev1.ServiceAccount, ownerRef config.Owner
 }
 
 func ReconcileDeployment(deployment *appsv1.Deployment, hcp *hyperv1.HostedControlPlane, deploymentConfig config.DeploymentConfig, serviceAccountName string, releaseImageProvider *imageprovider.ReleaseImageProvider) error {
+	var featureGates []string
+	if value, ok := hcp.Annotations[hyperv1.CCMFeatureGatesAnnotation]; ok {
+		var err error
+		featureGates, err = config.FeatureGatesFromAnnotation(value)
+		if err != nil {
+			return fmt.Errorf("failed to parse cloud controller manager feature gates: %w", err)
+		}
+	}
 	deployment.Spec = appsv1.DeploymentSpec{
 		Selector: &metav1.LabelSelector{
 			MatchLabels: ccmLabels(),
@@ -34,7 +43,7 @@ func ReconcileDeployment(deployment *appsv1.Deployment, hcp *hyperv1.HostedContr
 			},
 			Spec: corev1.PodSpec{
 				Containers: []corev1.Container{
-					util.BuildContainer(ccmContainer(), buildCCMContainer(releaseImageProvider.GetImage("aws-cloud-controller-manager"))),
+					util.BuildContainer(ccmContainer(), buildCCMContainer(releaseImageProvider.GetImage("aws-cloud-controller-manager"), featureGates)),
 				},
 				Volumes:            []corev1.Volume{},
 				ServiceAccountName: serviceAccountName,
@@ -42,7 +51,7 @@ func ReconcileDeployment(deployment *appsv1.Deployment, hcp *hyperv1.HostedContr
 		},
 	}
 
-	addVolumes(deployment)
+	addVolumes(deployment, hcp.Spec.CloudProviderConfig)
 
 	util.ApplyCloudProviderCreds(&deployment.Spec.Template.Spec, Provider, &corev1.LocalObjectReference{Name: KubeCloudControllerCredsSecret("").Name}, releaseImageProvider.GetImage("token-minter"), ccmContainer().Name)
 
@@ -51,16 +60,23 @@ func ReconcileDeployment(deployment *appsv1.Deployment, hcp *hyperv1.HostedContr
 	return nil
 }
 
-func addVolumes(deployment *appsv1.Deployment) {
+func addVolumes(deployment *appsv1.Deployment, cloudProviderConfig *corev1.LocalObjectReference) {
 
 	deployment.Spec.Template.Spec.Volumes = append(
 		deployment.Spec.Template.Spec.Volumes,
 		util.BuildVolume(ccmVolumeKubeconfig(), buildCCMVolumeKubeconfig),
 	)
-	deployment.Spec.Template.Spec.Volumes = append(
-		deployment.Spec.Template.Spec.Volumes,
-		util.BuildVolume(ccmCloudConfig(), buildCCMCloudConfig),
-	)
+	if cloudProviderConfig != nil && len(cloudProviderConfig.Name) > 0 {
+		deployment.Spec.Template.Spec.Volumes = append(
+			deployment.Spec.Template.Spec.Volumes,
+			util.BuildVolume(ccmCloudConfig(), buildCCMCustomCloudConfig(cloudProviderConfig.Name)),
+		)
+	} else {
+		deployment.Spec.Template.Spec.Volumes = append(
+			deployment.Spec.Template.Spec.Volumes,
+			util.BuildVolume(ccmCloudConfig(), buildCCMCloudConfig),
+		)
+	}
 	deployment.Spec.Template.Spec.Volumes = append(
 		deployment.Spec.Template.Spec.Volumes,
 		util.BuildVolume(ccmCloudControllerCreds(), buildCCMControllerCreds),
@@ -77,7 +93,7 @@ func podVolumeMounts() util.PodVolumeMounts {
 	}
 }
 
-func buildCCMContainer(controllerManagerImage string) func(c *corev1.Container) {
+func buildCCMContainer(controllerManagerImage string, featureGates []string) func(c *corev1.Container) {
 	return func(c *corev1.Container) {
 		c.Image = controllerManagerImage
 		c.ImagePullPolicy = corev1.PullIfNotPresent
@@ -96,6 +112,9 @@ func buildCCMContainer(controllerManagerImage string) func(c *corev1.Container)
 			"--authentication-kubeconfig=/etc/kubernetes/kubeconfig/kubeconfig",
 			"--authorization-kubeconfig=/etc/kubernetes/kubeconfig/kubeconfig",
 		}
+		if len(featureGates) > 0 {
+			c.Args = append(c.Args, fmt.Sprintf("--feature-gates=%s", strings.Join(featureGates, ",")))
+		}
 		c.VolumeMounts = podVolumeMounts().ContainerMounts(c.Name)
 	}
 }
@@ -120,6 +139,23 @@ func buildCCMCloudConfig(v *corev1.Volume) {
 	}
 }
 
+// buildCCMCustomCloudConfig mounts a user provided cloud provider config
+// secret in place of the generated config, projecting the config key to the
+// path the cloud controller manager already reads.
+func buildCCMCustomCloudConfig(secretName string) func(v *corev1.Volume) {
+	return func(v *corev1.Volume) {
+		v.Secret = &corev1.SecretVolumeSource{
+			SecretName: secretName,
+			Items: []corev1.KeyToPath{
+				{
+					Key:  hyperv1.CloudProviderConfigKey,
+					Path: ProviderConfigKey,
+				},
+			},
+		}
+	}
+}
+
 func ccmLabels() map[string]string {
 	return map[string]string{
 		"app": "cloud-controller-manager",
@@ -29,6 +29,8 @@ type EtcdParams struct {
 
 	StorageSpec hyperv1.ManagedEtcdStorageSpec
 
+	CompactionSpec *hyperv1.EtcdCompactionSpec
+
 	Availability hyperv1.AvailabilityPolicy
 
 	SnapshotRestored bool
@@ -92,6 +94,8 @@ func NewEtcdParams(hcp *hyperv1.HostedControlPlane, releaseImageProvider *imagep
 		}
 	}
 
+	p.CompactionSpec = hcp.Spec.Etcd.Managed.Compaction
+
 	if len(hcp.Spec.Etcd.Managed.Storage.RestoreSnapshotURL) > 0 {
 		p.StorageSpec.RestoreSnapshotURL = hcp.Spec.Etcd.Managed.Storage.RestoreSnapshotURL
 		p.SnapshotRestored = meta.IsStatusConditionTrue(hcp.Status.Conditions, string(hyperv1.EtcdSnapshotRestored))
@@ -325,6 +325,13 @@ fi
 
 		script := fmt.Sprintf(scriptTemplate, podIP, podIP, allInterfaces)
 
+		// Appending the compaction flags changes the pod template, so the
+		// statefulset rolls the etcd members when the configuration changes.
+		if p.CompactionSpec != nil {
+			script = strings.TrimRight(script, "\n")
+			script += fmt.Sprintf(" \\\n--auto-compaction-mode=%s \\\n--auto-compaction-retention=%s\n", strings.ToLower(string(p.CompactionSpec.Mode)), p.CompactionSpec.Retention)
+		}
+
 		var members []string
 		for i := 0; i < p.DeploymentConfig.Replicas; i++ {
 			name := fmt.Sprintf("etcd-%d", i)
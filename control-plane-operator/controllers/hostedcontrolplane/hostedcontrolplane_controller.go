@@ -9,7 +9,9 @@ import (
 	"net/http"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
@@ -167,18 +169,30 @@ type HostedControlPlaneReconciler struct {
 	OperateOnReleaseImage         string
 	DefaultIngressDomain          string
 	MetricsSet                    metrics.MetricsSet
+	ReconcileRateLimiter          workqueue.RateLimiter
 	SREConfigHash                 string
 	ec2Client                     ec2iface.EC2API
 	awsSession                    *session.Session
 	reconcileInfrastructureStatus func(ctx context.Context, hcp *hyperv1.HostedControlPlane) (InfrastructureStatus, error)
+
+	// kasProbeFailingSince tracks per control plane when the kube-apiserver
+	// reachability probe started failing, to debounce Available condition flips.
+	kasProbeFailingSince sync.Map
+	// kasProbeFailures counts per control plane the consecutive reconciles in
+	// which the kube-apiserver reachability probe failed.
+	kasProbeFailures sync.Map
 }
 
 func (r *HostedControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager, createOrUpdate upsert.CreateOrUpdateFN) error {
 	r.setup(createOrUpdate)
+	rateLimiter := r.ReconcileRateLimiter
+	if rateLimiter == nil {
+		rateLimiter = workqueue.NewItemExponentialFailureRateLimiter(1*time.Second, 10*time.Second)
+	}
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(&hyperv1.HostedControlPlane{}).
 		WithOptions(controller.Options{
-			RateLimiter: workqueue.NewItemExponentialFailureRateLimiter(1*time.Second, 10*time.Second),
+			RateLimiter: rateLimiter,
 		})
 	for _, handler := range r.eventHandlers(mgr.GetScheme(), mgr.GetRESTMapper()) {
 		b.Watches(handler.obj, handler.handler)
@@ -243,11 +257,34 @@ func createOrUpdateWithDelayForScrapeConfigs(hcp *hyperv1.HostedControlPlane, up
 	}
 }
 
+// createOrUpdateWithCustomMetricsRelabeling appends the metric relabeling
+// rules configured on the hosted cluster to every ServiceMonitor endpoint, so
+// fleet-wide rules apply uniformly without each component knowing about them.
+func createOrUpdateWithCustomMetricsRelabeling(hcp *hyperv1.HostedControlPlane, upstreamCreateOrUpdate upsert.CreateOrUpdateFN) upsert.CreateOrUpdateFN {
+	return func(ctx context.Context, c client.Client, obj client.Object, f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+		serviceMonitor, ok := obj.(*prometheusoperatorv1.ServiceMonitor)
+		if !ok || hcp.Spec.Configuration == nil || hcp.Spec.Configuration.Metrics == nil || len(hcp.Spec.Configuration.Metrics.RelabelConfigs) == 0 {
+			return upstreamCreateOrUpdate(ctx, c, obj, f)
+		}
+		customRelabelConfigs := metrics.CustomRelabelConfigs(hcp.Spec.Configuration.Metrics.RelabelConfigs)
+		mutate := func() error {
+			if err := f(); err != nil {
+				return err
+			}
+			for i := range serviceMonitor.Spec.Endpoints {
+				serviceMonitor.Spec.Endpoints[i].MetricRelabelConfigs = append(serviceMonitor.Spec.Endpoints[i].MetricRelabelConfigs, customRelabelConfigs...)
+			}
+			return nil
+		}
+		return upstreamCreateOrUpdate(ctx, c, obj, mutate)
+	}
+}
+
 func (r *HostedControlPlaneReconciler) setup(upstreamCreateOrUpdate upsert.CreateOrUpdateFN) {
 	createOrUpdateFactory := createOrUpdateWithOwnerRefFactory(upstreamCreateOrUpdate)
 
 	r.createOrUpdate = func(hcp *hyperv1.HostedControlPlane) upsert.CreateOrUpdateFN {
-		return createOrUpdateWithDelayForScrapeConfigs(hcp, createOrUpdateFactory(hcp))
+		return createOrUpdateWithDelayForScrapeConfigs(hcp, createOrUpdateWithCustomMetricsRelabeling(hcp, createOrUpdateFactory(hcp)))
 	}
 }
 
@@ -330,6 +367,14 @@ func (r *HostedControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 				return ctrl.Result{}, fmt.Errorf("failed to remove finalizer from cluster: %w", err)
 			}
 		}
+
+		// Drop the probe bookkeeping for this control plane so the state and
+		// metric series do not outlive the object.
+		probeKey := client.ObjectKeyFromObject(hostedControlPlane).String()
+		r.kasProbeFailures.Delete(probeKey)
+		r.kasProbeFailingSince.Delete(probeKey)
+		kasProbeFailuresTotal.DeleteLabelValues(hostedControlPlane.Namespace, hostedControlPlane.Name)
+
 		return ctrl.Result{}, nil
 	}
 
@@ -602,12 +647,14 @@ func (r *HostedControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 	}
 
 	// Reconcile hostedcontrolplane availability and Ready flag
+	var healthCheckRequeueAfter time.Duration
 	{
 		infrastructureCondition := meta.FindStatusCondition(hostedControlPlane.Status.Conditions, string(hyperv1.InfrastructureReady))
 		kubeConfigAvailable := hostedControlPlane.Status.KubeConfig != nil
 		etcdCondition := meta.FindStatusCondition(hostedControlPlane.Status.Conditions, string(hyperv1.EtcdAvailable))
 		kubeAPIServerCondition := meta.FindStatusCondition(hostedControlPlane.Status.Conditions, string(hyperv1.KubeAPIServerAvailable))
-		healthCheckErr := r.healthCheckKASLoadBalancers(ctx, hostedControlPlane)
+		var healthCheckErr error
+		healthCheckRequeueAfter, healthCheckErr = r.healthCheckKASLoadBalancersWithRetry(ctx, hostedControlPlane)
 
 		status := metav1.ConditionFalse
 		var reason, message string
@@ -738,6 +785,12 @@ func (r *HostedControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return result, nil
 	}
 
+	if healthCheckRequeueAfter > 0 {
+		// The KAS reachability probe failed but the Available condition is being
+		// held; probe again after the configured backoff.
+		return ctrl.Result{RequeueAfter: healthCheckRequeueAfter}, nil
+	}
+
 	if !hostedControlPlane.Status.Ready {
 		return ctrl.Result{RequeueAfter: hcpNotReadyRequeueInterval}, nil
 	}
@@ -745,6 +798,79 @@ func (r *HostedControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.R
 	return ctrl.Result{RequeueAfter: hcpReadyRequeueInterval}, nil
 }
 
+const (
+	defaultAvailabilityProbeRetries = 3
+	defaultAvailabilityProbeBackoff = 5 * time.Second
+	maxAvailabilityProbeRetries     = 10
+	maxAvailabilityProbeBackoff     = time.Minute
+	maxAvailabilityProbeDebounce    = 15 * time.Minute
+)
+
+// availabilityProbeConfig reads and validates the availability probe tuning
+// annotations of a control plane.
+func availabilityProbeConfig(hcp *hyperv1.HostedControlPlane) (retries int, backoff, debounce time.Duration, err error) {
+	retries = defaultAvailabilityProbeRetries
+	backoff = defaultAvailabilityProbeBackoff
+	if value := hcp.Annotations[hyperv1.AvailabilityProbeRetriesAnnotation]; value != "" {
+		retries, err = strconv.Atoi(value)
+		if err != nil || retries < 1 || retries > maxAvailabilityProbeRetries {
+			return 0, 0, 0, fmt.Errorf("invalid value %q for annotation %s, must be a positive integer no greater than %d", value, hyperv1.AvailabilityProbeRetriesAnnotation, maxAvailabilityProbeRetries)
+		}
+	}
+	if value := hcp.Annotations[hyperv1.AvailabilityProbeBackoffAnnotation]; value != "" {
+		backoff, err = time.ParseDuration(value)
+		if err != nil || backoff < 0 || backoff > maxAvailabilityProbeBackoff {
+			return 0, 0, 0, fmt.Errorf("invalid value %q for annotation %s, must be a non-negative duration no longer than %s", value, hyperv1.AvailabilityProbeBackoffAnnotation, maxAvailabilityProbeBackoff)
+		}
+	}
+	if value := hcp.Annotations[hyperv1.AvailabilityProbeDebounceAnnotation]; value != "" {
+		debounce, err = time.ParseDuration(value)
+		if err != nil || debounce < 0 || debounce > maxAvailabilityProbeDebounce {
+			return 0, 0, 0, fmt.Errorf("invalid value %q for annotation %s, must be a non-negative duration no longer than %s", value, hyperv1.AvailabilityProbeDebounceAnnotation, maxAvailabilityProbeDebounce)
+		}
+	}
+	return retries, backoff, debounce, nil
+}
+
+// healthCheckKASLoadBalancersWithRetry wraps healthCheckKASLoadBalancers with
+// the configured retries, backoff and debounce so a transient reachability blip
+// between the management and the guest cluster does not flip the Available
+// condition and fire alerts. The probe runs at most once per reconcile: instead
+// of sleeping between attempts it counts consecutive failing reconciles against
+// the retry budget and returns a non-zero duration when the reconcile should be
+// requeued to probe again.
+func (r *HostedControlPlaneReconciler) healthCheckKASLoadBalancersWithRetry(ctx context.Context, hcp *hyperv1.HostedControlPlane) (time.Duration, error) {
+	retries, backoff, debounce, err := availabilityProbeConfig(hcp)
+	if err != nil {
+		return 0, err
+	}
+	key := client.ObjectKeyFromObject(hcp).String()
+	healthCheckErr := r.healthCheckKASLoadBalancers(ctx, hcp)
+	if healthCheckErr == nil {
+		r.kasProbeFailures.Delete(key)
+		r.kasProbeFailingSince.Delete(key)
+		return 0, nil
+	}
+	kasProbeFailuresTotal.WithLabelValues(hcp.Namespace, hcp.Name).Inc()
+	failures := 1
+	if previous, ok := r.kasProbeFailures.Load(key); ok {
+		failures = previous.(int) + 1
+	}
+	r.kasProbeFailures.Store(key, failures)
+	failingSince, _ := r.kasProbeFailingSince.LoadOrStore(key, time.Now())
+	if failures < retries {
+		r.Log.Info("KAS reachability probe failed, will probe again on the next reconcile",
+			"error", healthCheckErr.Error(), "attempt", failures, "retries", retries)
+		return backoff, nil
+	}
+	if elapsed := time.Since(failingSince.(time.Time)); elapsed < debounce {
+		r.Log.Info("KAS reachability probe is failing, holding the Available condition until the debounce elapses",
+			"error", healthCheckErr.Error(), "failingFor", elapsed.Round(time.Second).String(), "debounce", debounce.String())
+		return backoff, nil
+	}
+	return 0, healthCheckErr
+}
+
 // healthCheckKASLoadBalancers performs a health check on the KubeAPI server /healthz endpoint using the public and private load balancers hostnames directly
 // This will detect if load balancers are down or deleted out of band
 func (r *HostedControlPlaneReconciler) healthCheckKASLoadBalancers(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
@@ -1214,8 +1340,12 @@ func (r *HostedControlPlaneReconciler) reconcileAPIServerService(ctx context.Con
 		kasSVCPort = config.KASSVCLBAzurePort
 		apiServerService = manifests.KubeAPIServerServiceAzureLB(hcp.Namespace)
 	}
+	var awsLoadBalancer *hyperv1.AWSLoadBalancerSpec
+	if hcp.Spec.Platform.AWS != nil {
+		awsLoadBalancer = hcp.Spec.Platform.AWS.LoadBalancer
+	}
 	if _, err := createOrUpdate(ctx, r.Client, apiServerService, func() error {
-		return kas.ReconcileService(apiServerService, serviceStrategy, p.OwnerReference, kasSVCPort, p.AllowedCIDRBlocks, util.IsPublicHCP(hcp), util.IsPrivateHCP(hcp))
+		return kas.ReconcileService(apiServerService, serviceStrategy, p.OwnerReference, kasSVCPort, p.AllowedCIDRBlocks, util.IsPublicHCP(hcp), util.IsPrivateHCP(hcp), awsLoadBalancer)
 	}); err != nil {
 		return fmt.Errorf("failed to reconcile API server service: %w", err)
 	}
@@ -1870,8 +2000,25 @@ func (r *HostedControlPlaneReconciler) reconcilePKI(ctx context.Context, hcp *hy
 	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(totalKASClientCABundle), totalKASClientCABundle); err != nil && !apierrors.IsNotFound(err) {
 		return fmt.Errorf("failed to fetch KAS total client CA bundle: %w", err)
 	}
+	additionalClientCAs := []*corev1.ConfigMap{totalKASClientCABundle}
+
+	// The user-provided client CA is merged into the total client CA bundle,
+	// which the kube-apiserver reloads dynamically, so client certs signed by
+	// it are accepted without a rollout.
+	if hcp.Spec.Configuration != nil && hcp.Spec.Configuration.APIServer != nil && len(hcp.Spec.Configuration.APIServer.ClientCA.Name) > 0 {
+		userClientCA := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: hcp.Namespace,
+				Name:      hcp.Spec.Configuration.APIServer.ClientCA.Name,
+			},
+		}
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(userClientCA), userClientCA); err != nil {
+			return fmt.Errorf("failed to fetch user client CA configmap: %w", err)
+		}
+		additionalClientCAs = append(additionalClientCAs, userClientCA)
+	}
 
-	if err := r.setupKASClientSigners(ctx, hcp, p, createOrUpdate, rootCASecret, totalKASClientCABundle); err != nil {
+	if err := r.setupKASClientSigners(ctx, hcp, p, createOrUpdate, rootCASecret, additionalClientCAs...); err != nil {
 		return err
 	}
 
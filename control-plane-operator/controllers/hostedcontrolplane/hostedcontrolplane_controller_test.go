@@ -28,6 +28,7 @@ import (
 	fakereleaseprovider "github.com/openshift/hypershift/support/releaseinfo/fake"
 	"github.com/openshift/hypershift/support/testutil"
 	"github.com/openshift/hypershift/support/util"
+	prometheusoperatorv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"go.uber.org/zap/zaptest"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -61,6 +62,102 @@ func (*fakeEC2Client) DescribeVpcEndpointsWithContext(aws.Context, *ec2.Describe
 	return &ec2.DescribeVpcEndpointsOutput{}, fmt.Errorf("not ready")
 }
 
+func TestAvailabilityProbeConfig(t *testing.T) {
+	testCases := []struct {
+		name             string
+		annotations      map[string]string
+		expectedRetries  int
+		expectedBackoff  time.Duration
+		expectedDebounce time.Duration
+		expectError      bool
+	}{
+		{
+			name:            "defaults without annotations",
+			expectedRetries: defaultAvailabilityProbeRetries,
+			expectedBackoff: defaultAvailabilityProbeBackoff,
+		},
+		{
+			name: "all annotations set",
+			annotations: map[string]string{
+				hyperv1.AvailabilityProbeRetriesAnnotation:  "5",
+				hyperv1.AvailabilityProbeBackoffAnnotation:  "10s",
+				hyperv1.AvailabilityProbeDebounceAnnotation: "1m",
+			},
+			expectedRetries:  5,
+			expectedBackoff:  10 * time.Second,
+			expectedDebounce: time.Minute,
+		},
+		{
+			name: "retries is not a number",
+			annotations: map[string]string{
+				hyperv1.AvailabilityProbeRetriesAnnotation: "many",
+			},
+			expectError: true,
+		},
+		{
+			name: "retries below one",
+			annotations: map[string]string{
+				hyperv1.AvailabilityProbeRetriesAnnotation: "0",
+			},
+			expectError: true,
+		},
+		{
+			name: "retries above the cap",
+			annotations: map[string]string{
+				hyperv1.AvailabilityProbeRetriesAnnotation: "100",
+			},
+			expectError: true,
+		},
+		{
+			name: "backoff is not a duration",
+			annotations: map[string]string{
+				hyperv1.AvailabilityProbeBackoffAnnotation: "soon",
+			},
+			expectError: true,
+		},
+		{
+			name: "backoff above the cap",
+			annotations: map[string]string{
+				hyperv1.AvailabilityProbeBackoffAnnotation: "10m",
+			},
+			expectError: true,
+		},
+		{
+			name: "negative debounce",
+			annotations: map[string]string{
+				hyperv1.AvailabilityProbeDebounceAnnotation: "-1m",
+			},
+			expectError: true,
+		},
+		{
+			name: "debounce above the cap",
+			annotations: map[string]string{
+				hyperv1.AvailabilityProbeDebounceAnnotation: "1h",
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			hcp := &hyperv1.HostedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+			retries, backoff, debounce, err := availabilityProbeConfig(hcp)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(retries).To(Equal(tc.expectedRetries))
+			g.Expect(backoff).To(Equal(tc.expectedBackoff))
+			g.Expect(debounce).To(Equal(tc.expectedDebounce))
+		})
+	}
+}
+
 func TestReconcileKubeadminPassword(t *testing.T) {
 	targetNamespace := "test"
 
@@ -1570,3 +1667,54 @@ func TestReconcileRouterServiceStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateWithCustomMetricsRelabeling(t *testing.T) {
+	upstream := func(ctx context.Context, c client.Client, obj client.Object, f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+		return controllerutil.OperationResultCreated, f()
+	}
+	reconcileEndpoints := func(serviceMonitor *prometheusoperatorv1.ServiceMonitor) controllerutil.MutateFn {
+		return func() error {
+			serviceMonitor.Spec.Endpoints = []prometheusoperatorv1.Endpoint{
+				{
+					Port: "metrics",
+					MetricRelabelConfigs: []*prometheusoperatorv1.RelabelConfig{
+						{Action: "keep", Regex: "up", SourceLabels: []prometheusoperatorv1.LabelName{"__name__"}},
+					},
+				},
+			}
+			return nil
+		}
+	}
+
+	t.Run("when relabel configs are set they are appended to every endpoint", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		hcp := &hyperv1.HostedControlPlane{
+			Spec: hyperv1.HostedControlPlaneSpec{
+				Configuration: &hyperv1.ClusterConfiguration{
+					Metrics: &hyperv1.MetricsSpec{
+						RelabelConfigs: []hyperv1.MetricsRelabelConfig{
+							{Action: "replace", TargetLabel: "tenant", Replacement: "tenant-a"},
+						},
+					},
+				},
+			},
+		}
+		serviceMonitor := &prometheusoperatorv1.ServiceMonitor{}
+		_, err := createOrUpdateWithCustomMetricsRelabeling(hcp, upstream)(context.Background(), nil, serviceMonitor, reconcileEndpoints(serviceMonitor))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(serviceMonitor.Spec.Endpoints[0].MetricRelabelConfigs).To(HaveLen(2))
+		appended := serviceMonitor.Spec.Endpoints[0].MetricRelabelConfigs[1]
+		g.Expect(appended.Action).To(Equal("replace"))
+		g.Expect(appended.TargetLabel).To(Equal("tenant"))
+		g.Expect(appended.Replacement).To(Equal("tenant-a"))
+	})
+
+	t.Run("when no relabel configs are set the endpoints are unchanged", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		hcp := &hyperv1.HostedControlPlane{}
+		serviceMonitor := &prometheusoperatorv1.ServiceMonitor{}
+		_, err := createOrUpdateWithCustomMetricsRelabeling(hcp, upstream)(context.Background(), nil, serviceMonitor, reconcileEndpoints(serviceMonitor))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(serviceMonitor.Spec.Endpoints[0].MetricRelabelConfigs).To(HaveLen(1))
+	})
+}
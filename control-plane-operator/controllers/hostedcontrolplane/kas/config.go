@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/blang/semver"
@@ -87,25 +88,7 @@ func generateConfig(p KubeAPIServerConfigParams, version semver.Version) *kcpv1.
 					},
 					"PodSecurity": {
 						Configuration: runtime.RawExtension{
-							Object: &podsecurityadmissionv1beta1.PodSecurityConfiguration{
-								TypeMeta: metav1.TypeMeta{
-									APIVersion: podsecurityadmissionv1beta1.SchemeGroupVersion.String(),
-									Kind:       "PodSecurityConfiguration",
-								},
-								Defaults: podsecurityadmissionv1beta1.PodSecurityDefaults{
-									Enforce:        "restricted",
-									EnforceVersion: "latest",
-									Audit:          "restricted",
-									AuditVersion:   "latest",
-									Warn:           "restricted",
-									WarnVersion:    "latest",
-								},
-								Exemptions: podsecurityadmissionv1beta1.PodSecurityExemptions{
-									Usernames: []string{
-										"system:serviceaccount:openshift-infra:build-controller",
-									},
-								},
-							},
+							Object: podSecurityConfiguration(p.PodSecurityAdmission),
 						},
 					},
 				},
@@ -138,7 +121,7 @@ func generateConfig(p KubeAPIServerConfigParams, version semver.Version) *kcpv1.
 	args.Set("advertise-address", p.AdvertiseAddress)
 	args.Set("allow-privileged", "true")
 	args.Set("anonymous-auth", "true")
-	args.Set("api-audiences", p.ServiceAccountIssuerURL)
+	args.Set("api-audiences", append([]string{p.ServiceAccountIssuerURL}, p.ServiceAccountAudiences...)...)
 	args.Set("audit-log-format", "json")
 	args.Set("audit-log-maxbackup", "10")
 	args.Set("audit-log-maxsize", "100")
@@ -155,6 +138,14 @@ func generateConfig(p KubeAPIServerConfigParams, version semver.Version) *kcpv1.
 	if p.AuditWebhookEnabled {
 		args.Set("audit-webhook-config-file", auditWebhookConfigFile())
 		args.Set("audit-webhook-mode", "batch")
+		if p.AuditWebhookBatching != nil {
+			if p.AuditWebhookBatching.MaxSize != nil {
+				args.Set("audit-webhook-batch-max-size", strconv.Itoa(int(*p.AuditWebhookBatching.MaxSize)))
+			}
+			if p.AuditWebhookBatching.MaxWait != nil {
+				args.Set("audit-webhook-batch-max-wait", p.AuditWebhookBatching.MaxWait.Duration.String())
+			}
+		}
 	}
 	if p.DisableProfiling {
 		args.Set("profiling", "false")
@@ -191,6 +182,9 @@ func generateConfig(p KubeAPIServerConfigParams, version semver.Version) *kcpv1.
 			args.Set("oidc-ca-file", oidcCAFile(provider.Issuer.CertificateAuthority.Name))
 		}
 	}
+	if p.APIServerTuning != nil && p.APIServerTuning.DefaultWatchCacheSize != nil {
+		args.Set("default-watch-cache-size", strconv.Itoa(int(*p.APIServerTuning.DefaultWatchCacheSize)))
+	}
 	args.Set("enable-aggregator-routing", "true")
 	args.Set("enable-logs-handler", "false")
 	args.Set("endpoint-reconciler-type", "lease")
@@ -201,7 +195,11 @@ func generateConfig(p KubeAPIServerConfigParams, version semver.Version) *kcpv1.
 	args.Set("etcd-servers", p.EtcdURL)
 	args.Set("event-ttl", "3h")
 	args.Set("feature-gates", p.FeatureGates...)
-	args.Set("goaway-chance", "0")
+	goawayChance := "0"
+	if p.APIServerTuning != nil && p.APIServerTuning.GoawayChance != "" {
+		goawayChance = p.APIServerTuning.GoawayChance
+	}
+	args.Set("goaway-chance", goawayChance)
 	args.Set("http2-max-streams-per-connection", "2000")
 	args.Set("kubelet-certificate-authority", cpath(kasVolumeKubeletClientCA().Name, certs.CASignerCertMapKey))
 	args.Set("kubelet-client-certificate", cpath(kasVolumeKubeletClientCert().Name, corev1.TLSCertKey))
@@ -209,8 +207,18 @@ func generateConfig(p KubeAPIServerConfigParams, version semver.Version) *kcpv1.
 	args.Set("kubelet-preferred-address-types", "InternalIP")
 	args.Set("kubelet-read-only-port", "0")
 	args.Set("kubernetes-service-node-port", "0")
-	args.Set("max-mutating-requests-inflight", "1000")
-	args.Set("max-requests-inflight", "3000")
+	maxMutatingRequestsInflight := "1000"
+	maxRequestsInflight := "3000"
+	if p.APIServerTuning != nil {
+		if p.APIServerTuning.MaxMutatingRequestsInflight != nil {
+			maxMutatingRequestsInflight = strconv.Itoa(int(*p.APIServerTuning.MaxMutatingRequestsInflight))
+		}
+		if p.APIServerTuning.MaxRequestsInflight != nil {
+			maxRequestsInflight = strconv.Itoa(int(*p.APIServerTuning.MaxRequestsInflight))
+		}
+	}
+	args.Set("max-mutating-requests-inflight", maxMutatingRequestsInflight)
+	args.Set("max-requests-inflight", maxRequestsInflight)
 	args.Set("min-request-timeout", "3600")
 	args.Set("proxy-client-cert-file", cpath(kasVolumeAggregatorCert().Name, corev1.TLSCertKey))
 	args.Set("proxy-client-key-file", cpath(kasVolumeAggregatorCert().Name, corev1.TLSPrivateKeyKey))
@@ -232,6 +240,9 @@ func generateConfig(p KubeAPIServerConfigParams, version semver.Version) *kcpv1.
 	args.Set("strict-transport-security-directives", p.APIServerSTSDirectives)
 	args.Set("tls-cert-file", cpath(kasVolumeServerCert().Name, corev1.TLSCertKey))
 	args.Set("tls-private-key-file", cpath(kasVolumeServerCert().Name, corev1.TLSPrivateKeyKey))
+	if p.APIServerTuning != nil && len(p.APIServerTuning.WatchCacheSizes) > 0 {
+		args.Set("watch-cache-sizes", strings.Join(p.APIServerTuning.WatchCacheSizes, ","))
+	}
 	config.APIServerArguments = args
 	return config
 }
@@ -280,6 +291,46 @@ func restrictedEndpointsAdmission(clusterNetwork, serviceNetwork []string) runti
 	return cfg
 }
 
+// podSecurityConfiguration renders the PodSecurity admission configuration,
+// applying the cluster's configured defaults and exemptions on top of the
+// restricted baseline.
+func podSecurityConfiguration(podSecurityAdmission *hyperv1.PodSecurityAdmissionSpec) runtime.Object {
+	cfg := &podsecurityadmissionv1beta1.PodSecurityConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: podsecurityadmissionv1beta1.SchemeGroupVersion.String(),
+			Kind:       "PodSecurityConfiguration",
+		},
+		Defaults: podsecurityadmissionv1beta1.PodSecurityDefaults{
+			Enforce:        "restricted",
+			EnforceVersion: "latest",
+			Audit:          "restricted",
+			AuditVersion:   "latest",
+			Warn:           "restricted",
+			WarnVersion:    "latest",
+		},
+		Exemptions: podsecurityadmissionv1beta1.PodSecurityExemptions{
+			Usernames: []string{
+				"system:serviceaccount:openshift-infra:build-controller",
+			},
+		},
+	}
+	if podSecurityAdmission != nil {
+		if podSecurityAdmission.Enforce != "" {
+			cfg.Defaults.Enforce = string(podSecurityAdmission.Enforce)
+		}
+		if podSecurityAdmission.Audit != "" {
+			cfg.Defaults.Audit = string(podSecurityAdmission.Audit)
+		}
+		if podSecurityAdmission.Warn != "" {
+			cfg.Defaults.Warn = string(podSecurityAdmission.Warn)
+		}
+		cfg.Exemptions.Usernames = append(cfg.Exemptions.Usernames, podSecurityAdmission.ExemptUsernames...)
+		cfg.Exemptions.Namespaces = append(cfg.Exemptions.Namespaces, podSecurityAdmission.ExemptNamespaces...)
+		cfg.Exemptions.RuntimeClasses = append(cfg.Exemptions.RuntimeClasses, podSecurityAdmission.ExemptRuntimeClasses...)
+	}
+	return cfg
+}
+
 func admissionPlugins() []string {
 	return []string{
 		"CertificateApproval",
@@ -0,0 +1,77 @@
+package kas
+
+import (
+	"testing"
+
+	podsecurityadmissionv1beta1 "k8s.io/pod-security-admission/admission/api/v1beta1"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+func TestPodSecurityConfiguration(t *testing.T) {
+	testCases := []struct {
+		name              string
+		spec              *hyperv1.PodSecurityAdmissionSpec
+		expectedEnforce   string
+		expectedAudit     string
+		expectedWarn      string
+		expectedUsernames []string
+	}{
+		{
+			name:              "no configuration defaults to restricted",
+			expectedEnforce:   "restricted",
+			expectedAudit:     "restricted",
+			expectedWarn:      "restricted",
+			expectedUsernames: []string{"system:serviceaccount:openshift-infra:build-controller"},
+		},
+		{
+			name: "configured levels override the defaults",
+			spec: &hyperv1.PodSecurityAdmissionSpec{
+				Enforce: hyperv1.PodSecurityAdmissionLevelBaseline,
+				Warn:    hyperv1.PodSecurityAdmissionLevelPrivileged,
+			},
+			expectedEnforce:   "baseline",
+			expectedAudit:     "restricted",
+			expectedWarn:      "privileged",
+			expectedUsernames: []string{"system:serviceaccount:openshift-infra:build-controller"},
+		},
+		{
+			name: "exemptions are appended to the built in ones",
+			spec: &hyperv1.PodSecurityAdmissionSpec{
+				ExemptUsernames: []string{"system:serviceaccount:example:operator"},
+			},
+			expectedEnforce: "restricted",
+			expectedAudit:   "restricted",
+			expectedWarn:    "restricted",
+			expectedUsernames: []string{
+				"system:serviceaccount:openshift-infra:build-controller",
+				"system:serviceaccount:example:operator",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, ok := podSecurityConfiguration(tc.spec).(*podsecurityadmissionv1beta1.PodSecurityConfiguration)
+			if !ok {
+				t.Fatalf("unexpected configuration type %T", podSecurityConfiguration(tc.spec))
+			}
+			if cfg.Defaults.Enforce != tc.expectedEnforce {
+				t.Errorf("expected enforce %q, got %q", tc.expectedEnforce, cfg.Defaults.Enforce)
+			}
+			if cfg.Defaults.Audit != tc.expectedAudit {
+				t.Errorf("expected audit %q, got %q", tc.expectedAudit, cfg.Defaults.Audit)
+			}
+			if cfg.Defaults.Warn != tc.expectedWarn {
+				t.Errorf("expected warn %q, got %q", tc.expectedWarn, cfg.Defaults.Warn)
+			}
+			if len(cfg.Exemptions.Usernames) != len(tc.expectedUsernames) {
+				t.Fatalf("expected %d exempt usernames, got %v", len(tc.expectedUsernames), cfg.Exemptions.Usernames)
+			}
+			for i, username := range tc.expectedUsernames {
+				if cfg.Exemptions.Usernames[i] != username {
+					t.Errorf("expected exempt username %q, got %q", username, cfg.Exemptions.Usernames[i])
+				}
+			}
+		})
+	}
+}
@@ -33,35 +33,39 @@ type KubeAPIServerImages struct {
 }
 
 type KubeAPIServerParams struct {
-	APIServer           *configv1.APIServerSpec      `json:"apiServer"`
-	Authentication      *configv1.AuthenticationSpec `json:"authentication"`
-	FeatureGate         *configv1.FeatureGateSpec    `json:"featureGate"`
-	Network             *configv1.NetworkSpec        `json:"network"`
-	Image               *configv1.ImageSpec          `json:"image"`
-	Scheduler           *configv1.SchedulerSpec      `json:"scheduler"`
-	CloudProvider       string                       `json:"cloudProvider"`
-	CloudProviderConfig *corev1.LocalObjectReference `json:"cloudProviderConfig"`
-	CloudProviderCreds  *corev1.LocalObjectReference `json:"cloudProviderCreds"`
-
-	ServiceAccountIssuer string   `json:"serviceAccountIssuer"`
-	ServiceCIDRs         []string `json:"serviceCIDRs"`
-	ClusterCIDRs         []string `json:"clusterCIDRs"`
-	AdvertiseAddress     string   `json:"advertiseAddress"`
-	ExternalAddress      string   `json:"externalAddress"`
+	APIServer            *configv1.APIServerSpec           `json:"apiServer"`
+	Authentication       *configv1.AuthenticationSpec      `json:"authentication"`
+	FeatureGate          *configv1.FeatureGateSpec         `json:"featureGate"`
+	Network              *configv1.NetworkSpec             `json:"network"`
+	Image                *configv1.ImageSpec               `json:"image"`
+	Scheduler            *configv1.SchedulerSpec           `json:"scheduler"`
+	PodSecurityAdmission *hyperv1.PodSecurityAdmissionSpec `json:"podSecurityAdmission"`
+	APIServerTuning      *hyperv1.APIServerTuningSpec      `json:"apiServerTuning"`
+	CloudProvider        string                            `json:"cloudProvider"`
+	CloudProviderConfig  *corev1.LocalObjectReference      `json:"cloudProviderConfig"`
+	CloudProviderCreds   *corev1.LocalObjectReference      `json:"cloudProviderCreds"`
+
+	ServiceAccountIssuer    string   `json:"serviceAccountIssuer"`
+	ServiceAccountAudiences []string `json:"serviceAccountAudiences"`
+	ServiceCIDRs            []string `json:"serviceCIDRs"`
+	ClusterCIDRs            []string `json:"clusterCIDRs"`
+	AdvertiseAddress        string   `json:"advertiseAddress"`
+	ExternalAddress         string   `json:"externalAddress"`
 	// ExternalPort is the port coming from the status of the SVC which is exposing the KAS, e.g. common router LB, dedicated private/public/ LB...
 	// This is used to build kas urls for generated internal kubeconfigs for example.
 	ExternalPort    int32  `json:"externalPort"`
 	InternalAddress string `json:"internalAddress"`
 	// KASPodPort is the port to expose in the KAS Pod.
-	KASPodPort           int32                        `json:"apiServerPort"`
-	ExternalOAuthAddress string                       `json:"externalOAuthAddress"`
-	ExternalOAuthPort    int32                        `json:"externalOAuthPort"`
-	OIDCCAConfigMap      *corev1.LocalObjectReference `json:"oidcCAConfigMap"`
-	EtcdURL              string                       `json:"etcdAddress"`
-	KubeConfigRef        *hyperv1.KubeconfigSecretRef `json:"kubeConfigRef"`
-	AuditWebhookRef      *corev1.LocalObjectReference `json:"auditWebhookRef"`
-	ConsolePublicURL     string                       `json:"consolePublicURL"`
-	DisableProfiling     bool                         `json:"disableProfiling"`
+	KASPodPort           int32                             `json:"apiServerPort"`
+	ExternalOAuthAddress string                            `json:"externalOAuthAddress"`
+	ExternalOAuthPort    int32                             `json:"externalOAuthPort"`
+	OIDCCAConfigMap      *corev1.LocalObjectReference      `json:"oidcCAConfigMap"`
+	EtcdURL              string                            `json:"etcdAddress"`
+	KubeConfigRef        *hyperv1.KubeconfigSecretRef      `json:"kubeConfigRef"`
+	AuditWebhookRef      *corev1.LocalObjectReference      `json:"auditWebhookRef"`
+	AuditWebhookBatching *hyperv1.AuditWebhookBatchingSpec `json:"auditWebhookBatching"`
+	ConsolePublicURL     string                            `json:"consolePublicURL"`
+	DisableProfiling     bool                              `json:"disableProfiling"`
 	config.DeploymentConfig
 	config.OwnerRef
 
@@ -86,17 +90,18 @@ func NewKubeAPIServerParams(ctx context.Context, hcp *hyperv1.HostedControlPlane
 	dns := globalconfig.DNSConfig()
 	globalconfig.ReconcileDNSConfig(dns, hcp)
 	params := &KubeAPIServerParams{
-		ExternalAddress:      externalAPIAddress,
-		ExternalPort:         externalAPIPort,
-		InternalAddress:      fmt.Sprintf("api.%s.hypershift.local", hcp.Name),
-		ExternalOAuthAddress: externalOAuthAddress,
-		ExternalOAuthPort:    externalOAuthPort,
-		ServiceAccountIssuer: hcp.Spec.IssuerURL,
-		ServiceCIDRs:         util.ServiceCIDRs(hcp.Spec.Networking.ServiceNetwork),
-		ClusterCIDRs:         util.ClusterCIDRs(hcp.Spec.Networking.ClusterNetwork),
-		Availability:         hcp.Spec.ControllerAvailabilityPolicy,
-		ConsolePublicURL:     fmt.Sprintf("https://console-openshift-console.%s", dns.Spec.BaseDomain),
-		DisableProfiling:     util.StringListContains(hcp.Annotations[hyperv1.DisableProfilingAnnotation], manifests.KASDeployment("").Name),
+		ExternalAddress:         externalAPIAddress,
+		ExternalPort:            externalAPIPort,
+		InternalAddress:         fmt.Sprintf("api.%s.hypershift.local", hcp.Name),
+		ExternalOAuthAddress:    externalOAuthAddress,
+		ExternalOAuthPort:       externalOAuthPort,
+		ServiceAccountIssuer:    hcp.Spec.IssuerURL,
+		ServiceAccountAudiences: hcp.Spec.ServiceAccountAudiences,
+		ServiceCIDRs:            util.ServiceCIDRs(hcp.Spec.Networking.ServiceNetwork),
+		ClusterCIDRs:            util.ClusterCIDRs(hcp.Spec.Networking.ClusterNetwork),
+		Availability:            hcp.Spec.ControllerAvailabilityPolicy,
+		ConsolePublicURL:        fmt.Sprintf("https://console-openshift-console.%s", dns.Spec.BaseDomain),
+		DisableProfiling:        util.StringListContains(hcp.Annotations[hyperv1.DisableProfilingAnnotation], manifests.KASDeployment("").Name),
 
 		Images: KubeAPIServerImages{
 			HyperKube:                  releaseImageProvider.GetImage("hyperkube"),
@@ -115,6 +120,8 @@ func NewKubeAPIServerParams(ctx context.Context, hcp *hyperv1.HostedControlPlane
 		params.Network = hcp.Spec.Configuration.Network
 		params.Image = hcp.Spec.Configuration.Image
 		params.Scheduler = hcp.Spec.Configuration.Scheduler
+		params.PodSecurityAdmission = hcp.Spec.Configuration.PodSecurityAdmission
+		params.APIServerTuning = hcp.Spec.Configuration.APIServerTuning
 	}
 
 	params.AdvertiseAddress = util.GetAdvertiseAddress(hcp, config.DefaultAdvertiseIPv4Address, config.DefaultAdvertiseIPv6Address)
@@ -283,6 +290,7 @@ func NewKubeAPIServerParams(ctx context.Context, hcp *hyperv1.HostedControlPlane
 
 	if hcp.Spec.AuditWebhook != nil && len(hcp.Spec.AuditWebhook.Name) > 0 {
 		params.AuditWebhookRef = hcp.Spec.AuditWebhook
+		params.AuditWebhookBatching = hcp.Spec.AuditWebhookBatching
 	}
 	if _, ok := hcp.Annotations[hyperv1.AWSKMSProviderImage]; ok {
 		params.Images.AWSKMS = hcp.Annotations[hyperv1.AWSKMSProviderImage]
@@ -368,16 +376,20 @@ func (p *KubeAPIServerParams) ConfigParams() KubeAPIServerConfigParams {
 		DefaultNodeSelector:          p.DefaultNodeSelector(),
 		AdvertiseAddress:             p.AdvertiseAddress,
 		ServiceAccountIssuerURL:      p.ServiceAccountIssuerURL(),
+		ServiceAccountAudiences:      p.ServiceAccountAudiences,
 		CloudProvider:                p.CloudProvider,
 		CloudProviderConfigRef:       p.CloudProviderConfig,
 		EtcdURL:                      p.EtcdURL,
 		FeatureGates:                 p.FeatureGates(),
 		NodePortRange:                p.ServiceNodePortRange(),
 		AuditWebhookEnabled:          p.AuditWebhookRef != nil,
+		AuditWebhookBatching:         p.AuditWebhookBatching,
 		ConsolePublicURL:             p.ConsolePublicURL,
 		DisableProfiling:             p.DisableProfiling,
 		APIServerSTSDirectives:       p.APIServerSTSDirectives,
 		Authentication:               p.Authentication,
+		PodSecurityAdmission:         p.PodSecurityAdmission,
+		APIServerTuning:              p.APIServerTuning,
 	}
 }
 
@@ -394,16 +406,20 @@ type KubeAPIServerConfigParams struct {
 	DefaultNodeSelector          string
 	AdvertiseAddress             string
 	ServiceAccountIssuerURL      string
+	ServiceAccountAudiences      []string
 	CloudProvider                string
 	CloudProviderConfigRef       *corev1.LocalObjectReference
 	EtcdURL                      string
 	FeatureGates                 []string
 	NodePortRange                string
 	AuditWebhookEnabled          bool
+	AuditWebhookBatching         *hyperv1.AuditWebhookBatchingSpec
 	ConsolePublicURL             string
 	DisableProfiling             bool
 	APIServerSTSDirectives       string
 	Authentication               *configv1.AuthenticationSpec
+	PodSecurityAdmission         *hyperv1.PodSecurityAdmissionSpec
+	APIServerTuning              *hyperv1.APIServerTuningSpec
 }
 
 func (p *KubeAPIServerParams) TLSSecurityProfile() *configv1.TLSSecurityProfile {
@@ -18,7 +18,7 @@ import (
 	"github.com/openshift/hypershift/support/util"
 )
 
-func ReconcileService(svc *corev1.Service, strategy *hyperv1.ServicePublishingStrategy, owner *metav1.OwnerReference, apiServerServicePort int, apiAllowedCIDRBlocks []string, isPublic, isPrivate bool) error {
+func ReconcileService(svc *corev1.Service, strategy *hyperv1.ServicePublishingStrategy, owner *metav1.OwnerReference, apiServerServicePort int, apiAllowedCIDRBlocks []string, isPublic, isPrivate bool, loadBalancer *hyperv1.AWSLoadBalancerSpec) error {
 	util.EnsureOwnerRef(svc, owner)
 	if svc.Spec.Selector == nil {
 		svc.Spec.Selector = kasLabels()
@@ -50,7 +50,26 @@ func ReconcileService(svc *corev1.Service, strategy *hyperv1.ServicePublishingSt
 	if svc.Annotations == nil {
 		svc.Annotations = map[string]string{}
 	}
-	svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-type"] = "nlb"
+	// A classic load balancer is created when the annotation is absent.
+	if loadBalancer == nil || loadBalancer.Type != hyperv1.ClassicLoadBalancerType {
+		svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-type"] = "nlb"
+	} else {
+		// Drop a stale NLB annotation so switching the type back to classic takes effect.
+		delete(svc.Annotations, "service.beta.kubernetes.io/aws-load-balancer-type")
+	}
+	if loadBalancer != nil && loadBalancer.CrossZoneLoadBalancing != nil && *loadBalancer.CrossZoneLoadBalancing {
+		svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled"] = "true"
+	} else {
+		// Drop a stale annotation so disabling cross-zone load balancing takes
+		// effect. Private clusters re-add it below regardless of the spec.
+		delete(svc.Annotations, "service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled")
+	}
+	if loadBalancer != nil && loadBalancer.Scheme == hyperv1.InternalLoadBalancerScheme {
+		svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-internal"] = "true"
+	} else {
+		// Drop a stale annotation so switching the scheme back to internet-facing takes effect.
+		delete(svc.Annotations, "service.beta.kubernetes.io/aws-load-balancer-internal")
+	}
 	switch strategy.Type {
 	case hyperv1.LoadBalancer:
 		if isPublic {
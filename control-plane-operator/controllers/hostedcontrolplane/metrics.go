@@ -0,0 +1,20 @@
+package hostedcontrolplane
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// kasProbeFailuresTotal counts failed kube-apiserver reachability probes so
+	// flapping connectivity between the management and the guest cluster is
+	// visible even while the debounce holds the Available condition.
+	kasProbeFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hypershift_kas_reachability_probe_failures_total",
+		Help: "Number of failed kube-apiserver reachability probes per hosted control plane.",
+	}, []string{"namespace", "name"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(kasProbeFailuresTotal)
+}
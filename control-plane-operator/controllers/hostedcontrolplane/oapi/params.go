@@ -17,8 +17,9 @@ import (
 type OpenShiftAPIServerParams struct {
 	APIServer               *configv1.APIServerSpec `json:"apiServer"`
 	IngressSubDomain        string
-	EtcdURL                 string `json:"etcdURL"`
-	ServiceAccountIssuerURL string `json:"serviceAccountIssuerURL"`
+	EtcdURL                 string   `json:"etcdURL"`
+	ServiceAccountIssuerURL string   `json:"serviceAccountIssuerURL"`
+	ServiceAccountAudiences []string `json:"serviceAccountAudiences"`
 
 	OpenShiftAPIServerDeploymentConfig      config.DeploymentConfig `json:"openshiftAPIServerDeploymentConfig,inline"`
 	OpenShiftOAuthAPIServerDeploymentConfig config.DeploymentConfig `json:"openshiftOAuthAPIServerDeploymentConfig,inline"`
@@ -41,6 +42,7 @@ type OAuthDeploymentParams struct {
 	MinTLSVersion                string
 	CipherSuites                 []string
 	ServiceAccountIssuerURL      string
+	ServiceAccountAudiences      []string
 	DeploymentConfig             config.DeploymentConfig
 	AvailabilityProberImage      string
 	Availability                 hyperv1.AvailabilityPolicy
@@ -55,6 +57,7 @@ func NewOpenShiftAPIServerParams(hcp *hyperv1.HostedControlPlane, observedConfig
 		OAuthAPIServerImage:     releaseImageProvider.GetImage("oauth-apiserver"),
 		ProxyImage:              releaseImageProvider.GetImage("socks5-proxy"),
 		ServiceAccountIssuerURL: hcp.Spec.IssuerURL,
+		ServiceAccountAudiences: hcp.Spec.ServiceAccountAudiences,
 		IngressSubDomain:        globalconfig.IngressDomain(hcp),
 		AvailabilityProberImage: releaseImageProvider.GetImage(util.AvailabilityProberImageName),
 		Availability:            hcp.Spec.ControllerAvailabilityPolicy,
@@ -220,6 +223,7 @@ func (p *OpenShiftAPIServerParams) OAuthAPIServerDeploymentParams(hcp *hyperv1.H
 		Image:                   p.OAuthAPIServerImage,
 		EtcdURL:                 p.EtcdURL,
 		ServiceAccountIssuerURL: p.ServiceAccountIssuerURL,
+		ServiceAccountAudiences: p.ServiceAccountAudiences,
 		DeploymentConfig:        p.OpenShiftOAuthAPIServerDeploymentConfig,
 		MinTLSVersion:           p.MinTLSVersion(),
 		CipherSuites:            p.CipherSuites(),
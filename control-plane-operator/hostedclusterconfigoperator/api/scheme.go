@@ -8,8 +8,10 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/openshift/api/operator/v1alpha1"
 	osinv1 "github.com/openshift/api/osin/v1"
+	projectv1 "github.com/openshift/api/project/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	securityv1 "github.com/openshift/api/security/v1"
+	templatev1 "github.com/openshift/api/template/v1"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	prometheusoperatorv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -64,4 +66,6 @@ func init() {
 	prometheusoperatorv1.AddToScheme(Scheme)
 	imageregistryv1.AddToScheme(Scheme)
 	operatorsv1alpha1.AddToScheme(Scheme)
+	projectv1.AddToScheme(Scheme)
+	templatev1.AddToScheme(Scheme)
 }
@@ -20,10 +20,12 @@ import (
 )
 
 const (
-	nodePoolAnnotation       = "hypershift.openshift.io/nodePool"
-	labelsSyncedAnnotation   = "hypershift.openshift.io/labelsSynced"
-	nodePoolAnnotationTaints = "hypershift.openshift.io/nodePoolTaints"
-	labelManagedPrefix       = "managed.hypershift.openshift.io"
+	nodePoolAnnotation              = "hypershift.openshift.io/nodePool"
+	labelsSyncedAnnotation          = "hypershift.openshift.io/labelsSynced"
+	nodePoolAnnotationTaints        = "hypershift.openshift.io/nodePoolTaints"
+	nodePoolAnnotationStartupTaints = "hypershift.openshift.io/nodePoolStartupTaints"
+	nodePoolAnnotationManagedLabels = "hypershift.openshift.io/nodePoolManagedLabels"
+	labelManagedPrefix              = "managed.hypershift.openshift.io"
 )
 
 type reconciler struct {
@@ -58,16 +60,60 @@ func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 	}
 
-	if labelsHaveSynced(node) {
-		return reconcile.Result{}, nil
-	}
-
 	machine, err := r.getMachineForNode(ctx, node)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
+
+	var startupTaints []corev1.Taint
+	if startupTaintsInJSON := machine.Annotations[nodePoolAnnotationStartupTaints]; startupTaintsInJSON != "" {
+		if err := json.Unmarshal([]byte(startupTaintsInJSON), &startupTaints); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	var managedLabels map[string]string
+	if managedLabelsInJSON := machine.Annotations[nodePoolAnnotationManagedLabels]; managedLabelsInJSON != "" {
+		if err := json.Unmarshal([]byte(managedLabelsInJSON), &managedLabels); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if labelsHaveSynced(node) {
+		// Managed labels are owned by the control plane and continuously
+		// reconciled, so drift is corrected even after the initial label sync.
+		if managedLabelsNeedSync(node, managedLabels) {
+			result, err := r.CreateOrUpdate(ctx, r.guestClusterClient, node, func() error {
+				for k, v := range managedLabels {
+					node.Labels[k] = v
+				}
+				return nil
+			})
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed to reconcile managed labels on Node: %w", err)
+			}
+			log.Info("Reconciled managed labels on Node", "result", result)
+		}
+		// Startup taints are dropped again once the Node reports Ready so pods only
+		// schedule after node-level agents had a chance to come up.
+		if len(startupTaints) > 0 && nodeIsReady(node) {
+			result, err := r.CreateOrUpdate(ctx, r.guestClusterClient, node, func() error {
+				node.Spec.Taints = removeTaints(node.Spec.Taints, startupTaints)
+				return nil
+			})
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed to remove startup taints from Node: %w", err)
+			}
+			log.Info("Removed startup taints from Node", "result", result)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	labelsToSync := getManagedLabels(machine.Labels)
 	labelsToSync[hyperv1.NodePoolLabel] = nodePoolName
+	for k, v := range managedLabels {
+		labelsToSync[k] = v
+	}
 
 	var taints []corev1.Taint
 	taintsInJSON := machine.Annotations[nodePoolAnnotationTaints]
@@ -86,6 +132,9 @@ func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 		// Sync taints.
 		node.Spec.Taints = append(node.Spec.Taints, taints...)
+		if !nodeIsReady(node) {
+			node.Spec.Taints = append(node.Spec.Taints, startupTaints...)
+		}
 
 		return nil
 	})
@@ -97,6 +146,35 @@ func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	return reconcile.Result{}, nil
 }
 
+func nodeIsReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// removeTaints returns taints without any taint matching the key and effect of one in toRemove.
+func removeTaints(taints, toRemove []corev1.Taint) []corev1.Taint {
+	var result []corev1.Taint
+	for _, taint := range taints {
+		matched := false
+		for _, candidate := range toRemove {
+			if taint.Key == candidate.Key && taint.Effect == candidate.Effect {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			result = append(result, taint)
+		}
+	}
+
+	return result
+}
+
 func getManagedLabels(labels map[string]string) map[string]string {
 	managedLabels := make(map[string]string)
 	for k, v := range labels {
@@ -109,6 +187,18 @@ func getManagedLabels(labels map[string]string) map[string]string {
 	return managedLabels
 }
 
+// managedLabelsNeedSync returns true if any managed label is missing from the
+// Node or has a different value.
+func managedLabelsNeedSync(node *corev1.Node, managedLabels map[string]string) bool {
+	for k, v := range managedLabels {
+		if node.Labels[k] != v {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *reconciler) getMachineForNode(ctx context.Context, node *corev1.Node) (*capiv1.Machine, error) {
 	machineName, ok := node.GetAnnotations()[capiv1.MachineAnnotation]
 	if !ok || machineName == "" {
@@ -120,6 +120,66 @@ func TestNodeToNodePoolName(t *testing.T) {
 	}
 }
 
+func TestRemoveTaints(t *testing.T) {
+	startupTaint := corev1.Taint{
+		Key:    "node.hypershift.openshift.io/startup",
+		Value:  "true",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+	otherTaint := corev1.Taint{
+		Key:    "dedicated",
+		Value:  "gpu",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+
+	testCases := []struct {
+		name     string
+		taints   []corev1.Taint
+		toRemove []corev1.Taint
+		expected []corev1.Taint
+	}{
+		{
+			name:     "When the startup taint is present it should be removed",
+			taints:   []corev1.Taint{otherTaint, startupTaint},
+			toRemove: []corev1.Taint{startupTaint},
+			expected: []corev1.Taint{otherTaint},
+		},
+		{
+			name:     "When no taint matches it should return the input",
+			taints:   []corev1.Taint{otherTaint},
+			toRemove: []corev1.Taint{startupTaint},
+			expected: []corev1.Taint{otherTaint},
+		},
+		{
+			name:     "When there is nothing to remove it should return the input",
+			taints:   []corev1.Taint{otherTaint},
+			toRemove: nil,
+			expected: []corev1.Taint{otherTaint},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(removeTaints(tc.taints, tc.toRemove)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestNodeIsReady(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(nodeIsReady(&corev1.Node{})).To(BeFalse())
+	g.Expect(nodeIsReady(&corev1.Node{
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}},
+		},
+	})).To(BeFalse())
+	g.Expect(nodeIsReady(&corev1.Node{
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	})).To(BeTrue())
+}
+
 func TestGetManagedLabels(t *testing.T) {
 	g := NewWithT(t)
 	labels := map[string]string{
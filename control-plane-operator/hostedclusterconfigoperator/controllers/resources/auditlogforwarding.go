@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const auditLogForwarderOutputName = "hypershift-audit"
+
+// auditLogForwardingDestinationTypes are the cluster logging forwarder output
+// types supported for audit log forwarding.
+var auditLogForwardingDestinationTypes = map[string]struct{}{
+	"syslog":        {},
+	"elasticsearch": {},
+	"kafka":         {},
+}
+
+// parseAuditLogForwarding parses the value of the audit log forwarding
+// annotation, which has the form type=url, and validates the destination.
+func parseAuditLogForwarding(value string) (string, string, error) {
+	pair := strings.SplitN(value, "=", 2)
+	if len(pair) != 2 {
+		return "", "", fmt.Errorf("invalid audit log forwarding %q, must be of the form type=url", value)
+	}
+	outputType, outputURL := pair[0], pair[1]
+	if _, ok := auditLogForwardingDestinationTypes[outputType]; !ok {
+		return "", "", fmt.Errorf("invalid audit log forwarding destination type %q, must be one of syslog, elasticsearch or kafka", outputType)
+	}
+	parsedURL, err := url.Parse(outputURL)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid audit log forwarding destination url %q: %w", outputURL, err)
+	}
+	if parsedURL.Scheme == "" || parsedURL.Host == "" {
+		return "", "", fmt.Errorf("audit log forwarding destination url %q must include a scheme and host", outputURL)
+	}
+	return outputType, outputURL, nil
+}
+
+// auditLogForwarder is the singleton ClusterLogForwarder consumed by the guest
+// cluster's logging operator. It is kept unstructured since the logging
+// operator API is not part of the payload and may not be installed.
+func auditLogForwarder() *unstructured.Unstructured {
+	forwarder := &unstructured.Unstructured{}
+	forwarder.SetAPIVersion("logging.openshift.io/v1")
+	forwarder.SetKind("ClusterLogForwarder")
+	forwarder.SetNamespace("openshift-logging")
+	forwarder.SetName("instance")
+	return forwarder
+}
+
+// reconcileAuditLogForwarding applies a ClusterLogForwarder that ships audit
+// logs to the destination described by the audit log forwarding annotation.
+// When the logging operator is not installed in the guest cluster the
+// ClusterLogForwarder API does not exist and reconciliation is skipped.
+func (r *reconciler) reconcileAuditLogForwarding(ctx context.Context, forwarding string) error {
+	outputType, outputURL, err := parseAuditLogForwarding(forwarding)
+	if err != nil {
+		return err
+	}
+	forwarder := auditLogForwarder()
+	if _, err := r.CreateOrUpdate(ctx, r.client, forwarder, func() error {
+		return reconcileAuditLogForwarder(forwarder, outputType, outputURL)
+	}); err != nil {
+		if meta.IsNoMatchError(err) {
+			ctrl.LoggerFrom(ctx).Info("skipping audit log forwarding, ClusterLogForwarder API is not available in the guest cluster")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func reconcileAuditLogForwarder(forwarder *unstructured.Unstructured, outputType, outputURL string) error {
+	outputs := []interface{}{
+		map[string]interface{}{
+			"name": auditLogForwarderOutputName,
+			"type": outputType,
+			"url":  outputURL,
+		},
+	}
+	pipelines := []interface{}{
+		map[string]interface{}{
+			"name":       "hypershift-audit-logs",
+			"inputRefs":  []interface{}{"audit"},
+			"outputRefs": []interface{}{auditLogForwarderOutputName},
+		},
+	}
+	if err := unstructured.SetNestedSlice(forwarder.Object, outputs, "spec", "outputs"); err != nil {
+		return fmt.Errorf("failed to set outputs: %w", err)
+	}
+	if err := unstructured.SetNestedSlice(forwarder.Object, pipelines, "spec", "pipelines"); err != nil {
+		return fmt.Errorf("failed to set pipelines: %w", err)
+	}
+	return nil
+}
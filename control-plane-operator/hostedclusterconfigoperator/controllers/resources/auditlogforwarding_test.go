@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseAuditLogForwarding(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectType  string
+		expectURL   string
+		expectError bool
+	}{
+		{
+			name:       "valid syslog destination",
+			value:      "syslog=tcp://collector.example.com:514",
+			expectType: "syslog",
+			expectURL:  "tcp://collector.example.com:514",
+		},
+		{
+			name:       "valid kafka destination",
+			value:      "kafka=tls://kafka.example.com:9093",
+			expectType: "kafka",
+			expectURL:  "tls://kafka.example.com:9093",
+		},
+		{
+			name:        "missing url",
+			value:       "syslog",
+			expectError: true,
+		},
+		{
+			name:        "unsupported type",
+			value:       "splunk=https://splunk.example.com",
+			expectError: true,
+		},
+		{
+			name:        "url without scheme",
+			value:       "elasticsearch=elasticsearch.example.com",
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			outputType, outputURL, err := parseAuditLogForwarding(test.value)
+			if test.expectError {
+				if err == nil {
+					t.Errorf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if outputType != test.expectType {
+				t.Errorf("expected type %s, got %s", test.expectType, outputType)
+			}
+			if outputURL != test.expectURL {
+				t.Errorf("expected url %s, got %s", test.expectURL, outputURL)
+			}
+		})
+	}
+}
+
+func TestReconcileAuditLogForwarder(t *testing.T) {
+	forwarder := auditLogForwarder()
+	if err := reconcileAuditLogForwarder(forwarder, "syslog", "tcp://collector.example.com:514"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	outputs, _, err := unstructured.NestedSlice(forwarder.Object, "spec", "outputs")
+	if err != nil || len(outputs) != 1 {
+		t.Fatalf("expected one output, got %v (err: %v)", outputs, err)
+	}
+	output := outputs[0].(map[string]interface{})
+	if output["type"] != "syslog" || output["url"] != "tcp://collector.example.com:514" {
+		t.Errorf("unexpected output: %v", output)
+	}
+	pipelines, _, err := unstructured.NestedSlice(forwarder.Object, "spec", "pipelines")
+	if err != nil || len(pipelines) != 1 {
+		t.Fatalf("expected one pipeline, got %v (err: %v)", pipelines, err)
+	}
+	pipeline := pipelines[0].(map[string]interface{})
+	inputRefs, _, _ := unstructured.NestedStringSlice(pipeline, "inputRefs")
+	if len(inputRefs) != 1 || inputRefs[0] != "audit" {
+		t.Errorf("expected audit input ref, got %v", inputRefs)
+	}
+}
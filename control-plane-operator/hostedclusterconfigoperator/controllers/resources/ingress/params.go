@@ -13,6 +13,7 @@ type IngressParams struct {
 	IsPrivate        bool
 	IBMCloudUPI      bool
 	AWSNLB           bool
+	Placement        *hyperv1.SystemComponentsPlacement
 }
 
 func NewIngressParams(hcp *hyperv1.HostedControlPlane) *IngressParams {
@@ -32,6 +33,10 @@ func NewIngressParams(hcp *hyperv1.HostedControlPlane) *IngressParams {
 	if hcp.Spec.Configuration != nil && hcp.Spec.Configuration.Ingress != nil && hcp.Spec.Configuration.Ingress.LoadBalancer.Platform.AWS != nil {
 		nlb = hcp.Spec.Configuration.Ingress.LoadBalancer.Platform.AWS.Type == configv1.NLB
 	}
+	var placement *hyperv1.SystemComponentsPlacement
+	if hcp.Spec.Configuration != nil {
+		placement = hcp.Spec.Configuration.SystemComponentsPlacement
+	}
 
 	return &IngressParams{
 		IngressSubdomain: globalconfig.IngressDomain(hcp),
@@ -40,6 +45,7 @@ func NewIngressParams(hcp *hyperv1.HostedControlPlane) *IngressParams {
 		IsPrivate:        isPrivate,
 		IBMCloudUPI:      ibmCloudUPI,
 		AWSNLB:           nlb,
+		Placement:        placement,
 	}
 
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -12,7 +13,7 @@ import (
 	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/manifests"
 )
 
-func ReconcileDefaultIngressController(ingressController *operatorv1.IngressController, ingressSubdomain string, platformType hyperv1.PlatformType, replicas int32, isIBMCloudUPI bool, isPrivate bool, useNLB bool) error {
+func ReconcileDefaultIngressController(ingressController *operatorv1.IngressController, ingressSubdomain string, platformType hyperv1.PlatformType, replicas int32, isIBMCloudUPI bool, isPrivate bool, useNLB bool, placement *hyperv1.SystemComponentsPlacement) error {
 	// If ingress controller already exists, skip reconciliation to allow day-2 configuration
 	if ingressController.ResourceVersion != "" {
 		return nil
@@ -94,6 +95,19 @@ func ReconcileDefaultIngressController(ingressController *operatorv1.IngressCont
 			Private: &operatorv1.PrivateStrategy{},
 		}
 	}
+	// An explicit system components placement takes precedence over any
+	// platform-specific default set above.
+	if placement != nil {
+		nodePlacement := &operatorv1.NodePlacement{
+			Tolerations: placement.Tolerations,
+		}
+		if len(placement.NodeSelector) > 0 {
+			nodePlacement.NodeSelector = &metav1.LabelSelector{
+				MatchLabels: placement.NodeSelector,
+			}
+		}
+		ingressController.Spec.NodePlacement = nodePlacement
+	}
 	return nil
 }
 
@@ -23,6 +23,7 @@ func TestReconcileDefaultIngressController(t *testing.T) {
 		inputIsIBMCloudUPI        bool
 		inputIsPrivate            bool
 		inputIsNLB                bool
+		inputPlacement            *hyperv1.SystemComponentsPlacement
 		expectedIngressController *operatorv1.IngressController
 	}{
 		{
@@ -252,11 +253,51 @@ func TestReconcileDefaultIngressController(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                   "system components placement sets node placement",
+			inputPlatformType:      hyperv1.NonePlatform,
+			inputIngressController: manifests.IngressDefaultIngressController(),
+			inputIngressDomain:     fakeIngressDomain,
+			inputReplicas:          fakeInputReplicas,
+			inputPlacement: &hyperv1.SystemComponentsPlacement{
+				NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+				Tolerations: []corev1.Toleration{
+					{
+						Key:      "node-role.kubernetes.io/infra",
+						Operator: corev1.TolerationOpExists,
+					},
+				},
+			},
+			expectedIngressController: &operatorv1.IngressController{
+				ObjectMeta: manifests.IngressDefaultIngressController().ObjectMeta,
+				Spec: operatorv1.IngressControllerSpec{
+					Domain:   fakeIngressDomain,
+					Replicas: &fakeInputReplicas,
+					EndpointPublishingStrategy: &operatorv1.EndpointPublishingStrategy{
+						Type: operatorv1.HostNetworkStrategyType,
+					},
+					DefaultCertificate: &corev1.LocalObjectReference{
+						Name: manifests.IngressDefaultIngressControllerCert().Name,
+					},
+					NodePlacement: &operatorv1.NodePlacement{
+						NodeSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"node-role.kubernetes.io/infra": ""},
+						},
+						Tolerations: []corev1.Toleration{
+							{
+								Key:      "node-role.kubernetes.io/infra",
+								Operator: corev1.TolerationOpExists,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testsCases {
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewGomegaWithT(t)
-			err := ReconcileDefaultIngressController(tc.inputIngressController, tc.inputIngressDomain, tc.inputPlatformType, tc.inputReplicas, tc.inputIsIBMCloudUPI, tc.inputIsPrivate, tc.inputIsNLB)
+			err := ReconcileDefaultIngressController(tc.inputIngressController, tc.inputIngressDomain, tc.inputPlatformType, tc.inputReplicas, tc.inputIsIBMCloudUPI, tc.inputIsPrivate, tc.inputIsNLB, tc.inputPlacement)
 			g.Expect(err).To(BeNil())
 			g.Expect(tc.inputIngressController).To(BeEquivalentTo(tc.expectedIngressController))
 		})
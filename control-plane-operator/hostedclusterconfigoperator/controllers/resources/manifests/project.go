@@ -0,0 +1,15 @@
+package manifests
+
+import (
+	templatev1 "github.com/openshift/api/template/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ProjectRequestTemplate() *templatev1.Template {
+	return &templatev1.Template{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "project-request",
+			Namespace: "openshift-config",
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package monitoring
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+// monitoringComponents are the cluster monitoring operator components which
+// accept a nodeSelector and tolerations in the cluster-monitoring-config
+// ConfigMap.
+var monitoringComponents = []string{
+	"prometheusOperator",
+	"prometheusK8s",
+	"alertmanagerMain",
+	"kubeStateMetrics",
+	"openshiftStateMetrics",
+	"telemeterClient",
+	"thanosQuerier",
+	"k8sPrometheusAdapter",
+}
+
+// ReconcileMonitoringConfig applies the given placement to every monitoring
+// component in the cluster-monitoring-config ConfigMap. Existing user-provided
+// configuration for other settings is preserved.
+func ReconcileMonitoringConfig(cm *corev1.ConfigMap, placement *hyperv1.SystemComponentsPlacement) error {
+	config := map[string]interface{}{}
+	if existing := cm.Data["config.yaml"]; existing != "" {
+		if err := yaml.Unmarshal([]byte(existing), &config); err != nil {
+			return fmt.Errorf("failed to parse existing monitoring config: %w", err)
+		}
+	}
+	for _, component := range monitoringComponents {
+		componentConfig, _ := config[component].(map[string]interface{})
+		if componentConfig == nil {
+			componentConfig = map[string]interface{}{}
+		}
+		if len(placement.NodeSelector) > 0 {
+			componentConfig["nodeSelector"] = placement.NodeSelector
+		} else {
+			delete(componentConfig, "nodeSelector")
+		}
+		if len(placement.Tolerations) > 0 {
+			componentConfig["tolerations"] = placement.Tolerations
+		} else {
+			delete(componentConfig, "tolerations")
+		}
+		if len(componentConfig) > 0 {
+			config[component] = componentConfig
+		} else {
+			delete(config, component)
+		}
+	}
+	serialized, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to serialize monitoring config: %w", err)
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data["config.yaml"] = string(serialized)
+	return nil
+}
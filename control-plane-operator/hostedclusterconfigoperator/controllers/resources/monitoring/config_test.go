@@ -0,0 +1,47 @@
+package monitoring
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/manifests"
+)
+
+func TestReconcileMonitoringConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	placement := &hyperv1.SystemComponentsPlacement{
+		NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+		Tolerations: []corev1.Toleration{
+			{
+				Key:      "node-role.kubernetes.io/infra",
+				Operator: corev1.TolerationOpExists,
+			},
+		},
+	}
+
+	cm := manifests.MonitoringConfig()
+	g.Expect(ReconcileMonitoringConfig(cm, placement)).To(Succeed())
+	for _, component := range monitoringComponents {
+		g.Expect(cm.Data["config.yaml"]).To(ContainSubstring(component))
+	}
+	g.Expect(cm.Data["config.yaml"]).To(ContainSubstring("node-role.kubernetes.io/infra"))
+
+	// Existing unrelated configuration is preserved.
+	cm = manifests.MonitoringConfig()
+	cm.Data = map[string]string{
+		"config.yaml": "prometheusK8s:\n  retention: 24h\n",
+	}
+	g.Expect(ReconcileMonitoringConfig(cm, placement)).To(Succeed())
+	g.Expect(cm.Data["config.yaml"]).To(ContainSubstring("retention: 24h"))
+	g.Expect(cm.Data["config.yaml"]).To(ContainSubstring("nodeSelector"))
+
+	// Clearing the placement removes the managed fields again.
+	g.Expect(ReconcileMonitoringConfig(cm, &hyperv1.SystemComponentsPlacement{})).To(Succeed())
+	g.Expect(cm.Data["config.yaml"]).To(ContainSubstring("retention: 24h"))
+	g.Expect(strings.Contains(cm.Data["config.yaml"], "nodeSelector")).To(BeFalse())
+	g.Expect(strings.Contains(cm.Data["config.yaml"], "tolerations")).To(BeFalse())
+}
@@ -2,9 +2,12 @@ package network
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,7 +36,7 @@ const kubevirtDefaultGenevePort = uint32(9880)
 // 100.65.0.0/16 is not used internally at OVN kubernetes.
 const kubevirtDefaultV4InternalSubnet = "100.65.0.0/16"
 
-func ReconcileNetworkOperator(network *operatorv1.Network, networkType hyperv1.NetworkType, platformType hyperv1.PlatformType) {
+func ReconcileNetworkOperator(network *operatorv1.Network, networkType hyperv1.NetworkType, platformType hyperv1.PlatformType, policyAuditConfig *hyperv1.NetworkPolicyAuditConfig) {
 	switch platformType {
 	case hyperv1.KubevirtPlatform:
 		// Modify vxlan port to avoid collisions with management cluster's default vxlan port.
@@ -73,6 +76,15 @@ func ReconcileNetworkOperator(network *operatorv1.Network, networkType hyperv1.N
 		// do nothing
 	}
 
+	if networkType == hyperv1.OVNKubernetes && policyAuditConfig != nil {
+		if network.Spec.DefaultNetwork.OVNKubernetesConfig == nil {
+			network.Spec.DefaultNetwork.OVNKubernetesConfig = &operatorv1.OVNKubernetesConfig{}
+		}
+		network.Spec.DefaultNetwork.OVNKubernetesConfig.PolicyAuditConfig = &operatorv1.PolicyAuditConfig{
+			RateLimit: policyAuditConfig.RateLimit,
+		}
+	}
+
 	// Setting the management state is required in order to create
 	// this object. We need to create this object before the cno starts
 	// because mutating many of the values (like vxlanport) is not permitted
@@ -82,6 +94,43 @@ func ReconcileNetworkOperator(network *operatorv1.Network, networkType hyperv1.N
 	}
 }
 
+// aclLoggingAnnotation enables OVN-Kubernetes ACL logging for a namespace.
+const aclLoggingAnnotation = "k8s.ovn.org/acl-logging"
+
+// defaultACLLoggingSeverity is the syslog severity used for denied traffic
+// when the policy audit config does not specify one.
+const defaultACLLoggingSeverity = "notice"
+
+// ReconcileNamespaceACLLogging annotates a guest namespace so that traffic
+// denied by a NetworkPolicy in that namespace is audited at the configured
+// severity.
+func ReconcileNamespaceACLLogging(ns *corev1.Namespace, policyAuditConfig *hyperv1.NetworkPolicyAuditConfig) error {
+	severity := policyAuditConfig.Severity
+	if severity == "" {
+		severity = defaultACLLoggingSeverity
+	}
+	value, err := json.Marshal(map[string]string{"deny": severity})
+	if err != nil {
+		return fmt.Errorf("failed to marshal acl logging annotation: %w", err)
+	}
+	if ns.Annotations == nil {
+		ns.Annotations = map[string]string{}
+	}
+	ns.Annotations[aclLoggingAnnotation] = string(value)
+	return nil
+}
+
+// ReconcileDefaultDenyNetworkPolicy configures the policy to select every pod
+// in its namespace while allowing no ingress traffic, so any traffic not
+// explicitly allowed by another NetworkPolicy is denied.
+func ReconcileDefaultDenyNetworkPolicy(policy *networkingv1.NetworkPolicy) error {
+	policy.Spec = networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+	}
+	return nil
+}
+
 func DetectSuboptimalMTU(ctx context.Context, mgmtClient client.Client,
 	guestNetworkOperator *operatorv1.Network, hcp *hyperv1.HostedControlPlane) error {
 	const recommendedMinMTU = uint32(9000)
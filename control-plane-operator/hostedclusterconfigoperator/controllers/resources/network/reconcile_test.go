@@ -6,6 +6,8 @@ import (
 	. "github.com/onsi/gomega"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestReconcileDefaultIngressController(t *testing.T) {
@@ -14,12 +16,14 @@ func TestReconcileDefaultIngressController(t *testing.T) {
 	v4InternalSubnet := kubevirtDefaultV4InternalSubnet
 
 	fakePort := uint32(11111)
+	auditRateLimit := uint32(30)
 	testsCases := []struct {
-		name              string
-		inputNetwork      *operatorv1.Network
-		inputNetworkType  hyperv1.NetworkType
-		inputPlatformType hyperv1.PlatformType
-		expectedNetwork   *operatorv1.Network
+		name                   string
+		inputNetwork           *operatorv1.Network
+		inputNetworkType       hyperv1.NetworkType
+		inputPlatformType      hyperv1.PlatformType
+		inputPolicyAuditConfig *hyperv1.NetworkPolicyAuditConfig
+		expectedNetwork        *operatorv1.Network
 	}{
 		{
 			name:              "KubeVirt with OVNKubernetes uses unique default geneve port",
@@ -242,12 +246,62 @@ func TestReconcileDefaultIngressController(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:              "AWS with OVNKubernetes sets the policy audit config",
+			inputNetwork:      NetworkOperator(),
+			inputNetworkType:  hyperv1.OVNKubernetes,
+			inputPlatformType: hyperv1.AWSPlatform,
+			inputPolicyAuditConfig: &hyperv1.NetworkPolicyAuditConfig{
+				RateLimit: &auditRateLimit,
+			},
+			expectedNetwork: &operatorv1.Network{
+				ObjectMeta: NetworkOperator().ObjectMeta,
+				Spec: operatorv1.NetworkSpec{
+					OperatorSpec: operatorv1.OperatorSpec{
+						ManagementState: "Managed",
+					},
+					DefaultNetwork: operatorv1.DefaultNetworkDefinition{
+						OVNKubernetesConfig: &operatorv1.OVNKubernetesConfig{
+							PolicyAuditConfig: &operatorv1.PolicyAuditConfig{
+								RateLimit: &auditRateLimit,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:                   "AWS with SDN ignores the policy audit config",
+			inputNetwork:           NetworkOperator(),
+			inputNetworkType:       hyperv1.OpenShiftSDN,
+			inputPlatformType:      hyperv1.AWSPlatform,
+			inputPolicyAuditConfig: &hyperv1.NetworkPolicyAuditConfig{RateLimit: &auditRateLimit},
+			expectedNetwork: &operatorv1.Network{
+				ObjectMeta: NetworkOperator().ObjectMeta,
+				Spec: operatorv1.NetworkSpec{
+					OperatorSpec: operatorv1.OperatorSpec{
+						ManagementState: "Managed",
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testsCases {
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewGomegaWithT(t)
-			ReconcileNetworkOperator(tc.inputNetwork, tc.inputNetworkType, tc.inputPlatformType)
+			ReconcileNetworkOperator(tc.inputNetwork, tc.inputNetworkType, tc.inputPlatformType, tc.inputPolicyAuditConfig)
 			g.Expect(tc.inputNetwork).To(BeEquivalentTo(tc.expectedNetwork))
 		})
 	}
 }
+
+func TestReconcileNamespaceACLLogging(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+
+	g.Expect(ReconcileNamespaceACLLogging(ns, &hyperv1.NetworkPolicyAuditConfig{})).To(Succeed())
+	g.Expect(ns.Annotations).To(HaveKeyWithValue(aclLoggingAnnotation, `{"deny":"notice"}`))
+
+	g.Expect(ReconcileNamespaceACLLogging(ns, &hyperv1.NetworkPolicyAuditConfig{Severity: "alert"})).To(Succeed())
+	g.Expect(ns.Annotations).To(HaveKeyWithValue(aclLoggingAnnotation, `{"deny":"alert"}`))
+}
@@ -0,0 +1,103 @@
+package project
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+	projectv1 "github.com/openshift/api/project/v1"
+	templatev1 "github.com/openshift/api/template/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	projectNameParam = "${PROJECT_NAME}"
+
+	// GuestLimitRangeName is the name of the LimitRange installed into newly
+	// requested projects through the project request template.
+	GuestLimitRangeName = "default-limits"
+)
+
+// ReconcileProjectRequestTemplate reconciles the project request template in
+// openshift-config. The template mirrors the objects created by the built-in
+// project request handling (the project itself and the admin role binding for
+// the requester) and additionally installs a LimitRange with the given default
+// container limits.
+func ReconcileProjectRequestTemplate(template *templatev1.Template, defaultLimits corev1.ResourceList) error {
+	project := &projectv1.Project{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Project",
+			APIVersion: projectv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: projectNameParam,
+			Annotations: map[string]string{
+				"openshift.io/description":  "${PROJECT_DESCRIPTION}",
+				"openshift.io/display-name": "${PROJECT_DISPLAYNAME}",
+				"openshift.io/requester":    "${PROJECT_REQUESTING_USER}",
+			},
+		},
+	}
+	adminRoleBinding := &rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RoleBinding",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "admin",
+			Namespace: projectNameParam,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				APIGroup: rbacv1.GroupName,
+				Kind:     rbacv1.UserKind,
+				Name:     "${PROJECT_ADMIN_USER}",
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "admin",
+		},
+	}
+	limitRange := &corev1.LimitRange{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "LimitRange",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GuestLimitRangeName,
+			Namespace: projectNameParam,
+		},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{
+				{
+					Type:    corev1.LimitTypeContainer,
+					Default: defaultLimits,
+				},
+			},
+		},
+	}
+	template.Objects = []runtime.RawExtension{
+		{Object: project},
+		{Object: adminRoleBinding},
+		{Object: limitRange},
+	}
+	template.Parameters = []templatev1.Parameter{
+		{Name: "PROJECT_NAME", Required: true},
+		{Name: "PROJECT_DISPLAYNAME"},
+		{Name: "PROJECT_DESCRIPTION"},
+		{Name: "PROJECT_ADMIN_USER"},
+		{Name: "PROJECT_REQUESTING_USER"},
+	}
+	return nil
+}
+
+// ReconcileProjectConfig points the cluster project configuration at the given
+// project request template so the openshift apiserver instantiates it for new
+// project requests.
+func ReconcileProjectConfig(cfg *configv1.Project, templateName string) error {
+	cfg.Spec.ProjectRequestTemplate.Name = templateName
+	return nil
+}
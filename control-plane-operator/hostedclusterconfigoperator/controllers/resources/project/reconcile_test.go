@@ -0,0 +1,45 @@
+package project
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/manifests"
+	"github.com/openshift/hypershift/support/globalconfig"
+)
+
+func TestReconcileProjectRequestTemplate(t *testing.T) {
+	g := NewGomegaWithT(t)
+	limits := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("500m"),
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+
+	template := manifests.ProjectRequestTemplate()
+	g.Expect(ReconcileProjectRequestTemplate(template, limits)).To(Succeed())
+	g.Expect(template.Objects).To(HaveLen(3))
+
+	limitRange, ok := template.Objects[2].Object.(*corev1.LimitRange)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(limitRange.Name).To(Equal(GuestLimitRangeName))
+	g.Expect(limitRange.Namespace).To(Equal(projectNameParam))
+	g.Expect(limitRange.Spec.Limits).To(HaveLen(1))
+	g.Expect(limitRange.Spec.Limits[0].Type).To(Equal(corev1.LimitTypeContainer))
+	g.Expect(limitRange.Spec.Limits[0].Default).To(Equal(limits))
+
+	var parameterNames []string
+	for _, parameter := range template.Parameters {
+		parameterNames = append(parameterNames, parameter.Name)
+	}
+	g.Expect(parameterNames).To(ContainElement("PROJECT_NAME"))
+}
+
+func TestReconcileProjectConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	projectConfig := globalconfig.ProjectConfig()
+	g.Expect(ReconcileProjectConfig(projectConfig, manifests.ProjectRequestTemplate().Name)).To(Succeed())
+	g.Expect(projectConfig.Spec.ProjectRequestTemplate.Name).To(Equal("project-request"))
+}
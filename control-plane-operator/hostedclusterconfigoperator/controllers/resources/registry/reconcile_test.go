@@ -91,7 +91,7 @@ func TestReconcileRegistryConfig(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewGomegaWithT(t)
 			config := tc.inputConfig
-			ReconcileRegistryConfig(config, tc.inputPlatform, tc.inputAvailabilityPolicy)
+			ReconcileRegistryConfig(config, tc.inputPlatform, tc.inputAvailabilityPolicy, nil)
 			g.Expect(config.Spec.Storage).To(BeEquivalentTo(tc.expectedConfig.Spec.Storage))
 			g.Expect(config.Spec.Replicas).To(BeEquivalentTo(tc.expectedConfig.Spec.Replicas))
 			g.Expect(config.Spec.ManagementState).To(BeEquivalentTo(tc.expectedConfig.Spec.ManagementState))
@@ -9,7 +9,7 @@ import (
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 )
 
-func ReconcileRegistryConfig(cfg *imageregistryv1.Config, platform hyperv1.PlatformType, availabilityPolicy hyperv1.AvailabilityPolicy) {
+func ReconcileRegistryConfig(cfg *imageregistryv1.Config, platform hyperv1.PlatformType, availabilityPolicy hyperv1.AvailabilityPolicy, placement *hyperv1.SystemComponentsPlacement) {
 	// Only initialize number of replicas if creating the config
 	if cfg.ResourceVersion == "" {
 		switch availabilityPolicy {
@@ -25,6 +25,10 @@ func ReconcileRegistryConfig(cfg *imageregistryv1.Config, platform hyperv1.Platf
 	if cfg.Spec.HTTPSecret == "" {
 		cfg.Spec.HTTPSecret = generateImageRegistrySecret()
 	}
+	if placement != nil {
+		cfg.Spec.NodeSelector = placement.NodeSelector
+		cfg.Spec.Tolerations = placement.Tolerations
+	}
 
 	// Initially assign storage as emptyDir for KubevirtPlatform and NonePlatform
 	// Allow user to change storage afterwards
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -35,6 +36,7 @@ import (
 	prometheusoperatorv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
 	configv1 "github.com/openshift/api/config/v1"
+	consolev1 "github.com/openshift/api/console/v1"
 	imageregistryv1 "github.com/openshift/api/imageregistry/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 
@@ -57,6 +59,7 @@ import (
 	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/oapi"
 	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/oauth"
 	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/olm"
+	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/project"
 	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/rbac"
 	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/registry"
 	"github.com/openshift/hypershift/control-plane-operator/hostedclusterconfigoperator/controllers/resources/storage"
@@ -297,6 +300,13 @@ func (r *reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result
 		errs = append(errs, fmt.Errorf("failed to reconcile global configuration: %w", err))
 	}
 
+	if forwarding, ok := hcp.Annotations[hyperv1.AuditLogForwardingAnnotation]; ok {
+		log.Info("reconciling audit log forwarder")
+		if err := r.reconcileAuditLogForwarding(ctx, forwarding); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile audit log forwarder: %w", err))
+		}
+	}
+
 	log.Info("reconciling guest cluster namespaces")
 	if err := r.reconcileNamespaces(ctx); err != nil {
 		errs = append(errs, fmt.Errorf("failed to reconcile namespaces: %w", err))
@@ -307,15 +317,60 @@ func (r *reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result
 		errs = append(errs, fmt.Errorf("failed to reconcile rbac: %w", err))
 	}
 
+	if hcp.Spec.Configuration != nil && hcp.Spec.Configuration.GuestRBACManifests != nil {
+		log.Info("reconciling user provided guest cluster rbac")
+		if err := r.reconcileUserProvidedRBAC(ctx, hcp); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile user provided rbac: %w", err))
+		}
+	}
+
+	if hcp.Spec.Configuration != nil && hcp.Spec.Configuration.Console != nil {
+		log.Info("reconciling guest cluster console customization")
+		if err := r.reconcileConsoleCustomization(ctx, hcp); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile console customization: %w", err))
+		}
+	}
+
+	var systemComponentsPlacement *hyperv1.SystemComponentsPlacement
+	if hcp.Spec.Configuration != nil {
+		systemComponentsPlacement = hcp.Spec.Configuration.SystemComponentsPlacement
+	}
+
 	log.Info("reconciling registry config")
 	registryConfig := manifests.Registry()
 	if _, err := r.CreateOrUpdate(ctx, r.client, registryConfig, func() error {
-		registry.ReconcileRegistryConfig(registryConfig, r.platformType, hcp.Spec.InfrastructureAvailabilityPolicy)
+		registry.ReconcileRegistryConfig(registryConfig, r.platformType, hcp.Spec.InfrastructureAvailabilityPolicy, systemComponentsPlacement)
 		return nil
 	}); err != nil {
 		errs = append(errs, fmt.Errorf("failed to reconcile imageregistry config: %w", err))
 	}
 
+	if systemComponentsPlacement != nil {
+		log.Info("reconciling monitoring config")
+		monitoringConfig := manifests.MonitoringConfig()
+		if _, err := r.CreateOrUpdate(ctx, r.client, monitoringConfig, func() error {
+			return monitoring.ReconcileMonitoringConfig(monitoringConfig, systemComponentsPlacement)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile monitoring config: %w", err))
+		}
+	}
+
+	if hcp.Spec.Configuration != nil && len(hcp.Spec.Configuration.GuestDefaultLimits) > 0 {
+		log.Info("reconciling project request template")
+		projectRequestTemplate := manifests.ProjectRequestTemplate()
+		if _, err := r.CreateOrUpdate(ctx, r.client, projectRequestTemplate, func() error {
+			return project.ReconcileProjectRequestTemplate(projectRequestTemplate, hcp.Spec.Configuration.GuestDefaultLimits)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile project request template: %w", err))
+		}
+		projectConfig := globalconfig.ProjectConfig()
+		if _, err := r.CreateOrUpdate(ctx, r.client, projectConfig, func() error {
+			return project.ReconcileProjectConfig(projectConfig, projectRequestTemplate.Name)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile project config: %w", err))
+		}
+	}
+
 	log.Info("reconciling ingress controller")
 	if err := r.reconcileIngressController(ctx, hcp); err != nil {
 		errs = append(errs, fmt.Errorf("failed to reconcile ingress controller: %w", err))
@@ -410,11 +465,25 @@ func (r *reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result
 	log.Info("reconciling network operator")
 	networkOperator := networkoperator.NetworkOperator()
 	if _, err := r.CreateOrUpdate(ctx, r.client, networkOperator, func() error {
-		networkoperator.ReconcileNetworkOperator(networkOperator, hcp.Spec.Networking.NetworkType, hcp.Spec.Platform.Type)
+		networkoperator.ReconcileNetworkOperator(networkOperator, hcp.Spec.Networking.NetworkType, hcp.Spec.Platform.Type, hcp.Spec.Networking.PolicyAuditConfig)
 		return nil
 	}); err != nil {
 		errs = append(errs, fmt.Errorf("failed to reconcile network operator: %w", err))
 	}
+
+	if hcp.Spec.Networking.NetworkType == hyperv1.OVNKubernetes && hcp.Spec.Networking.PolicyAuditConfig != nil {
+		log.Info("reconciling acl logging annotations")
+		if err := r.reconcileACLLoggingAnnotations(ctx, hcp); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if hcp.Spec.Configuration != nil && hcp.Spec.Configuration.GuestDefaultDenyNetworkPolicy {
+		log.Info("reconciling default deny network policies")
+		if err := r.reconcileDefaultDenyNetworkPolicies(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	// Detect suboptimal MTU size on kubevirt hosted cluster with ovn-k and raise a condition in such a case
 	if err := networkoperator.DetectSuboptimalMTU(ctx, r.cpClient, networkOperator, hcp); err != nil {
 		errs = append(errs, err)
@@ -571,9 +640,71 @@ func (r *reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result
 		log.Info("reconciling Azure specific resources")
 		errs = append(errs, r.reconcileAzureCloudNodeManager(ctx, releaseImage.ComponentImages()["azure-cloud-node-manager"])...)
 	}
+
+	if _, hasRotation := hcp.Annotations[hyperv1.LastEncryptionKeyRotationAnnotation]; hasRotation {
+		log.Info("reconciling secret re-encryption")
+		if err := r.reconcileSecretsReencryption(ctx, hcp); err != nil {
+			errs = append(errs, fmt.Errorf("failed to re-encrypt secrets: %w", err))
+		}
+	}
+
 	return ctrl.Result{}, errors.NewAggregate(errs)
 }
 
+// reconcileSecretsReencryption rewrites every secret in the guest cluster
+// after an encryption key rotation so that all of them are encrypted at rest
+// with the active key, then records the rotation time it completed for on the
+// HostedControlPlane. The hypershift operator retires the backup key once the
+// recorded time catches up with the last rotation.
+func (r *reconciler) reconcileSecretsReencryption(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
+	rotationTime := hcp.Annotations[hyperv1.LastEncryptionKeyRotationAnnotation]
+	if hcp.Annotations[hyperv1.SecretsReencryptedAnnotation] == rotationTime {
+		return nil
+	}
+
+	var secrets corev1.SecretList
+	if err := r.uncachedClient.List(ctx, &secrets); err != nil {
+		return fmt.Errorf("failed to list secrets: %w", err)
+	}
+	var errs []error
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		// An unchanged update still forces the kube-apiserver to rewrite the
+		// secret at rest because it was stored under a non-active key. Updates
+		// of controller-managed secrets routinely conflict; those must be
+		// retried with a fresh copy because the completion annotation set below
+		// allows the hypershift operator to retire the backup key, after which
+		// any secret that was skipped becomes unreadable.
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			if err := r.client.Update(ctx, secret); err != nil {
+				if apierrors.IsConflict(err) {
+					if getErr := r.uncachedClient.Get(ctx, client.ObjectKeyFromObject(secret), secret); getErr != nil {
+						return getErr
+					}
+				}
+				return err
+			}
+			return nil
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to rewrite secret %s/%s: %w", secret.Namespace, secret.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewAggregate(errs)
+	}
+
+	original := hcp.DeepCopy()
+	if hcp.Annotations == nil {
+		hcp.Annotations = map[string]string{}
+	}
+	hcp.Annotations[hyperv1.SecretsReencryptedAnnotation] = rotationTime
+	if err := r.cpClient.Patch(ctx, hcp, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to record re-encryption completion: %w", err)
+	}
+	return nil
+}
+
 func (r *reconciler) reconcileCSIDriver(ctx context.Context, hcp *hyperv1.HostedControlPlane, releaseImage *releaseinfo.ReleaseImage) error {
 	switch hcp.Spec.Platform.Type {
 	case hyperv1.KubevirtPlatform:
@@ -668,11 +799,33 @@ func (r *reconciler) reconcileConfig(ctx context.Context, hcp *hyperv1.HostedCon
 		errs = append(errs, fmt.Errorf("failed to reconcile proxy config: %w", err))
 	}
 
+	imageConfig := globalconfig.ImageConfig()
+	if _, err := r.CreateOrUpdate(ctx, r.client, imageConfig, func() error {
+		globalconfig.ReconcileImageConfig(imageConfig, hcp)
+		return nil
+	}); err != nil {
+		errs = append(errs, fmt.Errorf("failed to reconcile image config: %w", err))
+	}
+
+	operatorHub := globalconfig.OperatorHubConfig()
+	if _, err := r.CreateOrUpdate(ctx, r.client, operatorHub, func() error {
+		globalconfig.ReconcileOperatorHubConfig(operatorHub, hcp)
+		return nil
+	}); err != nil {
+		errs = append(errs, fmt.Errorf("failed to reconcile operatorhub config: %w", err))
+	}
+
 	err := r.reconcileImageContentPolicyType(ctx, hcp)
 	if err != nil {
 		errs = append(errs, err)
 	}
 
+	if len(hcp.Spec.ImageSignaturePolicies) > 0 {
+		if err := r.reconcileImageSignaturePolicies(ctx, hcp); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile image signature policies: %w", err))
+		}
+	}
+
 	installConfigCM := manifests.InstallConfigConfigMap()
 	if _, err := r.CreateOrUpdate(ctx, r.client, installConfigCM, func() error {
 		installConfigCM.Data = map[string]string{
@@ -785,6 +938,55 @@ func (r *reconciler) reconcileNamespaces(ctx context.Context) error {
 	return errors.NewAggregate(errs)
 }
 
+// reconcileACLLoggingAnnotations enables OVN-Kubernetes ACL logging in every
+// non-system namespace of the guest cluster at the configured severity.
+func (r *reconciler) reconcileACLLoggingAnnotations(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
+	var namespaceList corev1.NamespaceList
+	if err := r.client.List(ctx, &namespaceList); err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	var errs []error
+	for i := range namespaceList.Items {
+		ns := &namespaceList.Items[i]
+		if ns.Name == "default" || ns.Name == "openshift" || strings.HasPrefix(ns.Name, "openshift-") || strings.HasPrefix(ns.Name, "kube-") {
+			continue
+		}
+		if _, err := r.CreateOrUpdate(ctx, r.client, ns, func() error {
+			return networkoperator.ReconcileNamespaceACLLogging(ns, hcp.Spec.Networking.PolicyAuditConfig)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile acl logging annotation on namespace %s: %w", ns.Name, err))
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
+// reconcileDefaultDenyNetworkPolicies installs a default-deny ingress
+// NetworkPolicy into every non-system namespace of the guest cluster. New
+// namespaces are picked up through the namespace watch.
+func (r *reconciler) reconcileDefaultDenyNetworkPolicies(ctx context.Context) error {
+	var namespaceList corev1.NamespaceList
+	if err := r.client.List(ctx, &namespaceList); err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	var errs []error
+	for i := range namespaceList.Items {
+		ns := &namespaceList.Items[i]
+		if ns.Name == "default" || ns.Name == "openshift" || strings.HasPrefix(ns.Name, "openshift-") || strings.HasPrefix(ns.Name, "kube-") {
+			continue
+		}
+		if ns.Status.Phase == corev1.NamespaceTerminating {
+			continue
+		}
+		policy := manifests.DefaultDenyNetworkPolicy(ns.Name)
+		if _, err := r.CreateOrUpdate(ctx, r.client, policy, func() error {
+			return networkoperator.ReconcileDefaultDenyNetworkPolicy(policy)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile default deny network policy in namespace %s: %w", ns.Name, err))
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
 type manifestAndReconcile[o client.Object] struct {
 	manifest  func() o
 	reconcile func(o) error
@@ -854,17 +1056,105 @@ func (r *reconciler) reconcileRBAC(ctx context.Context) error {
 	return errors.NewAggregate(errs)
 }
 
+// reconcileUserProvidedRBAC applies the ClusterRole and ClusterRoleBinding
+// manifests referenced by the GuestRBACManifests configuration to the guest
+// cluster. The referenced ConfigMap is synced into the control plane namespace
+// by the hypershift operator.
+func (r *reconciler) reconcileUserProvidedRBAC(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: hcp.Namespace,
+			Name:      hcp.Spec.Configuration.GuestRBACManifests.Name,
+		},
+	}
+	if err := r.cpClient.Get(ctx, client.ObjectKeyFromObject(cm), cm); err != nil {
+		return fmt.Errorf("failed to get guest rbac manifests configmap %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	objects, err := util.ParseGuestRBACManifests(cm.Data)
+	if err != nil {
+		return fmt.Errorf("invalid guest rbac manifests configmap %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	var errs []error
+	for _, obj := range objects {
+		switch desired := obj.(type) {
+		case *rbacv1.ClusterRole:
+			clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: desired.Name}}
+			if _, err := r.CreateOrUpdate(ctx, r.client, clusterRole, func() error {
+				clusterRole.Labels = desired.Labels
+				clusterRole.Annotations = desired.Annotations
+				clusterRole.Rules = desired.Rules
+				clusterRole.AggregationRule = desired.AggregationRule
+				return nil
+			}); err != nil {
+				errs = append(errs, fmt.Errorf("failed to reconcile cluster role %s: %w", desired.Name, err))
+			}
+		case *rbacv1.ClusterRoleBinding:
+			clusterRoleBinding := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: desired.Name}}
+			if _, err := r.CreateOrUpdate(ctx, r.client, clusterRoleBinding, func() error {
+				clusterRoleBinding.Labels = desired.Labels
+				clusterRoleBinding.Annotations = desired.Annotations
+				clusterRoleBinding.RoleRef = desired.RoleRef
+				clusterRoleBinding.Subjects = desired.Subjects
+				return nil
+			}); err != nil {
+				errs = append(errs, fmt.Errorf("failed to reconcile cluster role binding %s: %w", desired.Name, err))
+			}
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
+// reconcileConsoleCustomization creates the ConsoleLink and ConsoleNotification
+// resources described by the hosted cluster's console configuration in the
+// guest cluster.
+func (r *reconciler) reconcileConsoleCustomization(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
+	console := hcp.Spec.Configuration.Console
+	var errs []error
+	for i := range console.Links {
+		link := console.Links[i]
+		consoleLink := &consolev1.ConsoleLink{ObjectMeta: metav1.ObjectMeta{Name: link.Name}}
+		if _, err := r.CreateOrUpdate(ctx, r.client, consoleLink, func() error {
+			consoleLink.Spec = link.ConsoleLinkSpec
+			return nil
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile console link %s: %w", link.Name, err))
+		}
+	}
+	for i := range console.Notifications {
+		notification := console.Notifications[i]
+		consoleNotification := &consolev1.ConsoleNotification{ObjectMeta: metav1.ObjectMeta{Name: notification.Name}}
+		if _, err := r.CreateOrUpdate(ctx, r.client, consoleNotification, func() error {
+			consoleNotification.Spec = notification.ConsoleNotificationSpec
+			return nil
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile console notification %s: %w", notification.Name, err))
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
 func (r *reconciler) reconcileIngressController(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
 	var errs []error
 	p := ingress.NewIngressParams(hcp)
 	ingressController := manifests.IngressDefaultIngressController()
 	if _, err := r.CreateOrUpdate(ctx, r.client, ingressController, func() error {
-		return ingress.ReconcileDefaultIngressController(ingressController, p.IngressSubdomain, p.PlatformType, p.Replicas, p.IBMCloudUPI, p.IsPrivate, p.AWSNLB)
+		return ingress.ReconcileDefaultIngressController(ingressController, p.IngressSubdomain, p.PlatformType, p.Replicas, p.IBMCloudUPI, p.IsPrivate, p.AWSNLB, p.Placement)
 	}); err != nil {
 		errs = append(errs, fmt.Errorf("failed to reconcile default ingress controller: %w", err))
 	}
 
 	sourceCert := cpomanifests.IngressCert(hcp.Namespace)
+	if hcp.Spec.Configuration != nil && hcp.Spec.Configuration.IngressDefaultCertificate != nil && len(hcp.Spec.Configuration.IngressDefaultCertificate.Name) > 0 {
+		// A user provided certificate overrides the self-signed default. The
+		// referenced secret is synced into the control plane namespace by the
+		// hypershift operator.
+		sourceCert = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: hcp.Namespace,
+				Name:      hcp.Spec.Configuration.IngressDefaultCertificate.Name,
+			},
+		}
+	}
 	if err := r.cpClient.Get(ctx, client.ObjectKeyFromObject(sourceCert), sourceCert); err != nil {
 		errs = append(errs, fmt.Errorf("failed to get ingress cert (%s/%s) from control plane: %w", sourceCert.Namespace, sourceCert.Name, err))
 	} else {
@@ -1006,6 +1296,11 @@ func (r *reconciler) reconcileClusterVersion(ctx context.Context, hcp *hyperv1.H
 		clusterVersion.Spec.Capabilities = nil
 		clusterVersion.Spec.Upstream = ""
 		clusterVersion.Spec.Channel = hcp.Spec.Channel
+		// With auto upgrades disabled the CVO must not follow a channel, so version
+		// changes only come from explicit release image updates.
+		if hcp.Annotations[hyperv1.DisableCVOAutoUpgradeAnnotation] == "true" {
+			clusterVersion.Spec.Channel = ""
+		}
 		clusterVersion.Spec.DesiredUpdate = nil
 		return nil
 	}); err != nil {
@@ -1365,9 +1660,21 @@ func (r *reconciler) reconcileOLM(ctx context.Context, hcp *hyperv1.HostedContro
 		{manifest: manifests.RedHatOperatorsCatalogSource, reconcile: olm.ReconcileRedHatOperatorsCatalogSource},
 	}
 
+	// Per-source entries take precedence over disableAllDefaultSources, so a
+	// single default source can be re-enabled while all others stay disabled,
+	// or disabled individually.
+	sourceOverrides := map[string]bool{}
+	for _, source := range operatorHub.Spec.Sources {
+		sourceOverrides[source.Name] = source.Disabled
+	}
+
 	for _, catalog := range catalogs {
 		cs := catalog.manifest()
-		if operatorHub.Spec.DisableAllDefaultSources {
+		disabled := operatorHub.Spec.DisableAllDefaultSources
+		if override, ok := sourceOverrides[cs.Name]; ok {
+			disabled = override
+		}
+		if disabled {
 			if err := r.client.Delete(ctx, cs); err != nil {
 				if !apierrors.IsNotFound(err) {
 					errs = append(errs, fmt.Errorf("failed to delete catalogSource %s/%s: %w", cs.Namespace, cs.Name, err))
@@ -2124,6 +2431,24 @@ func (r *reconciler) reconcileImageContentPolicyType(ctx context.Context, hcp *h
 	return nil
 }
 
+// reconcileImageSignaturePolicies reconciles the image signature policies
+// from the HCP spec into ClusterImagePolicy instances. The ClusterImagePolicy
+// CRD is only present in guest clusters with the TechPreviewNoUpgrade feature
+// set; its absence is surfaced as an error so the policy is not silently
+// skipped.
+func (r *reconciler) reconcileImageSignaturePolicies(ctx context.Context, hcp *hyperv1.HostedControlPlane) error {
+	var errs []error
+	for _, policySpec := range hcp.Spec.ImageSignaturePolicies {
+		policy := globalconfig.ClusterImagePolicy(policySpec.Name)
+		if _, err := r.CreateOrUpdate(ctx, r.client, policy, func() error {
+			return globalconfig.ReconcileClusterImagePolicy(policy, policySpec)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile cluster image policy %s: %w", policySpec.Name, err))
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
 // allLoadBalancersRemoved checks any service of type corev1.ServiceTypeLoadBalancer exists.
 // If any one service of type corev1.ServiceTypeLoadBalancer exists, will return false or else will return true.
 func allLoadBalancersRemoved(ctx context.Context, c client.Client) (bool, error) {
@@ -163,6 +163,13 @@ func NewStartCommand() *cobra.Command {
 		enableCIDebugOutput              bool
 		registryOverrides                map[string]string
 		imageOverrides                   map[string]string
+		leaderElectionLeaseDuration      time.Duration
+		leaderElectionRenewDeadline      time.Duration
+		leaderElectionRetryPeriod        time.Duration
+		resyncPeriod                     time.Duration
+		rateLimiterBaseDelay             time.Duration
+		rateLimiterMaxDelay              time.Duration
+		rateLimiterBucketSize            int
 	)
 
 	cmd.Flags().StringVar(&namespace, "namespace", os.Getenv("MY_NAMESPACE"), "The namespace this operator lives in (required)")
@@ -183,6 +190,13 @@ func NewStartCommand() *cobra.Command {
 			"Format is: name1=image1,name2=image2. \"nameX\" is name of an image in OpenShift release (e.g. \"cluster-network-operator\"). "+
 			"\"imageX\" is container image name (e.g. \"quay.io/foo/my-network-operator:latest\"). The container image name is still subject of registry name "+
 			"replacement when --registry-overrides is used.")
+	cmd.Flags().DurationVar(&leaderElectionLeaseDuration, "leader-elect-lease-duration", config.DefaultLeaseDuration, "The duration that non-leader candidates will wait to force acquire leadership")
+	cmd.Flags().DurationVar(&leaderElectionRenewDeadline, "leader-elect-renew-deadline", config.DefaultRenewDeadline, "The duration that the acting leader will retry refreshing leadership before giving up")
+	cmd.Flags().DurationVar(&leaderElectionRetryPeriod, "leader-elect-retry-period", config.DefaultRetryPeriod, "The duration the leader election clients should wait between attempts of actions")
+	cmd.Flags().DurationVar(&resyncPeriod, "resync-period", config.DefaultResyncPeriod, "The minimum interval at which watched resources are reconciled regardless of events")
+	cmd.Flags().DurationVar(&rateLimiterBaseDelay, "rate-limiter-base-delay", 1*time.Second, "The initial per-item requeue backoff of the hosted control plane controller")
+	cmd.Flags().DurationVar(&rateLimiterMaxDelay, "rate-limiter-max-delay", 10*time.Second, "The maximum per-item requeue backoff of the hosted control plane controller")
+	cmd.Flags().IntVar(&rateLimiterBucketSize, "rate-limiter-bucket-size", config.DefaultRateLimiterBucketSize, "The burst size of the token bucket limiting how many reconciles may start at once")
 
 	cmd.Run = func(cmd *cobra.Command, args []string) {
 		setupLog.Info("Starting hypershift-controlplane-manager", "version", version.String())
@@ -190,10 +204,26 @@ func NewStartCommand() *cobra.Command {
 
 		restConfig := ctrl.GetConfigOrDie()
 		restConfig.UserAgent = "hypershift-controlplane-manager"
-		leaseDuration := time.Second * 60
-		renewDeadline := time.Second * 40
-		retryPeriod := time.Second * 15
-		mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
+		leaderElection := config.LeaderElectionConfig{
+			LeaseDuration: leaderElectionLeaseDuration,
+			RenewDeadline: leaderElectionRenewDeadline,
+			RetryPeriod:   leaderElectionRetryPeriod,
+		}
+		if err := leaderElection.Validate(); err != nil {
+			setupLog.Error(err, "invalid leader election configuration")
+			os.Exit(1)
+		}
+		reconcileConfig := config.ReconcileConfig{
+			ResyncPeriod:          resyncPeriod,
+			RateLimiterBaseDelay:  rateLimiterBaseDelay,
+			RateLimiterMaxDelay:   rateLimiterMaxDelay,
+			RateLimiterBucketSize: rateLimiterBucketSize,
+		}
+		if err := reconcileConfig.Validate(); err != nil {
+			setupLog.Error(err, "invalid reconcile configuration")
+			os.Exit(1)
+		}
+		managerOptions := ctrl.Options{
 			Scheme: hyperapi.Scheme,
 			Metrics: metricsserver.Options{
 				BindAddress: metricsAddr,
@@ -206,9 +236,6 @@ func NewStartCommand() *cobra.Command {
 			LeaderElectionResourceLock:    "leases",
 			LeaderElectionNamespace:       namespace,
 			LeaderElectionReleaseOnCancel: true,
-			LeaseDuration:                 &leaseDuration,
-			RenewDeadline:                 &renewDeadline,
-			RetryPeriod:                   &retryPeriod,
 			HealthProbeBindAddress:        healthProbeAddr,
 			Cache: cache.Options{
 				DefaultFieldSelector: fields.OneTermEqualSelector("metadata.namespace", namespace),
@@ -216,7 +243,10 @@ func NewStartCommand() *cobra.Command {
 					&operatorv1.IngressController{}: {Field: fields.OneTermEqualSelector("metadata.namespace", manifests.IngressPrivateIngressController("").Namespace)},
 				},
 			},
-		})
+		}
+		leaderElection.ApplyTo(&managerOptions)
+		reconcileConfig.ApplyTo(&managerOptions)
+		mgr, err := ctrl.NewManager(restConfig, managerOptions)
 		if err != nil {
 			setupLog.Error(err, "unable to start manager")
 			os.Exit(1)
@@ -403,6 +433,7 @@ func NewStartCommand() *cobra.Command {
 			OperateOnReleaseImage:         os.Getenv("OPERATE_ON_RELEASE_IMAGE"),
 			DefaultIngressDomain:          defaultIngressDomain,
 			MetricsSet:                    metricsSet,
+			ReconcileRateLimiter:          reconcileConfig.RateLimiter(),
 			CertRotationScale:             certRotationScale,
 		}).SetupWithManager(mgr, upsert.New(enableCIDebugOutput).CreateOrUpdate); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "hosted-control-plane")
@@ -18,25 +18,31 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net"
 	"net/netip"
+	"net/url"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/blang/semver"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	configv1 "github.com/openshift/api/config/v1"
+	consolev1 "github.com/openshift/api/console/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	agentv1 "github.com/openshift/cluster-api-provider-agent/api/v1beta1"
 	prometheusoperatorv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -50,12 +56,15 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 	k8sutilspointer "k8s.io/utils/pointer"
@@ -97,6 +106,7 @@ import (
 	"github.com/openshift/hypershift/support/releaseinfo"
 	"github.com/openshift/hypershift/support/rhobsmonitoring"
 	"github.com/openshift/hypershift/support/supportedversion"
+	"github.com/openshift/hypershift/support/thirdparty/library-go/pkg/image/reference"
 	"github.com/openshift/hypershift/support/upsert"
 	hyperutil "github.com/openshift/hypershift/support/util"
 )
@@ -121,6 +131,11 @@ const (
 	useRestrictedPodSecurityLabel                              = "io.openshift.hypershift.restricted-psa"
 
 	etcdEncKeyPostfix = "-etcd-encryption-key"
+
+	// rotatedEncKeyPostfix is the postfix of the name of secrets holding
+	// encryption keys generated by the automated key rotation. The full name
+	// also carries the rotation's unix timestamp.
+	rotatedEncKeyPostfix = "-encryption-key"
 )
 
 var (
@@ -174,6 +189,11 @@ type HostedClusterReconciler struct {
 	MonitoringDashboards bool
 
 	CertRotationScale time.Duration
+
+	// auditWebhookProbes tracks per HostedCluster when the audit webhook
+	// reachability check last ran and for which kubeconfig, so the blocking
+	// TCP dial does not run on every reconcile.
+	auditWebhookProbes sync.Map
 }
 
 // +kubebuilder:rbac:groups=hypershift.openshift.io,resources=hostedclusters,verbs=get;list;watch;create;update;patch;delete
@@ -324,7 +344,7 @@ func (r *HostedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 }
 
 func (r *HostedClusterReconciler) reconcile(ctx context.Context, req ctrl.Request, log logr.Logger, hcluster *hyperv1.HostedCluster) (ctrl.Result, error) {
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceObject(hcluster.Namespace, hcluster.Name)
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceObjectFor(hcluster)
 	hcp := controlplaneoperator.HostedControlPlane(controlPlaneNamespace.Name, hcluster.Name)
 	err := r.Client.Get(ctx, client.ObjectKeyFromObject(hcp), hcp)
 	if err != nil {
@@ -740,7 +760,7 @@ func (r *HostedClusterReconciler) reconcile(ctx context.Context, req ctrl.Reques
 
 	// Copy AWSEndpointAvailable and AWSEndpointServiceAvailable conditions from the AWSEndpointServices.
 	if hcluster.Spec.Platform.Type == hyperv1.AWSPlatform {
-		hcpNamespace := manifests.HostedControlPlaneNamespace(hcluster.Namespace, hcluster.Name)
+		hcpNamespace := manifests.HostedControlPlaneNamespaceFor(hcluster)
 		var awsEndpointServiceList hyperv1.AWSEndpointServiceList
 		if err := r.List(ctx, &awsEndpointServiceList, &client.ListOptions{Namespace: hcpNamespace}); err != nil {
 			condition := metav1.Condition{
@@ -1185,6 +1205,16 @@ func (r *HostedClusterReconciler) reconcile(ctx context.Context, req ctrl.Reques
 				// don't return error here as reconciling won't fix input error
 				return ctrl.Result{}, nil
 			}
+			if hcluster.Spec.SecretEncryption.KeyRotation != nil {
+				rotated, err := r.reconcileAESCBCKeyRotation(ctx, hcluster, createOrUpdate)
+				if err != nil {
+					return ctrl.Result{}, fmt.Errorf("failed to reconcile aescbc key rotation: %w", err)
+				}
+				if rotated {
+					// The spec update re-triggers reconciliation with the new key references.
+					return ctrl.Result{}, nil
+				}
+			}
 			hostedControlPlaneActiveKeySecret := &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: controlPlaneNamespace.Name,
@@ -1261,6 +1291,16 @@ func (r *HostedClusterReconciler) reconcile(ctx context.Context, req ctrl.Reques
 				return ctrl.Result{}, fmt.Errorf("audit webhook secret does not contain key %s", hyperv1.AuditWebhookKubeconfigKey)
 			}
 
+			// Warn, but do not block reconciliation, when the audit webhook
+			// endpoint is not reachable from the management cluster. The check
+			// involves a blocking TCP dial, so it only runs when the kubeconfig
+			// changes or the probe interval elapses.
+			if r.shouldProbeAuditWebhook(hcluster, configData) {
+				if err := checkAuditWebhookReachable(configData); err != nil {
+					log.Info("WARNING: audit webhook endpoint is not reachable", "error", err.Error())
+				}
+			}
+
 			hostedControlPlaneAuditWebhookSecret := &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: controlPlaneNamespace.Name,
@@ -1281,6 +1321,42 @@ func (r *HostedClusterReconciler) reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}
 
+	// Reconcile the HostedControlPlane custom cloud provider config if specified
+	// reference from the HostedCluster and syncing the secret in the control plane namespace.
+	{
+		if hcluster.Spec.CloudProviderConfig != nil && len(hcluster.Spec.CloudProviderConfig.Name) > 0 {
+			var src corev1.Secret
+			if err := r.Client.Get(ctx, client.ObjectKey{Namespace: hcluster.GetNamespace(), Name: hcluster.Spec.CloudProviderConfig.Name}, &src); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to get cloud provider config %s: %w", hcluster.Spec.CloudProviderConfig.Name, err)
+			}
+			configData, ok := src.Data[hyperv1.CloudProviderConfigKey]
+			if !ok {
+				return ctrl.Result{}, fmt.Errorf("cloud provider config secret does not contain key %s", hyperv1.CloudProviderConfigKey)
+			}
+			if err := validateCloudProviderConfigData(configData); err != nil {
+				return ctrl.Result{}, fmt.Errorf("cloud provider config secret %s is invalid: %w", hcluster.Spec.CloudProviderConfig.Name, err)
+			}
+
+			hostedControlPlaneCloudProviderConfigSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: controlPlaneNamespace.Name,
+					Name:      src.Name,
+				},
+			}
+			_, err = createOrUpdate(ctx, r.Client, hostedControlPlaneCloudProviderConfigSecret, func() error {
+				if hostedControlPlaneCloudProviderConfigSecret.Data == nil {
+					hostedControlPlaneCloudProviderConfigSecret.Data = map[string][]byte{}
+				}
+				hostedControlPlaneCloudProviderConfigSecret.Data[hyperv1.CloudProviderConfigKey] = configData
+				hostedControlPlaneCloudProviderConfigSecret.Type = corev1.SecretTypeOpaque
+				return nil
+			})
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed reconciling cloud provider config secret: %w", err)
+			}
+		}
+	}
+
 	// Reconcile the HostedControlPlane SSH secret by resolving the source secret reference
 	// from the HostedCluster and syncing the secret in the control plane namespace.
 	if len(hcluster.Spec.SSHKey.Name) > 0 {
@@ -1708,9 +1784,15 @@ func (r *HostedClusterReconciler) reconcile(ctx context.Context, req ctrl.Reques
 // reconcileHostedControlPlane reconciles the given HostedControlPlane, which
 // will be mutated.
 func reconcileHostedControlPlane(hcp *hyperv1.HostedControlPlane, hcluster *hyperv1.HostedCluster) error {
+	// The hosted cluster config operator records re-encryption progress on
+	// the HostedControlPlane; preserve it across reconciles.
+	secretsReencrypted := hcp.Annotations[hyperv1.SecretsReencryptedAnnotation]
 	hcp.Annotations = map[string]string{
 		HostedClusterAnnotation: client.ObjectKeyFromObject(hcluster).String(),
 	}
+	if secretsReencrypted != "" {
+		hcp.Annotations[hyperv1.SecretsReencryptedAnnotation] = secretsReencrypted
+	}
 
 	// These annotations are copied from the HostedCluster
 	mirroredAnnotations := []string{
@@ -1731,11 +1813,18 @@ func reconcileHostedControlPlane(hcp *hyperv1.HostedControlPlane, hcluster *hype
 		hyperv1.EtcdPriorityClass,
 		hyperv1.EnsureExistsPullSecretReconciliation,
 		hyperv1.TopologyAnnotation,
+		hyperv1.TopologySpreadConstraintsAnnotation,
+		hyperv1.DisableCVOAutoUpgradeAnnotation,
+		hyperv1.CCMFeatureGatesAnnotation,
+		hyperv1.AuditLogForwardingAnnotation,
 		hyperv1.CertifiedOperatorsCatalogImageAnnotation,
 		hyperv1.CommunityOperatorsCatalogImageAnnotation,
 		hyperv1.RedHatMarketplaceCatalogImageAnnotation,
 		hyperv1.RedHatOperatorsCatalogImageAnnotation,
 		hyperv1.OLMCatalogsISRegistryOverridesAnnotation,
+		hyperv1.AvailabilityProbeRetriesAnnotation,
+		hyperv1.AvailabilityProbeBackoffAnnotation,
+		hyperv1.AvailabilityProbeDebounceAnnotation,
 	}
 	for _, key := range mirroredAnnotations {
 		val, hasVal := hcluster.Annotations[key]
@@ -1766,10 +1855,16 @@ func reconcileHostedControlPlane(hcp *hyperv1.HostedControlPlane, hcluster *hype
 	}
 	if hcluster.Spec.AuditWebhook != nil && len(hcluster.Spec.AuditWebhook.Name) > 0 {
 		hcp.Spec.AuditWebhook = hcluster.Spec.AuditWebhook.DeepCopy()
+		hcp.Spec.AuditWebhookBatching = hcluster.Spec.AuditWebhookBatching.DeepCopy()
+	}
+
+	if hcluster.Spec.CloudProviderConfig != nil && len(hcluster.Spec.CloudProviderConfig.Name) > 0 {
+		hcp.Spec.CloudProviderConfig = hcluster.Spec.CloudProviderConfig.DeepCopy()
 	}
 
 	hcp.Spec.FIPS = hcluster.Spec.FIPS
 	hcp.Spec.IssuerURL = hcluster.Spec.IssuerURL
+	hcp.Spec.ServiceAccountAudiences = hcluster.Spec.ServiceAccountAudiences
 	hcp.Spec.ServiceAccountSigningKey = hcluster.Spec.ServiceAccountSigningKey
 
 	hcp.Spec.Networking = hcluster.Spec.Networking
@@ -1790,12 +1885,16 @@ func reconcileHostedControlPlane(hcp *hyperv1.HostedControlPlane, hcluster *hype
 	if hcluster.Spec.ImageContentSources != nil {
 		hcp.Spec.ImageContentSources = hcluster.Spec.ImageContentSources
 	}
+	hcp.Spec.ImageSignaturePolicies = hcluster.Spec.ImageSignaturePolicies
 	if hcluster.Spec.AdditionalTrustBundle != nil {
 		hcp.Spec.AdditionalTrustBundle = &corev1.LocalObjectReference{Name: controlplaneoperator.UserCABundle(hcp.Namespace).Name}
 	}
 	if hcluster.Spec.SecretEncryption != nil {
 		hcp.Spec.SecretEncryption = hcluster.Spec.SecretEncryption.DeepCopy()
 	}
+	if history := hcluster.Status.SecretEncryptionKeyRotations; len(history) > 0 {
+		hcp.Annotations[hyperv1.LastEncryptionKeyRotationAnnotation] = history[len(history)-1].RotationTime.UTC().Format(time.RFC3339)
+	}
 
 	hcp.Spec.PausedUntil = hcluster.Spec.PausedUntil
 	hcp.Spec.OLMCatalogPlacement = hcluster.Spec.OLMCatalogPlacement
@@ -1819,9 +1918,124 @@ func reconcileHostedControlPlane(hcp *hyperv1.HostedControlPlane, hcluster *hype
 	return nil
 }
 
+// reconcileAESCBCKeyRotation rotates the active aescbc encryption key
+// according to the configured rotation schedule. When a rotation is due, a
+// new key is generated and becomes the active key while the previous active
+// key is kept as the backup key. Once the hosted cluster config operator
+// reports that all of the guest cluster's secrets have been re-encrypted, the
+// backup key is retired. The returned boolean indicates whether the
+// HostedCluster was updated.
+func (r *HostedClusterReconciler) reconcileAESCBCKeyRotation(ctx context.Context, hcluster *hyperv1.HostedCluster, createOrUpdate upsert.CreateOrUpdateFN) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	var lastRotation time.Time
+	if history := hcluster.Status.SecretEncryptionKeyRotations; len(history) > 0 {
+		lastRotation = history[len(history)-1].RotationTime.Time
+	} else {
+		activeKeySecret := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: hcluster.Namespace, Name: hcluster.Spec.SecretEncryption.AESCBC.ActiveKey.Name}, activeKeySecret); err != nil {
+			return false, fmt.Errorf("failed to get active aescbc secret: %w", err)
+		}
+		lastRotation = activeKeySecret.CreationTimestamp.Time
+	}
+
+	if backupKey := hcluster.Spec.SecretEncryption.AESCBC.BackupKey; backupKey != nil && len(backupKey.Name) > 0 {
+		// A previous rotation is still pending re-encryption; retire the
+		// backup key once the hosted cluster config operator reports that all
+		// of the guest cluster's secrets have been re-encrypted since the
+		// last rotation. A backup key configured by the user without a
+		// recorded rotation is left alone.
+		if len(hcluster.Status.SecretEncryptionKeyRotations) == 0 {
+			return false, nil
+		}
+		hcp := controlplaneoperator.HostedControlPlane(manifests.HostedControlPlaneNamespaceFor(hcluster), hcluster.Name)
+		if err := r.Get(ctx, client.ObjectKeyFromObject(hcp), hcp); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to get hostedcontrolplane: %w", err)
+		}
+		reencrypted, err := time.Parse(time.RFC3339, hcp.Annotations[hyperv1.SecretsReencryptedAnnotation])
+		if err != nil || reencrypted.Before(lastRotation.Truncate(time.Second)) {
+			return false, nil
+		}
+		retiredKeyName := backupKey.Name
+		hcluster.Spec.SecretEncryption.AESCBC.BackupKey = nil
+		if err := r.Update(ctx, hcluster); err != nil {
+			return false, fmt.Errorf("failed to retire aescbc backup key: %w", err)
+		}
+		// Only key secrets generated by the rotation itself are deleted.
+		if strings.HasPrefix(retiredKeyName, hcluster.Name+rotatedEncKeyPostfix) {
+			retiredSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: hcluster.Namespace,
+					Name:      retiredKeyName,
+				},
+			}
+			if err := r.Delete(ctx, retiredSecret); err != nil && !apierrors.IsNotFound(err) {
+				return true, fmt.Errorf("failed to delete retired aescbc key secret %s: %w", retiredKeyName, err)
+			}
+		}
+		log.Info("retired aescbc backup key", "secret", retiredKeyName)
+		return true, nil
+	}
+
+	if time.Since(lastRotation) < hcluster.Spec.SecretEncryption.KeyRotation.Interval.Duration {
+		return false, nil
+	}
+
+	now := time.Now()
+	newKeySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: hcluster.Namespace,
+			Name:      fmt.Sprintf("%s%s-%d", hcluster.Name, rotatedEncKeyPostfix, now.Unix()),
+		},
+	}
+	if _, err := createOrUpdate(ctx, r.Client, newKeySecret, func() error {
+		// don't override an existing key
+		if _, exists := newKeySecret.Data[hyperv1.AESCBCKeySecretKey]; exists {
+			return nil
+		}
+		generatedKey := make([]byte, 32)
+		if _, err := rand.Read(generatedKey); err != nil {
+			return fmt.Errorf("failed to generate encryption key: %w", err)
+		}
+		if newKeySecret.Data == nil {
+			newKeySecret.Data = map[string][]byte{}
+		}
+		newKeySecret.Data[hyperv1.AESCBCKeySecretKey] = generatedKey
+		newKeySecret.Type = corev1.SecretTypeOpaque
+		config.OwnerRefFrom(hcluster).ApplyTo(newKeySecret)
+		return nil
+	}); err != nil {
+		return false, fmt.Errorf("failed to create rotated aescbc key secret: %w", err)
+	}
+
+	previousKeyName := hcluster.Spec.SecretEncryption.AESCBC.ActiveKey.Name
+	hcluster.Spec.SecretEncryption.AESCBC.BackupKey = &corev1.LocalObjectReference{Name: previousKeyName}
+	hcluster.Spec.SecretEncryption.AESCBC.ActiveKey = corev1.LocalObjectReference{Name: newKeySecret.Name}
+	if err := r.Update(ctx, hcluster); err != nil {
+		return false, fmt.Errorf("failed to update hostedcluster with rotated key: %w", err)
+	}
+
+	hcluster.Status.SecretEncryptionKeyRotations = append(hcluster.Status.SecretEncryptionKeyRotations, hyperv1.EncryptionKeyRotationStatus{
+		RotationTime:   metav1.NewTime(now),
+		ActiveKeyName:  newKeySecret.Name,
+		RetiredKeyName: previousKeyName,
+	})
+	if history := hcluster.Status.SecretEncryptionKeyRotations; len(history) > 10 {
+		hcluster.Status.SecretEncryptionKeyRotations = history[len(history)-10:]
+	}
+	if err := r.Client.Status().Update(ctx, hcluster); err != nil {
+		return true, fmt.Errorf("failed to record key rotation in status: %w", err)
+	}
+	log.Info("rotated aescbc encryption key", "activeKey", newKeySecret.Name, "backupKey", previousKeyName)
+	return true, nil
+}
+
 // reconcileCAPIManager orchestrates orchestrates of  all CAPI manager components.
 func (r *HostedClusterReconciler) reconcileCAPIManager(ctx context.Context, createOrUpdate upsert.CreateOrUpdateFN, hcluster *hyperv1.HostedCluster, hcp *hyperv1.HostedControlPlane, pullSecretBytes []byte) error {
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceObject(hcluster.Namespace, hcluster.Name)
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceObjectFor(hcluster)
 	err := r.Client.Get(ctx, client.ObjectKeyFromObject(controlPlaneNamespace), controlPlaneNamespace)
 	if err != nil {
 		return fmt.Errorf("failed to get control plane namespace: %w", err)
@@ -1949,7 +2163,7 @@ func (r *HostedClusterReconciler) reconcileCAPIProvider(ctx context.Context, cre
 		return nil
 	}
 
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceObject(hcluster.Namespace, hcluster.Name)
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceObjectFor(hcluster)
 	err := r.Client.Get(ctx, client.ObjectKeyFromObject(controlPlaneNamespace), controlPlaneNamespace)
 	if err != nil {
 		return fmt.Errorf("failed to get control plane namespace: %w", err)
@@ -1998,7 +2212,7 @@ func (r *HostedClusterReconciler) reconcileCAPIProvider(ctx context.Context, cre
 // reconcileControlPlaneOperator orchestrates reconciliation of the control plane
 // operator components.
 func (r *HostedClusterReconciler) reconcileControlPlaneOperator(ctx context.Context, createOrUpdate upsert.CreateOrUpdateFN, hcluster *hyperv1.HostedCluster, hostedControlPlane *hyperv1.HostedControlPlane, controlPlaneOperatorImage, utilitiesImage, defaultIngressDomain string, cpoHasUtilities bool, openShiftTrustedCABundleConfigMapExists bool, certRotationScale time.Duration) error {
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceObject(hcluster.Namespace, hcluster.Name)
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceObjectFor(hcluster)
 	err := r.Client.Get(ctx, client.ObjectKeyFromObject(controlPlaneNamespace), controlPlaneNamespace)
 	if err != nil {
 		return fmt.Errorf("failed to get control plane namespace: %w", err)
@@ -3414,7 +3628,7 @@ func deleteControlPlaneOperatorRBAC(ctx context.Context, c client.Client, rbacNa
 }
 
 func (r *HostedClusterReconciler) delete(ctx context.Context, hc *hyperv1.HostedCluster) (bool, error) {
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespace(hc.Namespace, hc.Name)
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceFor(hc)
 	log := ctrl.LoggerFrom(ctx)
 
 	// ensure that the cleanup annotation has been propagated to the hcp if it is set
@@ -3669,6 +3883,10 @@ func (r *HostedClusterReconciler) validateConfigAndClusterCapabilities(ctx conte
 		errs = append(errs, err)
 	}
 
+	if err := validateIssuerURL(hc); err != nil {
+		errs = append(errs, err)
+	}
+
 	if err := r.validatePublishingStrategyMapping(hc); err != nil {
 		errs = append(errs, err)
 	}
@@ -3678,12 +3896,650 @@ func (r *HostedClusterReconciler) validateConfigAndClusterCapabilities(ctx conte
 		if err := r.validateNetworks(hc); err != nil {
 			errs = append(errs, err)
 		}
+		if err := r.validateServiceNetworkVsManagementCluster(ctx, hc); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	if err := r.validateUserCAConfigMaps(ctx, hc); err != nil {
 		errs = append(errs, err...)
 	}
 
+	if err := validateCAPIProviderImageOverrides(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateImageConfig(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := r.validateIngressDefaultCertificate(ctx, hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := r.validateGuestRBACManifests(ctx, hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateConsoleCustomization(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateOperatorHub(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateMetricsRelabelConfigs(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateEtcdCompaction(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateAPIServerTuning(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := r.validateControlPlaneNamespace(ctx, hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := r.validateAuthentication(ctx, hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := r.validateAPIServerClientCA(ctx, hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateSystemComponentsPlacement(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateNetworkPolicyAuditConfig(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateSecretEncryption(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateSecretEncryptionKeyRotation(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateImageSignaturePolicies(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateAWSLoadBalancer(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateAWSServiceEndpoints(hc); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateAWSServiceEndpoints checks that custom AWS service endpoints are
+// well formed and that an explicit partition is consistent with the region.
+func validateAWSServiceEndpoints(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Platform.AWS == nil {
+		return nil
+	}
+	var errs []error
+	seenServices := map[string]bool{}
+	for i, serviceEndpoint := range hc.Spec.Platform.AWS.ServiceEndpoints {
+		if serviceEndpoint.Name == "" {
+			errs = append(errs, fmt.Errorf("spec.platform.aws.serviceEndpoints[%d]: name is required", i))
+		} else if seenServices[serviceEndpoint.Name] {
+			errs = append(errs, fmt.Errorf("spec.platform.aws.serviceEndpoints[%d]: duplicate service endpoint for %s", i, serviceEndpoint.Name))
+		}
+		seenServices[serviceEndpoint.Name] = true
+		if u, err := url.Parse(serviceEndpoint.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("spec.platform.aws.serviceEndpoints[%d]: URL %q must be a https URL", i, serviceEndpoint.URL))
+		}
+	}
+	if partition := hc.Spec.Platform.AWS.Partition; partition != "" {
+		// Regions the SDK does not know about cannot be cross-checked, e.g.
+		// isolated regions, so only known regions are validated.
+		if resolved, known := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), hc.Spec.Platform.AWS.Region); known && resolved.ID() != partition {
+			errs = append(errs, fmt.Errorf("spec.platform.aws.partition: partition %s is not consistent with region %s, which belongs to partition %s", partition, hc.Spec.Platform.AWS.Region, resolved.ID()))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateAWSLoadBalancer checks that the kube-apiserver load balancer
+// configuration is consistent with the cluster's endpoint access mode.
+func validateAWSLoadBalancer(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Platform.AWS == nil || hc.Spec.Platform.AWS.LoadBalancer == nil {
+		return nil
+	}
+	loadBalancer := hc.Spec.Platform.AWS.LoadBalancer
+	switch loadBalancer.Type {
+	case "", hyperv1.NLBLoadBalancerType, hyperv1.ClassicLoadBalancerType:
+	default:
+		return fmt.Errorf("invalid load balancer type %q, must be %s or %s", loadBalancer.Type, hyperv1.NLBLoadBalancerType, hyperv1.ClassicLoadBalancerType)
+	}
+	switch loadBalancer.Scheme {
+	case "", hyperv1.InternetFacingLoadBalancerScheme:
+	case hyperv1.InternalLoadBalancerScheme:
+		if hc.Spec.Platform.AWS.EndpointAccess != hyperv1.Public {
+			return fmt.Errorf("the %s load balancer scheme is only valid with %s endpoint access; %s and %s clusters already expose an internal load balancer", hyperv1.InternalLoadBalancerScheme, hyperv1.Public, hyperv1.PublicAndPrivate, hyperv1.Private)
+		}
+	default:
+		return fmt.Errorf("invalid load balancer scheme %q, must be %s or %s", loadBalancer.Scheme, hyperv1.InternetFacingLoadBalancerScheme, hyperv1.InternalLoadBalancerScheme)
+	}
+	return nil
+}
+
+// validateImageSignaturePolicies checks that each image signature policy has a
+// name, at least one scope and exactly one root of trust.
+func validateImageSignaturePolicies(hc *hyperv1.HostedCluster) error {
+	var errs []error
+	seenNames := map[string]bool{}
+	for i, policy := range hc.Spec.ImageSignaturePolicies {
+		if policy.Name == "" {
+			errs = append(errs, fmt.Errorf("spec.imageSignaturePolicies[%d]: name is required", i))
+		} else if seenNames[policy.Name] {
+			errs = append(errs, fmt.Errorf("spec.imageSignaturePolicies[%d]: duplicate policy name %q", i, policy.Name))
+		}
+		seenNames[policy.Name] = true
+		if len(policy.Scopes) == 0 {
+			errs = append(errs, fmt.Errorf("spec.imageSignaturePolicies[%d]: at least one scope is required", i))
+		}
+		if (len(policy.PublicKey) > 0) == (policy.Fulcio != nil) {
+			errs = append(errs, fmt.Errorf("spec.imageSignaturePolicies[%d]: exactly one of publicKey or fulcio must be set", i))
+		}
+		if len(policy.PublicKey) > 0 {
+			if block, _ := pem.Decode(policy.PublicKey); block == nil {
+				errs = append(errs, fmt.Errorf("spec.imageSignaturePolicies[%d]: publicKey is not PEM encoded", i))
+			}
+		}
+		if policy.Fulcio != nil {
+			if block, _ := pem.Decode(policy.Fulcio.CAData); block == nil {
+				errs = append(errs, fmt.Errorf("spec.imageSignaturePolicies[%d]: fulcio.caData is not PEM encoded", i))
+			}
+			if policy.Fulcio.OIDCIssuer == "" {
+				errs = append(errs, fmt.Errorf("spec.imageSignaturePolicies[%d]: fulcio.oidcIssuer is required", i))
+			}
+			if policy.Fulcio.SignedEmail == "" {
+				errs = append(errs, fmt.Errorf("spec.imageSignaturePolicies[%d]: fulcio.signedEmail is required", i))
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// defaultOperatorHubSources are the names of the default OperatorHub catalog
+// sources managed in the guest cluster; per-source OperatorHub entries can
+// only refer to these.
+var defaultOperatorHubSources = []string{
+	"certified-operators",
+	"community-operators",
+	"redhat-marketplace",
+	"redhat-operators",
+}
+
+// validateOperatorHub checks the OperatorHub configuration: per-source entries
+// must name one of the default catalog sources and may not repeat.
+func validateOperatorHub(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.OperatorHub == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, source := range hc.Spec.Configuration.OperatorHub.Sources {
+		if source.Name == "" {
+			return fmt.Errorf("spec.configuration.operatorHub.sources entries must have a name")
+		}
+		found := false
+		for _, defaultSource := range defaultOperatorHubSources {
+			if source.Name == defaultSource {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("spec.configuration.operatorHub.sources refers to %q, which is not a default hub source (%s)", source.Name, strings.Join(defaultOperatorHubSources, ", "))
+		}
+		if seen[source.Name] {
+			return fmt.Errorf("spec.configuration.operatorHub.sources lists %q more than once", source.Name)
+		}
+		seen[source.Name] = true
+	}
+	return nil
+}
+
+// validateEtcdCompaction checks the managed etcd auto-compaction settings:
+// Periodic retention must parse as a positive duration and Revision retention
+// as a positive revision count.
+func validateEtcdCompaction(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Etcd.Managed == nil || hc.Spec.Etcd.Managed.Compaction == nil {
+		return nil
+	}
+	compaction := hc.Spec.Etcd.Managed.Compaction
+	switch compaction.Mode {
+	case hyperv1.PeriodicEtcdCompaction:
+		duration, err := time.ParseDuration(compaction.Retention)
+		if err != nil {
+			return fmt.Errorf("spec.etcd.managed.compaction.retention: invalid duration %q: %w", compaction.Retention, err)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("spec.etcd.managed.compaction.retention must be a positive duration")
+		}
+	case hyperv1.RevisionEtcdCompaction:
+		revisions, err := strconv.ParseInt(compaction.Retention, 10, 64)
+		if err != nil {
+			return fmt.Errorf("spec.etcd.managed.compaction.retention: invalid revision count %q: %w", compaction.Retention, err)
+		}
+		if revisions <= 0 {
+			return fmt.Errorf("spec.etcd.managed.compaction.retention must be a positive revision count")
+		}
+	default:
+		return fmt.Errorf("spec.etcd.managed.compaction.mode: invalid mode %q, must be %s or %s", compaction.Mode, hyperv1.PeriodicEtcdCompaction, hyperv1.RevisionEtcdCompaction)
+	}
+	return nil
+}
+
+// validateAPIServerTuning checks the kube-apiserver tuning values against the
+// ranges the apiserver itself enforces.
+func validateAPIServerTuning(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.APIServerTuning == nil {
+		return nil
+	}
+	tuning := hc.Spec.Configuration.APIServerTuning
+	if tuning.GoawayChance != "" {
+		chance, err := strconv.ParseFloat(tuning.GoawayChance, 64)
+		if err != nil {
+			return fmt.Errorf("spec.configuration.apiServerTuning.goawayChance: invalid value %q: %w", tuning.GoawayChance, err)
+		}
+		if chance < 0 || chance > 0.02 {
+			return fmt.Errorf("spec.configuration.apiServerTuning.goawayChance must be between 0 and 0.02, got %s", tuning.GoawayChance)
+		}
+	}
+	if tuning.MaxRequestsInflight != nil && *tuning.MaxRequestsInflight < 1 {
+		return fmt.Errorf("spec.configuration.apiServerTuning.maxRequestsInflight must be at least 1, got %d", *tuning.MaxRequestsInflight)
+	}
+	if tuning.MaxMutatingRequestsInflight != nil && *tuning.MaxMutatingRequestsInflight < 1 {
+		return fmt.Errorf("spec.configuration.apiServerTuning.maxMutatingRequestsInflight must be at least 1, got %d", *tuning.MaxMutatingRequestsInflight)
+	}
+	if tuning.DefaultWatchCacheSize != nil && *tuning.DefaultWatchCacheSize < 0 {
+		return fmt.Errorf("spec.configuration.apiServerTuning.defaultWatchCacheSize must not be negative, got %d", *tuning.DefaultWatchCacheSize)
+	}
+	seenResources := sets.NewString()
+	for i, entry := range tuning.WatchCacheSizes {
+		fieldPath := fmt.Sprintf("spec.configuration.apiServerTuning.watchCacheSizes[%d]", i)
+		if !watchCacheSizeRegexp.MatchString(entry) {
+			return fmt.Errorf("%s: invalid entry %q, must have the form resource[.group]#size", fieldPath, entry)
+		}
+		resource, _, _ := strings.Cut(entry, "#")
+		if seenResources.Has(resource) {
+			return fmt.Errorf("%s: duplicate entry for resource %q", fieldPath, resource)
+		}
+		seenResources.Insert(resource)
+	}
+	return nil
+}
+
+// watchCacheSizeRegexp matches a kube-apiserver --watch-cache-sizes entry,
+// i.e. resource[.group]#size with a non-negative integer size.
+var watchCacheSizeRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]*(\.[a-z0-9-]+(\.[a-z0-9-]+)*)?#(0|[1-9][0-9]*)$`)
+
+// prometheusLabelNameRegexp matches valid Prometheus label names.
+var prometheusLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// validateMetricsRelabelConfigs checks the metric relabeling rules in
+// spec.configuration.metrics: regular expressions must compile, label names
+// must be valid and the fields each relabeling action requires must be set.
+func validateMetricsRelabelConfigs(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.Metrics == nil {
+		return nil
+	}
+	for i, relabelConfig := range hc.Spec.Configuration.Metrics.RelabelConfigs {
+		fieldPath := fmt.Sprintf("spec.configuration.metrics.relabelConfigs[%d]", i)
+		if relabelConfig.Regex != "" {
+			if _, err := regexp.Compile(relabelConfig.Regex); err != nil {
+				return fmt.Errorf("%s: invalid regex %q: %w", fieldPath, relabelConfig.Regex, err)
+			}
+		}
+		for _, sourceLabel := range relabelConfig.SourceLabels {
+			if !prometheusLabelNameRegexp.MatchString(sourceLabel) {
+				return fmt.Errorf("%s: invalid source label name %q", fieldPath, sourceLabel)
+			}
+		}
+		if relabelConfig.TargetLabel != "" && !prometheusLabelNameRegexp.MatchString(relabelConfig.TargetLabel) {
+			return fmt.Errorf("%s: invalid target label name %q", fieldPath, relabelConfig.TargetLabel)
+		}
+		switch relabelConfig.Action {
+		case "", "replace":
+			if relabelConfig.TargetLabel == "" {
+				return fmt.Errorf("%s: the replace action requires a targetLabel", fieldPath)
+			}
+		case "keep", "drop":
+			if len(relabelConfig.SourceLabels) == 0 {
+				return fmt.Errorf("%s: the %s action requires sourceLabels", fieldPath, relabelConfig.Action)
+			}
+		case "labelmap":
+		case "labeldrop", "labelkeep":
+			if len(relabelConfig.SourceLabels) > 0 || relabelConfig.TargetLabel != "" {
+				return fmt.Errorf("%s: the %s action must not set sourceLabels or targetLabel", fieldPath, relabelConfig.Action)
+			}
+		default:
+			return fmt.Errorf("%s: invalid action %q", fieldPath, relabelConfig.Action)
+		}
+	}
+	return nil
+}
+
+// validateSecretEncryption checks that the chosen secret encryption provider
+// is supported and carries the metadata it needs, so a misconfigured provider
+// is reported instead of leaving the kube-apiserver without its encryption
+// config.
+func validateSecretEncryption(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.SecretEncryption == nil {
+		return nil
+	}
+	switch hc.Spec.SecretEncryption.Type {
+	case hyperv1.AESCBC:
+		if hc.Spec.SecretEncryption.AESCBC == nil || len(hc.Spec.SecretEncryption.AESCBC.ActiveKey.Name) == 0 {
+			return fmt.Errorf("spec.secretEncryption.aescbc.activeKey is required with the %s encryption type", hyperv1.AESCBC)
+		}
+	case hyperv1.KMS:
+		if hc.Spec.SecretEncryption.KMS == nil {
+			return fmt.Errorf("spec.secretEncryption.kms is required with the %s encryption type", hyperv1.KMS)
+		}
+		switch hc.Spec.SecretEncryption.KMS.Provider {
+		case hyperv1.AWS:
+			if hc.Spec.SecretEncryption.KMS.AWS == nil {
+				return fmt.Errorf("spec.secretEncryption.kms.aws is required with the %s KMS provider", hyperv1.AWS)
+			}
+		case hyperv1.IBMCloud:
+			if hc.Spec.SecretEncryption.KMS.IBMCloud == nil {
+				return fmt.Errorf("spec.secretEncryption.kms.ibmcloud is required with the %s KMS provider", hyperv1.IBMCloud)
+			}
+		case hyperv1.AZURE:
+			if hc.Spec.SecretEncryption.KMS.Azure == nil {
+				return fmt.Errorf("spec.secretEncryption.kms.azure is required with the %s KMS provider", hyperv1.AZURE)
+			}
+		default:
+			return fmt.Errorf("invalid KMS provider %q, must be one of %s, %s or %s", hc.Spec.SecretEncryption.KMS.Provider, hyperv1.AWS, hyperv1.IBMCloud, hyperv1.AZURE)
+		}
+	default:
+		return fmt.Errorf("invalid secret encryption type %q, must be one of %s or %s", hc.Spec.SecretEncryption.Type, hyperv1.KMS, hyperv1.AESCBC)
+	}
+	return nil
+}
+
+// validateSecretEncryptionKeyRotation checks that the encryption key rotation
+// schedule is only used with the aescbc encryption type and that its interval
+// is reasonable.
+func validateSecretEncryptionKeyRotation(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.SecretEncryption == nil || hc.Spec.SecretEncryption.KeyRotation == nil {
+		return nil
+	}
+	if hc.Spec.SecretEncryption.Type != hyperv1.AESCBC {
+		return fmt.Errorf("spec.secretEncryption.keyRotation is only supported with the %s encryption type", hyperv1.AESCBC)
+	}
+	if hc.Spec.SecretEncryption.KeyRotation.Interval.Duration < time.Hour {
+		return fmt.Errorf("encryption key rotation interval must be at least 1h")
+	}
+	return nil
+}
+
+// validateNetworkPolicyAuditConfig checks that spec.networking.policyAuditConfig
+// is only used with OVN-Kubernetes and that its parameters are valid.
+func validateNetworkPolicyAuditConfig(hc *hyperv1.HostedCluster) error {
+	policyAuditConfig := hc.Spec.Networking.PolicyAuditConfig
+	if policyAuditConfig == nil {
+		return nil
+	}
+	if hc.Spec.Networking.NetworkType != hyperv1.OVNKubernetes {
+		return fmt.Errorf("spec.networking.policyAuditConfig is only supported with network type %s", hyperv1.OVNKubernetes)
+	}
+	var errs []error
+	if policyAuditConfig.RateLimit != nil && *policyAuditConfig.RateLimit < 1 {
+		errs = append(errs, fmt.Errorf("policy audit rate limit must be at least 1 message per second"))
+	}
+	switch policyAuditConfig.Severity {
+	case "", "alert", "warning", "notice", "info", "debug":
+	default:
+		errs = append(errs, fmt.Errorf("invalid policy audit severity %q, must be one of alert, warning, notice, info or debug", policyAuditConfig.Severity))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateSystemComponentsPlacement checks the node selector and toleration
+// syntax in spec.configuration.systemComponentsPlacement before it is rolled
+// out to the guest cluster operators.
+func validateSystemComponentsPlacement(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.SystemComponentsPlacement == nil {
+		return nil
+	}
+	placement := hc.Spec.Configuration.SystemComponentsPlacement
+	var errs []error
+	for key, value := range placement.NodeSelector {
+		for _, message := range validation.IsQualifiedName(key) {
+			errs = append(errs, fmt.Errorf("invalid systemComponentsPlacement node selector key %q: %s", key, message))
+		}
+		for _, message := range validation.IsValidLabelValue(value) {
+			errs = append(errs, fmt.Errorf("invalid systemComponentsPlacement node selector value %q: %s", value, message))
+		}
+	}
+	for i, toleration := range placement.Tolerations {
+		if toleration.Key != "" {
+			for _, message := range validation.IsQualifiedName(toleration.Key) {
+				errs = append(errs, fmt.Errorf("invalid systemComponentsPlacement tolerations[%d] key %q: %s", i, toleration.Key, message))
+			}
+		} else if toleration.Operator != corev1.TolerationOpExists {
+			errs = append(errs, fmt.Errorf("systemComponentsPlacement tolerations[%d] must use operator %s when no key is set", i, corev1.TolerationOpExists))
+		}
+		switch toleration.Operator {
+		case "", corev1.TolerationOpEqual:
+			for _, message := range validation.IsValidLabelValue(toleration.Value) {
+				errs = append(errs, fmt.Errorf("invalid systemComponentsPlacement tolerations[%d] value %q: %s", i, toleration.Value, message))
+			}
+		case corev1.TolerationOpExists:
+			if toleration.Value != "" {
+				errs = append(errs, fmt.Errorf("systemComponentsPlacement tolerations[%d] must not set a value with operator %s", i, corev1.TolerationOpExists))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("invalid systemComponentsPlacement tolerations[%d] operator %q", i, toleration.Operator))
+		}
+		switch toleration.Effect {
+		case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			errs = append(errs, fmt.Errorf("invalid systemComponentsPlacement tolerations[%d] effect %q", i, toleration.Effect))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateIngressDefaultCertificate checks that the referenced default ingress
+// certificate secret exists and holds a parseable keypair. A certificate which
+// does not cover the apps wildcard domain is only warned about, as custom
+// domains may be served through additional ingress controllers.
+func (r *HostedClusterReconciler) validateIngressDefaultCertificate(ctx context.Context, hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.IngressDefaultCertificate == nil || len(hc.Spec.Configuration.IngressDefaultCertificate.Name) == 0 {
+		return nil
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: hc.Namespace,
+			Name:      hc.Spec.Configuration.IngressDefaultCertificate.Name,
+		},
+	}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		return fmt.Errorf("failed to get ingress default certificate secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+	if _, hasKey := secret.Data[corev1.TLSPrivateKeyKey]; !hasKey {
+		return fmt.Errorf("ingress default certificate secret %s/%s does not have a %s key", secret.Namespace, secret.Name, corev1.TLSPrivateKeyKey)
+	}
+	certBytes, hasCert := secret.Data[corev1.TLSCertKey]
+	if !hasCert {
+		return fmt.Errorf("ingress default certificate secret %s/%s does not have a %s key", secret.Namespace, secret.Name, corev1.TLSCertKey)
+	}
+	cert, err := certs.PemToCertificate(certBytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse certificate in ingress default certificate secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+	// Verify coverage with a concrete hostname under the apps domain so
+	// wildcard SANs match.
+	appsDomain := ingressDomain(hc)
+	if err := cert.VerifyHostname(fmt.Sprintf("console-openshift-console.%s", appsDomain)); err != nil {
+		ctrl.LoggerFrom(ctx).Info("Warning: ingress default certificate does not cover the apps wildcard domain", "secret", client.ObjectKeyFromObject(secret).String(), "domain", fmt.Sprintf("*.%s", appsDomain), "error", err.Error())
+	}
+	return nil
+}
+
+// validateAPIServerClientCA checks that the client CA ConfigMap referenced by
+// spec.configuration.apiServer.clientCA exists and holds a parseable
+// certificate bundle, so a broken bundle is caught before it is merged into
+// the kube-apiserver client CA.
+func (r *HostedClusterReconciler) validateAPIServerClientCA(ctx context.Context, hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.APIServer == nil || len(hc.Spec.Configuration.APIServer.ClientCA.Name) == 0 {
+		return nil
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: hc.Namespace,
+			Name:      hc.Spec.Configuration.APIServer.ClientCA.Name,
+		},
+	}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(cm), cm); err != nil {
+		return fmt.Errorf("failed to get apiserver client CA configmap %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	bundle, hasBundle := cm.Data[certs.OCPCASignerCertMapKey]
+	if !hasBundle {
+		return fmt.Errorf("apiserver client CA configmap %s/%s does not have a %s key", cm.Namespace, cm.Name, certs.OCPCASignerCertMapKey)
+	}
+	rest := []byte(bundle)
+	var parsed int
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("apiserver client CA configmap %s/%s contains a %s PEM block, expected only certificates", cm.Namespace, cm.Name, block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("failed to parse certificate %d in apiserver client CA configmap %s/%s: %w", parsed, cm.Namespace, cm.Name, err)
+		}
+		parsed++
+	}
+	if parsed == 0 {
+		return fmt.Errorf("apiserver client CA configmap %s/%s does not contain any PEM certificates", cm.Namespace, cm.Name)
+	}
+	return nil
+}
+
+// validateGuestRBACManifests checks that the referenced guest RBAC manifests
+// ConfigMap exists and only contains ClusterRole and ClusterRoleBinding
+// manifests, so invalid content is surfaced before the control plane tries to
+// apply it.
+func (r *HostedClusterReconciler) validateGuestRBACManifests(ctx context.Context, hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.GuestRBACManifests == nil || len(hc.Spec.Configuration.GuestRBACManifests.Name) == 0 {
+		return nil
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: hc.Namespace,
+			Name:      hc.Spec.Configuration.GuestRBACManifests.Name,
+		},
+	}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(cm), cm); err != nil {
+		return fmt.Errorf("failed to get guest rbac manifests configmap %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	if _, err := hyperutil.ParseGuestRBACManifests(cm.Data); err != nil {
+		return fmt.Errorf("invalid guest rbac manifests configmap %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	return nil
+}
+
+// ingressDomain mirrors globalconfig.IngressDomain for a HostedCluster.
+func ingressDomain(hc *hyperv1.HostedCluster) string {
+	if hc.Spec.Configuration != nil && hc.Spec.Configuration.Ingress != nil {
+		if len(hc.Spec.Configuration.Ingress.AppsDomain) > 0 {
+			return hc.Spec.Configuration.Ingress.AppsDomain
+		}
+		if len(hc.Spec.Configuration.Ingress.Domain) > 0 {
+			return hc.Spec.Configuration.Ingress.Domain
+		}
+	}
+	prefix := hc.Name
+	if hc.Spec.DNS.BaseDomainPrefix != nil {
+		prefix = *hc.Spec.DNS.BaseDomainPrefix
+	}
+	if prefix == "" {
+		return fmt.Sprintf("apps.%s", hc.Spec.DNS.BaseDomain)
+	}
+	return fmt.Sprintf("apps.%s.%s", prefix, hc.Spec.DNS.BaseDomain)
+}
+
+// validateImageConfig checks the registry lists in the hosted cluster's image
+// configuration so that malformed entries are surfaced before they are rolled
+// out to the guest cluster.
+func validateImageConfig(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.Image == nil {
+		return nil
+	}
+	image := hc.Spec.Configuration.Image
+	var errs []error
+	if len(image.RegistrySources.AllowedRegistries) > 0 && len(image.RegistrySources.BlockedRegistries) > 0 {
+		errs = append(errs, fmt.Errorf("allowedRegistries and blockedRegistries are mutually exclusive"))
+	}
+	registries := append([]string{}, image.RegistrySources.AllowedRegistries...)
+	registries = append(registries, image.RegistrySources.BlockedRegistries...)
+	registries = append(registries, image.RegistrySources.InsecureRegistries...)
+	for _, location := range image.AllowedRegistriesForImport {
+		registries = append(registries, location.DomainName)
+	}
+	for _, registry := range registries {
+		if err := validateRegistryName(registry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateRegistryName verifies that a registry entry is a plain
+// hostname[:port], optionally with a wildcard subdomain prefix.
+func validateRegistryName(registry string) error {
+	if len(registry) == 0 {
+		return fmt.Errorf("registry name may not be empty")
+	}
+	if strings.Contains(registry, "://") {
+		return fmt.Errorf("registry %q must not include a scheme", registry)
+	}
+	if strings.ContainsAny(registry, " \t") {
+		return fmt.Errorf("registry %q must not contain whitespace", registry)
+	}
+	return nil
+}
+
+// validateCAPIProviderImageOverrides checks that any cluster-api manager or
+// provider image override annotations hold parseable image references.
+func validateCAPIProviderImageOverrides(hc *hyperv1.HostedCluster) error {
+	var errs []error
+	for _, annotation := range []string{
+		hyperv1.ClusterAPIManagerImage,
+		hyperv1.ClusterAPIProviderAWSImage,
+		hyperv1.ClusterAPIKubeVirtProviderImage,
+		hyperv1.ClusterAPIAgentProviderImage,
+		hyperv1.ClusterAPIAzureProviderImage,
+		hyperv1.ClusterAPIPowerVSProviderImage,
+	} {
+		if override, ok := hc.Annotations[annotation]; ok {
+			if _, err := reference.Parse(override); err != nil {
+				errs = append(errs, fmt.Errorf("invalid image reference %q in annotation %s: %w", override, annotation, err))
+			}
+		}
+	}
 	return utilerrors.NewAggregate(errs)
 }
 
@@ -3751,6 +4607,75 @@ func (r *HostedClusterReconciler) validateReleaseImage(ctx context.Context, hc *
 	return supportedversion.IsValidReleaseVersion(&version, currentVersion, &supportedversion.LatestSupportedVersion, &minSupportedVersion, hc.Spec.Networking.NetworkType, hc.Spec.Platform.Type)
 }
 
+// auditWebhookProbeInterval is how long a reachability probe result for an
+// unchanged audit webhook kubeconfig stays valid before the endpoint is
+// dialed again.
+const auditWebhookProbeInterval = 5 * time.Minute
+
+type auditWebhookProbe struct {
+	configHash string
+	lastProbe  time.Time
+}
+
+// shouldProbeAuditWebhook debounces the audit webhook reachability check so its
+// blocking TCP dial only runs when the webhook kubeconfig changed or the probe
+// interval elapsed since the last attempt.
+func (r *HostedClusterReconciler) shouldProbeAuditWebhook(hc *hyperv1.HostedCluster, kubeconfigData []byte) bool {
+	key := client.ObjectKeyFromObject(hc).String()
+	hash := hyperutil.HashStruct(kubeconfigData)
+	if value, ok := r.auditWebhookProbes.Load(key); ok {
+		probe := value.(auditWebhookProbe)
+		if probe.configHash == hash && time.Since(probe.lastProbe) < auditWebhookProbeInterval {
+			return false
+		}
+	}
+	r.auditWebhookProbes.Store(key, auditWebhookProbe{configHash: hash, lastProbe: time.Now()})
+	return true
+}
+
+// checkAuditWebhookReachable attempts a TCP connection to the audit webhook
+// endpoint found in the given webhook kubeconfig. It is a best effort check
+// used to surface misconfigured endpoints early.
+func checkAuditWebhookReachable(kubeconfigData []byte) error {
+	kubeconfig, err := clientcmd.Load(kubeconfigData)
+	if err != nil {
+		return fmt.Errorf("failed to parse audit webhook kubeconfig: %w", err)
+	}
+	for _, cluster := range kubeconfig.Clusters {
+		serverURL, err := url.Parse(cluster.Server)
+		if err != nil {
+			return fmt.Errorf("failed to parse audit webhook server %q: %w", cluster.Server, err)
+		}
+		port := serverURL.Port()
+		if port == "" {
+			switch serverURL.Scheme {
+			case "http":
+				port = "80"
+			default:
+				port = "443"
+			}
+		}
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(serverURL.Hostname(), port), 5*time.Second)
+		if err != nil {
+			return fmt.Errorf("failed to reach audit webhook server %q: %w", cluster.Server, err)
+		}
+		conn.Close()
+	}
+	return nil
+}
+
+// validateCloudProviderConfigData verifies that a custom cloud provider config
+// parses as an INI file before it is handed to the cloud controller manager.
+func validateCloudProviderConfigData(configData []byte) error {
+	if len(configData) == 0 {
+		return fmt.Errorf("cloud provider config is empty")
+	}
+	if _, err := ini.Load(configData); err != nil {
+		return fmt.Errorf("failed to parse cloud provider config: %w", err)
+	}
+	return nil
+}
+
 func isProgressing(hc *hyperv1.HostedCluster, releaseImage *releaseinfo.ReleaseImage) (bool, error) {
 	for _, condition := range hc.Status.Conditions {
 		switch string(condition.Type) {
@@ -3993,6 +4918,39 @@ func (r *HostedClusterReconciler) validateNetworks(hc *hyperv1.HostedCluster) er
 	return errs.ToAggregate()
 }
 
+// validateServiceNetworkVsManagementCluster validates that the hosted
+// cluster's service network does not overlap the management cluster's service
+// CIDR. Control plane components resolve guest service addresses from within
+// the hosted control plane namespace, so an overlap causes routing conflicts
+// between the two clusters' service networks.
+func (r *HostedClusterReconciler) validateServiceNetworkVsManagementCluster(ctx context.Context, hc *hyperv1.HostedCluster) error {
+	managementNetwork := &configv1.Network{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(managementNetwork), managementNetwork); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+			// The management cluster does not expose its network configuration,
+			// nothing to validate against.
+			return nil
+		}
+		return fmt.Errorf("failed to get management cluster network configuration: %w", err)
+	}
+
+	var errs field.ErrorList
+	for _, managementCIDR := range managementNetwork.Status.ServiceNetwork {
+		_, managementNet, err := net.ParseCIDR(managementCIDR)
+		if err != nil {
+			continue
+		}
+		for _, cidr := range hc.Spec.Networking.ServiceNetwork {
+			serviceNet := (net.IPNet)(cidr.CIDR)
+			if err := cidrsOverlap(&serviceNet, managementNet); err != nil {
+				errs = append(errs, field.Invalid(field.NewPath("spec.networking.ServiceNetwork"), cidr.CIDR.String(),
+					fmt.Sprintf("service network overlaps with the management cluster's service CIDR %s: %s", managementCIDR, err)))
+			}
+		}
+	}
+	return errs.ToAggregate()
+}
+
 // findAdvertiseAddress function returns a string and an error indicating the AdvertiseAddress for the hostedcluster.
 // if the advertise address is properly set, it will return that value and nil, otherwise will return an error.
 // if the advertise address is not set, it will return the default one based on the network primary stack.
@@ -4492,6 +5450,201 @@ func validateClusterID(hc *hyperv1.HostedCluster) error {
 	}
 	return nil
 }
+
+// validateIssuerURL ensures that a user supplied service account issuer is a
+// usable https URL. Service account tokens embed the issuer verbatim, so a
+// malformed value would only surface later as workload authentication
+// failures.
+func validateIssuerURL(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.IssuerURL == "" || hc.Spec.IssuerURL == config.DefaultServiceAccountIssuer {
+		return nil
+	}
+	issuerURL, err := url.Parse(hc.Spec.IssuerURL)
+	if err != nil {
+		return fmt.Errorf("cannot parse issuer URL %q: %w", hc.Spec.IssuerURL, err)
+	}
+	if issuerURL.Scheme != "https" {
+		return fmt.Errorf("issuer URL %q must use the https scheme", hc.Spec.IssuerURL)
+	}
+	if issuerURL.Host == "" {
+		return fmt.Errorf("issuer URL %q must specify a host", hc.Spec.IssuerURL)
+	}
+	if issuerURL.RawQuery != "" || issuerURL.Fragment != "" {
+		return fmt.Errorf("issuer URL %q must not contain a query or fragment", hc.Spec.IssuerURL)
+	}
+	return nil
+}
+
+// validateControlPlaneNamespace checks the control-plane-namespace override
+// annotation: the value must be a valid namespace name, must not collide
+// with the control plane namespace of any other HostedCluster, and must not
+// change the effective namespace once the control plane exists.
+func (r *HostedClusterReconciler) validateControlPlaneNamespace(ctx context.Context, hc *hyperv1.HostedCluster) error {
+	override, exists := hc.Annotations[hyperv1.ControlPlaneNamespaceAnnotation]
+	if exists && override != "" {
+		if msgs := validation.IsDNS1123Label(override); len(msgs) > 0 {
+			return fmt.Errorf("invalid control plane namespace %q: %s", override, strings.Join(msgs, ", "))
+		}
+		hcList := &hyperv1.HostedClusterList{}
+		if err := r.Client.List(ctx, hcList); err != nil {
+			return fmt.Errorf("failed to list hosted clusters: %w", err)
+		}
+		for i := range hcList.Items {
+			other := &hcList.Items[i]
+			if other.Namespace == hc.Namespace && other.Name == hc.Name {
+				continue
+			}
+			if manifests.HostedControlPlaneNamespaceFor(other) == override {
+				return fmt.Errorf("control plane namespace %q is already in use by hosted cluster %s/%s", override, other.Namespace, other.Name)
+			}
+		}
+	}
+	// Once a HostedControlPlane exists its namespace is immutable: changing
+	// the annotation would silently re-home the entire control plane and
+	// orphan everything in the old namespace.
+	effectiveNamespace := manifests.HostedControlPlaneNamespaceFor(hc)
+	hcpList := &hyperv1.HostedControlPlaneList{}
+	if err := r.Client.List(ctx, hcpList); err != nil {
+		return fmt.Errorf("failed to list hosted control planes: %w", err)
+	}
+	for i := range hcpList.Items {
+		hcp := &hcpList.Items[i]
+		if hcp.Annotations[HostedClusterAnnotation] != client.ObjectKeyFromObject(hc).String() {
+			continue
+		}
+		if hcp.Namespace != effectiveNamespace {
+			return fmt.Errorf("control plane namespace cannot be changed to %q: the control plane already exists in namespace %q", effectiveNamespace, hcp.Namespace)
+		}
+	}
+	return nil
+}
+
+// validateAuthentication checks the external OIDC authentication
+// configuration so a misconfigured issuer or claim mapping is rejected before
+// it is rendered into the kube-apiserver arguments.
+func (r *HostedClusterReconciler) validateAuthentication(ctx context.Context, hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.Authentication == nil {
+		return nil
+	}
+	authentication := hc.Spec.Configuration.Authentication
+	if hyperutil.ConfigOAuthEnabled(authentication) {
+		return nil
+	}
+	if len(authentication.OIDCProviders) != 1 {
+		return fmt.Errorf("exactly one OIDC provider must be specified when authentication type is %s, got %d", configv1.AuthenticationTypeOIDC, len(authentication.OIDCProviders))
+	}
+	provider := authentication.OIDCProviders[0]
+	issuerURL, err := url.Parse(provider.Issuer.URL)
+	if err != nil {
+		return fmt.Errorf("OIDC provider %q has an invalid issuer URL: %w", provider.Name, err)
+	}
+	if issuerURL.Scheme != "https" {
+		return fmt.Errorf("OIDC provider %q issuer URL must use the https scheme", provider.Name)
+	}
+	if issuerURL.RawQuery != "" || issuerURL.Fragment != "" {
+		return fmt.Errorf("OIDC provider %q issuer URL must not have a query or fragment", provider.Name)
+	}
+	if len(provider.Issuer.Audiences) == 0 {
+		return fmt.Errorf("OIDC provider %q must specify at least one audience", provider.Name)
+	}
+	if provider.ClaimMappings.Username.Claim == "" {
+		return fmt.Errorf("OIDC provider %q must specify a username claim", provider.Name)
+	}
+	if provider.ClaimMappings.Username.PrefixPolicy == configv1.Prefix &&
+		(provider.ClaimMappings.Username.Prefix == nil || provider.ClaimMappings.Username.Prefix.PrefixString == "") {
+		return fmt.Errorf("OIDC provider %q must specify a username prefix when the prefix policy is %s", provider.Name, configv1.Prefix)
+	}
+	for _, rule := range provider.ClaimValidationRules {
+		if rule.RequiredClaim == nil || rule.RequiredClaim.Claim == "" || rule.RequiredClaim.RequiredValue == "" {
+			return fmt.Errorf("OIDC provider %q claim validation rules must specify both a claim and a required value", provider.Name)
+		}
+	}
+	if caName := provider.Issuer.CertificateAuthority.Name; caName != "" {
+		var caConfigMap corev1.ConfigMap
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: hc.Namespace, Name: caName}, &caConfigMap); err != nil {
+			return fmt.Errorf("failed to get OIDC provider %q issuer CA configmap %q: %w", provider.Name, caName, err)
+		}
+		if _, hasCA := caConfigMap.Data["ca.crt"]; !hasCA {
+			return fmt.Errorf("OIDC provider %q issuer CA configmap %q must have a ca.crt key", provider.Name, caName)
+		}
+	}
+	return nil
+}
+
+// validateConsoleCustomization checks the console links and notifications in
+// the hosted cluster's configuration so malformed entries are rejected before
+// the control plane tries to create the corresponding console resources.
+func validateConsoleCustomization(hc *hyperv1.HostedCluster) error {
+	if hc.Spec.Configuration == nil || hc.Spec.Configuration.Console == nil {
+		return nil
+	}
+	console := hc.Spec.Configuration.Console
+	var errs []error
+	linkNames := make(map[string]bool, len(console.Links))
+	for _, link := range console.Links {
+		if msgs := validation.IsDNS1123Subdomain(link.Name); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("invalid console link name %q: %s", link.Name, strings.Join(msgs, ", ")))
+			continue
+		}
+		if linkNames[link.Name] {
+			errs = append(errs, fmt.Errorf("duplicate console link name %q", link.Name))
+		}
+		linkNames[link.Name] = true
+		if len(link.Text) == 0 {
+			errs = append(errs, fmt.Errorf("console link %q must specify text", link.Name))
+		}
+		if err := validateConsoleHref(link.Href); err != nil {
+			errs = append(errs, fmt.Errorf("console link %q: %w", link.Name, err))
+		}
+		switch link.Location {
+		case consolev1.HelpMenu, consolev1.UserMenu, consolev1.ApplicationMenu, consolev1.NamespaceDashboard:
+		default:
+			errs = append(errs, fmt.Errorf("console link %q has invalid location %q", link.Name, link.Location))
+		}
+	}
+	notificationNames := make(map[string]bool, len(console.Notifications))
+	for _, notification := range console.Notifications {
+		if msgs := validation.IsDNS1123Subdomain(notification.Name); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("invalid console notification name %q: %s", notification.Name, strings.Join(msgs, ", ")))
+			continue
+		}
+		if notificationNames[notification.Name] {
+			errs = append(errs, fmt.Errorf("duplicate console notification name %q", notification.Name))
+		}
+		notificationNames[notification.Name] = true
+		if len(notification.Text) == 0 {
+			errs = append(errs, fmt.Errorf("console notification %q must specify text", notification.Name))
+		}
+		switch notification.Location {
+		case "", consolev1.BannerTop, consolev1.BannerBottom, consolev1.BannerTopBottom:
+		default:
+			errs = append(errs, fmt.Errorf("console notification %q has invalid location %q", notification.Name, notification.Location))
+		}
+		if notification.Link != nil {
+			if err := validateConsoleHref(notification.Link.Href); err != nil {
+				errs = append(errs, fmt.Errorf("console notification %q: %w", notification.Name, err))
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateConsoleHref ensures a console link target is a parseable https URL,
+// which is what the console enforces for external links.
+func validateConsoleHref(href string) error {
+	hrefURL, err := url.Parse(href)
+	if err != nil {
+		return fmt.Errorf("cannot parse href %q: %w", href, err)
+	}
+	if hrefURL.Scheme != "https" {
+		return fmt.Errorf("href %q must use the https scheme", href)
+	}
+	if hrefURL.Host == "" {
+		return fmt.Errorf("href %q must specify a host", href)
+	}
+	return nil
+}
+
 func (r *HostedClusterReconciler) reconcileServiceAccountSigningKey(ctx context.Context, hc *hyperv1.HostedCluster, targetNamespace string, createOrUpdate upsert.CreateOrUpdateFN) error {
 	privateBytes, publicBytes, err := r.serviceAccountSigningKeyBytes(ctx, hc)
 	if err != nil {
@@ -4526,7 +5679,7 @@ func (r *HostedClusterReconciler) validateServiceAccountSigningKey(ctx context.C
 	if err != nil {
 		return err
 	}
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespace(hc.Namespace, hc.Name)
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceFor(hc)
 	cpSigningKeySecret := controlplaneoperator.ServiceAccountSigningKeySecret(controlPlaneNamespace)
 	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(cpSigningKeySecret), cpSigningKeySecret); err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -4755,7 +5908,7 @@ func (r *HostedClusterReconciler) reconcileMonitoringDashboard(ctx context.Conte
 	varsToReplace := map[string]string{
 		"__NAME__":                    hc.Name,
 		"__NAMESPACE__":               hc.Namespace,
-		"__CONTROL_PLANE_NAMESPACE__": manifests.HostedControlPlaneNamespace(hc.Namespace, hc.Name),
+		"__CONTROL_PLANE_NAMESPACE__": manifests.HostedControlPlaneNamespaceFor(hc),
 		"__CLUSTER_ID__":              hc.Spec.ClusterID,
 	}
 	for k, v := range varsToReplace {
@@ -146,6 +146,13 @@ func (v hostedClusterValidator) ValidateUpdate(ctx context.Context, oldHC, newHC
 		return nil, fmt.Errorf("wrong type %T for validation, instead of HostedCluster", oldHC)
 	}
 
+	// The issuer URL is embedded in every service account token signed by the
+	// control plane; changing it invalidates tokens already projected into
+	// workloads. Reject changes instead of rolling out a broken issuer.
+	if hcOld.Spec.IssuerURL != hc.Spec.IssuerURL {
+		return nil, fmt.Errorf("IssuerURL is immutable and cannot be changed from %q to %q", hcOld.Spec.IssuerURL, hc.Spec.IssuerURL)
+	}
+
 	switch hc.Spec.Platform.Type {
 	case hyperv1.KubevirtPlatform:
 		err := v.validateUpdateKubevirtHostedCluster(ctx, hcOld, hc)
@@ -314,7 +321,7 @@ func (v *kubevirtClusterValidator) validateCreate(ctx context.Context, hc *hyper
 		return nil, fmt.Errorf("the spec.platform.kubevirt field is missing in the HostedCluster resource")
 	}
 
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespace(hc.Namespace, hc.Name)
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceFor(hc)
 	cl, err := v.clientMap.DiscoverKubevirtClusterClient(ctx, v.client, hc.Spec.InfraID, hc.Spec.Platform.Kubevirt.Credentials, controlPlaneNamespace, hc.Namespace)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect external infra cluster; %w", err)
@@ -1326,3 +1326,48 @@ func TestValidateKVNodePoolUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHostedClusterIssuerURLUpdate(t *testing.T) {
+	for _, testCase := range []struct {
+		name        string
+		oldHC       *v1beta1.HostedCluster
+		newHC       *v1beta1.HostedCluster
+		expectError bool
+	}{
+		{
+			name: "issuer unchanged",
+			oldHC: &v1beta1.HostedCluster{
+				Spec: v1beta1.HostedClusterSpec{
+					IssuerURL: "https://example-oidc.s3.us-east-1.amazonaws.com/cluster",
+				},
+			},
+			newHC: &v1beta1.HostedCluster{
+				Spec: v1beta1.HostedClusterSpec{
+					IssuerURL: "https://example-oidc.s3.us-east-1.amazonaws.com/cluster",
+				},
+			},
+		},
+		{
+			name: "issuer changed",
+			oldHC: &v1beta1.HostedCluster{
+				Spec: v1beta1.HostedClusterSpec{
+					IssuerURL: "https://example-oidc.s3.us-east-1.amazonaws.com/cluster",
+				},
+			},
+			newHC: &v1beta1.HostedCluster{
+				Spec: v1beta1.HostedClusterSpec{
+					IssuerURL: "https://example-oidc.s3.us-east-1.amazonaws.com/other",
+				},
+			},
+			expectError: true,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			hcVal := &hostedClusterValidator{}
+			_, err := hcVal.ValidateUpdate(context.Background(), testCase.oldHC, testCase.newHC)
+			if (err != nil) != testCase.expectError {
+				t.Errorf("expectError: %v, got: %v", testCase.expectError, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
@@ -77,6 +78,18 @@ func (p AWS) CAPIProviderDeploymentSpec(hcluster *hyperv1.HostedCluster, hcp *hy
 	if override, ok := hcluster.Annotations[hyperv1.ClusterAPIProviderAWSImage]; ok {
 		providerImage = override
 	}
+	providerArgs := []string{"--namespace", "$(MY_NAMESPACE)",
+		"--v=4",
+		"--leader-elect=true",
+		"--feature-gates=EKS=false",
+	}
+	if hcluster.Spec.Platform.AWS != nil && len(hcluster.Spec.Platform.AWS.ServiceEndpoints) > 0 {
+		serviceEndpoints := make([]string, 0, len(hcluster.Spec.Platform.AWS.ServiceEndpoints))
+		for _, serviceEndpoint := range hcluster.Spec.Platform.AWS.ServiceEndpoints {
+			serviceEndpoints = append(serviceEndpoints, fmt.Sprintf("%s=%s", serviceEndpoint.Name, serviceEndpoint.URL))
+		}
+		providerArgs = append(providerArgs, fmt.Sprintf("--service-endpoints=%s:%s", hcluster.Spec.Platform.AWS.Region, strings.Join(serviceEndpoints, ",")))
+	}
 	defaultMode := int32(0640)
 	deploymentSpec := &appsv1.DeploymentSpec{
 		Template: corev1.PodTemplateSpec{
@@ -168,11 +181,7 @@ func (p AWS) CAPIProviderDeploymentSpec(hcluster *hyperv1.HostedCluster, hcp *hy
 								Value: "true",
 							},
 						},
-						Args: []string{"--namespace", "$(MY_NAMESPACE)",
-							"--v=4",
-							"--leader-elect=true",
-							"--feature-gates=EKS=false",
-						},
+						Args: providerArgs,
 						Ports: []corev1.ContainerPort{
 							{
 								Name:          "healthz",
@@ -334,6 +343,7 @@ func reconcileAWSCluster(awsCluster *capiaws.AWSCluster, hcluster *hyperv1.Hoste
 	awsCluster.Spec.AdditionalTags = nil
 	if hcluster.Spec.Platform.AWS != nil {
 		awsCluster.Spec.Region = hcluster.Spec.Platform.AWS.Region
+		awsCluster.Spec.Partition = hcluster.Spec.Platform.AWS.Partition
 
 		if hcluster.Spec.Platform.AWS.CloudProviderConfig != nil {
 			awsCluster.Spec.NetworkSpec.VPC.ID = hcluster.Spec.Platform.AWS.CloudProviderConfig.VPC
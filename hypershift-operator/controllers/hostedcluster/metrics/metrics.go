@@ -8,6 +8,7 @@ import (
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	platformaws "github.com/openshift/hypershift/hypershift-operator/controllers/hostedcluster/internal/platform/aws"
 	"github.com/openshift/hypershift/support/conditions"
+	hyperutil "github.com/openshift/hypershift/support/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -56,6 +57,9 @@ const (
 	SilenceAlertsMetricName = "hypershift_cluster_silence_alerts"
 	silenceAlertsMetricHelp = "Indicates if the given HostedCluster is silenced or not"
 
+	PausedMetricName = "hypershift_cluster_paused"
+	pausedMetricHelp = "Indicates if reconciliation of the given HostedCluster is currently paused or not"
+
 	ProxyMetricName = "hypershift_cluster_proxy"
 	proxyMetricHelp = "Indicates if the given HostedCluster is available through a proxy or not"
 
@@ -133,6 +137,10 @@ var (
 		SilenceAlertsMetricName, silenceAlertsMetricHelp,
 		hclusterLabels, nil)
 
+	pausedMetricDesc = prometheus.NewDesc(
+		PausedMetricName, pausedMetricHelp,
+		hclusterLabels, nil)
+
 	proxyMetricDesc = prometheus.NewDesc(
 		ProxyMetricName, proxyMetricHelp,
 		append(hclusterLabels, "proxy_http", "proxy_https", "proxy_trusted_ca"), nil)
@@ -365,6 +373,21 @@ func (c *hostedClustersMetricsCollector) Collect(ch chan<- prometheus.Metric) {
 				)
 			}
 
+			// pausedMetric
+			{
+				pausedValue := 0.0
+				if isPaused, _ := hyperutil.IsReconciliationPaused(log, hcluster.Spec.PausedUntil); isPaused {
+					pausedValue = 1.0
+				}
+
+				ch <- prometheus.MustNewConstMetric(
+					pausedMetricDesc,
+					prometheus.GaugeValue,
+					pausedValue,
+					hclusterLabelValues...,
+				)
+			}
+
 			// proxyMetric
 			{
 				var proxyHTTP, proxyHTTPS, proxyTrustedCA string
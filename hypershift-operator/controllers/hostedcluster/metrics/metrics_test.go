@@ -452,6 +452,63 @@ func TestReportSilenceAlerts(t *testing.T) {
 	}
 }
 
+func TestReportPaused(t *testing.T) {
+	wrapExpectedValueAsMetric := func(expectedValue float64) *dto.MetricFamily {
+		return createMetricValue(
+			PausedMetricName,
+			pausedMetricHelp,
+			expectedValue)
+	}
+
+	testCases := []struct {
+		name        string
+		pausedUntil *string
+		expected    *dto.MetricFamily
+	}{
+		{
+			name:        "When pausedUntil is true, metric is reported as one",
+			pausedUntil: pointer.String("true"),
+			expected:    wrapExpectedValueAsMetric(1),
+		},
+		{
+			name:        "When pausedUntil is a date in the future, metric is reported as one",
+			pausedUntil: pointer.String(time.Now().Add(time.Hour).Format(time.RFC3339)),
+			expected:    wrapExpectedValueAsMetric(1),
+		},
+		{
+			name:        "When pausedUntil is a date in the past, metric is reported as zero",
+			pausedUntil: pointer.String(time.Now().Add(-time.Hour).Format(time.RFC3339)),
+			expected:    wrapExpectedValueAsMetric(0),
+		},
+		{
+			name:     "When pausedUntil is not set, metric is reported as zero",
+			expected: wrapExpectedValueAsMetric(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hcluster := &hyperv1.HostedCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "hc",
+					Namespace:         "any",
+					CreationTimestamp: metav1.Time{Time: time.Time{}.Add(time.Hour)},
+				},
+				Spec: hyperv1.HostedClusterSpec{
+					ClusterID:   "id",
+					PausedUntil: tc.pausedUntil,
+				},
+			}
+
+			checkMetric(t,
+				fake.NewClientBuilder().WithScheme(api.Scheme).WithObjects(hcluster).Build(),
+				clock.RealClock{},
+				PausedMetricName,
+				tc.expected)
+		})
+	}
+}
+
 func TestReportProxy(t *testing.T) {
 	wrapExpectedValueAsMetric := func(expectedValue float64) *dto.MetricFamily {
 		var labelValue string
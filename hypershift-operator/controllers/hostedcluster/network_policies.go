@@ -27,7 +27,7 @@ const (
 )
 
 func (r *HostedClusterReconciler) reconcileNetworkPolicies(ctx context.Context, createOrUpdate upsert.CreateOrUpdateFN, hcluster *hyperv1.HostedCluster, hcp *hyperv1.HostedControlPlane, version semver.Version, controlPlaneOperatorAppliesManagementKASNetworkPolicyLabel bool) error {
-	controlPlaneNamespaceName := manifests.HostedControlPlaneNamespace(hcluster.Namespace, hcluster.Name)
+	controlPlaneNamespaceName := manifests.HostedControlPlaneNamespaceFor(hcluster)
 
 	// Reconcile openshift-ingress Network Policy
 	policy := networkpolicy.OpenshiftIngressNetworkPolicy(controlPlaneNamespaceName)
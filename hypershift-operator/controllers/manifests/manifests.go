@@ -7,6 +7,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	mcfgv1 "github.com/openshift/hypershift/thirdparty/machineconfigoperator/pkg/apis/machineconfiguration.openshift.io/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -25,6 +26,26 @@ func HostedControlPlaneNamespace(hostedClusterNamespace, hostedClusterName strin
 	return fmt.Sprintf("%s-%s", hostedClusterNamespace, strings.ReplaceAll(hostedClusterName, ".", "-"))
 }
 
+// HostedControlPlaneNamespaceFor returns the control plane namespace for the
+// given HostedCluster. The name is derived from the cluster's namespace and
+// name unless the control-plane-namespace annotation overrides it.
+func HostedControlPlaneNamespaceFor(hc *hyperv1.HostedCluster) string {
+	if override, exists := hc.Annotations[hyperv1.ControlPlaneNamespaceAnnotation]; exists && override != "" {
+		return override
+	}
+	return HostedControlPlaneNamespace(hc.Namespace, hc.Name)
+}
+
+// HostedControlPlaneNamespaceObjectFor is the namespace object for
+// HostedControlPlaneNamespaceFor.
+func HostedControlPlaneNamespaceObjectFor(hc *hyperv1.HostedCluster) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: HostedControlPlaneNamespaceFor(hc),
+		},
+	}
+}
+
 func KubeConfigSecret(hostedClusterNamespace string, hostedClusterName string) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -51,6 +72,46 @@ func MachineConfigAPIServerHAProxy() *mcfgv1.MachineConfig {
 	}
 }
 
+func MachineConfigHugePages() *mcfgv1.MachineConfig {
+	return &mcfgv1.MachineConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "20-hugepages",
+		},
+	}
+}
+
+func MachineConfigDataVolumes() *mcfgv1.MachineConfig {
+	return &mcfgv1.MachineConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "20-data-volumes",
+		},
+	}
+}
+
+func MachineConfigKernelArguments() *mcfgv1.MachineConfig {
+	return &mcfgv1.MachineConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "20-kernel-arguments",
+		},
+	}
+}
+
+func KubeletConfigPIDLimits() *mcfgv1.KubeletConfig {
+	return &mcfgv1.KubeletConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pid-limits",
+		},
+	}
+}
+
+func ContainerRuntimeConfigPIDLimits() *mcfgv1.ContainerRuntimeConfig {
+	return &mcfgv1.ContainerRuntimeConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pid-limits",
+		},
+	}
+}
+
 func OperatorDeployment(ns string) *appsv1.Deployment {
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
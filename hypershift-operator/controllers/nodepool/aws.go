@@ -2,6 +2,7 @@ package nodepool
 
 import (
 	"fmt"
+	"strings"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	k8sutilspointer "k8s.io/utils/pointer"
@@ -20,6 +21,42 @@ func awsClusterCloudProviderTagKey(id string) string {
 	return fmt.Sprintf("kubernetes.io/cluster/%s", id)
 }
 
+// validateAWSResourceTags rejects tags that AWS would refuse or that would
+// silently shadow each other when the list is collapsed into a map.
+func validateAWSResourceTags(resourceTags []hyperv1.AWSResourceTag) error {
+	keys := make(map[string]bool, len(resourceTags))
+	for _, tag := range resourceTags {
+		if strings.HasPrefix(tag.Key, "aws:") {
+			return fmt.Errorf("invalid resource tag key %q: the aws: prefix is reserved for AWS internal tags", tag.Key)
+		}
+		if keys[tag.Key] {
+			return fmt.Errorf("duplicate resource tag key %q", tag.Key)
+		}
+		keys[tag.Key] = true
+	}
+	return nil
+}
+
+// validateAWSTenancy rejects tenancy combinations EC2 would refuse: a
+// dedicated host ID is only meaningful with host tenancy and must look like a
+// host ID.
+func validateAWSTenancy(platform *hyperv1.AWSNodePoolPlatform) error {
+	if platform.DedicatedHostID != "" {
+		if platform.Tenancy != "host" {
+			return fmt.Errorf("dedicatedHostID is only valid with host tenancy, got tenancy %q", platform.Tenancy)
+		}
+		if !strings.HasPrefix(platform.DedicatedHostID, "h-") {
+			return fmt.Errorf("invalid dedicated host ID %q: must start with h-", platform.DedicatedHostID)
+		}
+	}
+	switch platform.Tenancy {
+	case "", "default", "dedicated", "host":
+	default:
+		return fmt.Errorf("invalid tenancy %q, must be default, dedicated or host", platform.Tenancy)
+	}
+	return nil
+}
+
 func awsMachineTemplateSpec(infraName, ami string, hostedCluster *hyperv1.HostedCluster, nodePool *hyperv1.NodePool, defaultSG bool) (*capiaws.AWSMachineTemplateSpec, error) {
 
 	subnet := &capiaws.AWSResourceReference{}
@@ -33,14 +70,15 @@ func awsMachineTemplateSpec(infraName, ami string, hostedCluster *hyperv1.Hosted
 			subnet.Filters = append(subnet.Filters, filter)
 		}
 	}
+	// Default the root volume type so pools created directly through the API
+	// get gp3 like CLI-created pools do, rather than the EC2 account default.
 	rootVolume := &capiaws.Volume{
 		Size: EC2VolumeDefaultSize,
+		Type: capiaws.VolumeType(EC2VolumeDefaultType),
 	}
 	if nodePool.Spec.Platform.AWS.RootVolume != nil {
 		if nodePool.Spec.Platform.AWS.RootVolume.Type != "" {
 			rootVolume.Type = capiaws.VolumeType(nodePool.Spec.Platform.AWS.RootVolume.Type)
-		} else {
-			rootVolume.Type = capiaws.VolumeType(EC2VolumeDefaultType)
 		}
 		if nodePool.Spec.Platform.AWS.RootVolume.Size > 0 {
 			rootVolume.Size = nodePool.Spec.Platform.AWS.RootVolume.Size
@@ -53,6 +91,27 @@ func awsMachineTemplateSpec(infraName, ami string, hostedCluster *hyperv1.Hosted
 		rootVolume.EncryptionKey = nodePool.Spec.Platform.AWS.RootVolume.EncryptionKey
 	}
 
+	// Data volumes get deterministic device names so the ignition config
+	// rendered by dataVolumesMachineConfig can format and mount them by index.
+	var nonRootVolumes []capiaws.Volume
+	for i, dataVolume := range nodePool.Spec.Platform.AWS.DataVolumes {
+		volume := capiaws.Volume{
+			DeviceName:    dataVolumeDeviceName(i),
+			Size:          dataVolume.Size,
+			Type:          capiaws.VolumeType(dataVolume.Type),
+			IOPS:          dataVolume.IOPS,
+			Encrypted:     dataVolume.Encrypted,
+			EncryptionKey: dataVolume.EncryptionKey,
+		}
+		if volume.Size == 0 {
+			volume.Size = EC2VolumeDefaultSize
+		}
+		if volume.Type == "" {
+			volume.Type = capiaws.VolumeType(EC2VolumeDefaultType)
+		}
+		nonRootVolumes = append(nonRootVolumes, volume)
+	}
+
 	securityGroups := []capiaws.AWSResourceReference{}
 	for _, sg := range nodePool.Spec.Platform.AWS.SecurityGroups {
 		filters := []capiaws.Filter{}
@@ -84,8 +143,18 @@ func awsMachineTemplateSpec(infraName, ami string, hostedCluster *hyperv1.Hosted
 
 	instanceType := nodePool.Spec.Platform.AWS.InstanceType
 
+	if err := validateAWSResourceTags(nodePool.Spec.Platform.AWS.ResourceTags); err != nil {
+		return nil, err
+	}
+
+	if err := validateAWSTenancy(nodePool.Spec.Platform.AWS); err != nil {
+		return nil, err
+	}
+
+	// NodePool tags are merged over the cluster-wide ones so a pool can carry
+	// its own cost-center or environment tags.
 	tags := capiaws.Tags{}
-	for _, tag := range append(nodePool.Spec.Platform.AWS.ResourceTags, hostedCluster.Spec.Platform.AWS.ResourceTags...) {
+	for _, tag := range append(hostedCluster.Spec.Platform.AWS.ResourceTags, nodePool.Spec.Platform.AWS.ResourceTags...) {
 		tags[tag.Key] = tag.Value
 	}
 
@@ -112,7 +181,10 @@ func awsMachineTemplateSpec(infraName, ami string, hostedCluster *hyperv1.Hosted
 				AdditionalSecurityGroups: securityGroups,
 				Subnet:                   subnet,
 				RootVolume:               rootVolume,
+				NonRootVolumes:           nonRootVolumes,
 				AdditionalTags:           tags,
+				PlacementGroupName:       nodePool.Spec.Platform.AWS.PlacementGroup,
+				Tenancy:                  nodePool.Spec.Platform.AWS.Tenancy,
 			},
 		},
 	}
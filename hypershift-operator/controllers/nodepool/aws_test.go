@@ -54,6 +54,17 @@ func TestAWSMachineTemplate(t *testing.T) {
 
 			expected: defaultAWSMachineTemplate(withRootVolume(&volume)),
 		},
+		{
+			name: "root volume type defaults to gp3 when unspecified",
+			nodePool: hyperv1.NodePoolSpec{Platform: hyperv1.NodePoolPlatform{AWS: &hyperv1.AWSNodePoolPlatform{
+				RootVolume:     &hyperv1.Volume{Size: 120},
+				SecurityGroups: defaultSG,
+			}}},
+
+			expected: defaultAWSMachineTemplate(func(tmpl *capiaws.AWSMachineTemplate) {
+				tmpl.Spec.Template.Spec.RootVolume = &capiaws.Volume{Size: 120, Type: capiaws.VolumeType(EC2VolumeDefaultType)}
+			}),
+		},
 		{
 			name: "Tags from nodepool get copied",
 			nodePool: hyperv1.NodePoolSpec{Platform: hyperv1.NodePoolPlatform{AWS: &hyperv1.AWSNodePoolPlatform{
@@ -81,7 +92,7 @@ func TestAWSMachineTemplate(t *testing.T) {
 			}),
 		},
 		{
-			name: "Cluster tags take precedence over nodepool tags",
+			name: "NodePool tags take precedence over cluster tags",
 			cluster: hyperv1.HostedClusterSpec{Platform: hyperv1.PlatformSpec{AWS: &hyperv1.AWSPlatformSpec{
 				ResourceTags: []hyperv1.AWSResourceTag{
 					{Key: "cluster-only", Value: "value"},
@@ -98,10 +109,39 @@ func TestAWSMachineTemplate(t *testing.T) {
 
 			expected: defaultAWSMachineTemplate(func(tmpl *capiaws.AWSMachineTemplate) {
 				tmpl.Spec.Template.Spec.AdditionalTags["cluster-only"] = "value"
-				tmpl.Spec.Template.Spec.AdditionalTags["cluster-and-nodepool"] = "cluster"
+				tmpl.Spec.Template.Spec.AdditionalTags["cluster-and-nodepool"] = "nodepool"
 				tmpl.Spec.Template.Spec.AdditionalTags["nodepool-only"] = "value"
 			}),
 		},
+		{
+			name: "Reserved tag key is rejected",
+			nodePool: hyperv1.NodePoolSpec{Platform: hyperv1.NodePoolPlatform{AWS: &hyperv1.AWSNodePoolPlatform{
+				SecurityGroups: defaultSG,
+				ResourceTags: []hyperv1.AWSResourceTag{
+					{Key: "aws:cloudformation:stack-name", Value: "value"},
+				},
+			}}},
+			checkError: func(t *testing.T, err error) {
+				if err == nil {
+					t.Errorf("expected an error for a reserved tag key")
+				}
+			},
+		},
+		{
+			name: "Duplicate tag key is rejected",
+			nodePool: hyperv1.NodePoolSpec{Platform: hyperv1.NodePoolPlatform{AWS: &hyperv1.AWSNodePoolPlatform{
+				SecurityGroups: defaultSG,
+				ResourceTags: []hyperv1.AWSResourceTag{
+					{Key: "cost-center", Value: "a"},
+					{Key: "cost-center", Value: "b"},
+				},
+			}}},
+			checkError: func(t *testing.T, err error) {
+				if err == nil {
+					t.Errorf("expected an error for a duplicate tag key")
+				}
+			},
+		},
 		{
 			name:          "Cluster default sg is used when none specified",
 			clusterStatus: hyperv1.HostedClusterStatus{Platform: &hyperv1.PlatformStatus{AWS: &hyperv1.AWSPlatformStatus{DefaultWorkerSecurityGroupID: "cluster-default"}}},
@@ -184,7 +224,7 @@ func defaultAWSMachineTemplate(modify ...func(*capiaws.AWSMachineTemplate)) *cap
 						InsecureSkipSecretsManager: true,
 						SecureSecretsBackend:       "secrets-manager",
 					},
-					RootVolume: &capiaws.Volume{Size: 16},
+					RootVolume: &capiaws.Volume{Size: EC2VolumeDefaultSize, Type: capiaws.VolumeType(EC2VolumeDefaultType)},
 				},
 			},
 		},
@@ -0,0 +1,124 @@
+package nodepool
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	api "github.com/openshift/hypershift/api"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/control-plane-operator/controllers/hostedcontrolplane/ignition"
+	"github.com/openshift/hypershift/hypershift-operator/controllers/manifests"
+	mcfgv1 "github.com/openshift/hypershift/thirdparty/machineconfigoperator/pkg/apis/machineconfiguration.openshift.io/v1"
+)
+
+// dataVolumeDeviceName returns the EBS device name under which the i-th data
+// volume of a NodePool is attached, leaving the earlier letters for the root
+// volume and AWS reserved devices.
+func dataVolumeDeviceName(i int) string {
+	return fmt.Sprintf("/dev/xvd%c", 'f'+rune(i))
+}
+
+// dataVolumeFilesystemLabel returns the filesystem label of the i-th data
+// volume. The mount units reference the volumes by label so they are found
+// regardless of how the instance type exposes the block devices.
+func dataVolumeFilesystemLabel(i int) string {
+	return fmt.Sprintf("data-%d", i)
+}
+
+// dataVolumesMachineConfig renders the NodePool's data volumes into a
+// serialized MachineConfig whose ignition payload formats each volume as xfs
+// and mounts it at its mount path through a systemd mount unit.
+func dataVolumesMachineConfig(nodePool *hyperv1.NodePool) (string, error) {
+	machineConfig := manifests.MachineConfigDataVolumes()
+	ignition.SetMachineConfigLabels(machineConfig)
+
+	type ignitionFilesystem struct {
+		Device         string `json:"device"`
+		Format         string `json:"format"`
+		Label          string `json:"label"`
+		WipeFilesystem bool   `json:"wipeFilesystem"`
+	}
+	type systemdUnit struct {
+		Name     string `json:"name"`
+		Enabled  bool   `json:"enabled"`
+		Contents string `json:"contents"`
+	}
+	var filesystems []ignitionFilesystem
+	var units []systemdUnit
+	for i, dataVolume := range nodePool.Spec.Platform.AWS.DataVolumes {
+		label := dataVolumeFilesystemLabel(i)
+		filesystems = append(filesystems, ignitionFilesystem{
+			Device:         dataVolumeDeviceName(i),
+			Format:         "xfs",
+			Label:          label,
+			WipeFilesystem: false,
+		})
+		units = append(units, systemdUnit{
+			Name:    systemdMountUnitName(dataVolume.MountPath),
+			Enabled: true,
+			Contents: fmt.Sprintf(`[Unit]
+Description=Mount data volume at %[2]s
+Before=local-fs.target
+
+[Mount]
+What=/dev/disk/by-label/%[1]s
+Where=%[2]s
+Type=xfs
+
+[Install]
+WantedBy=local-fs.target
+`, label, dataVolume.MountPath),
+		})
+	}
+
+	ignitionConfig := map[string]interface{}{
+		"ignition": map[string]string{"version": "3.2.0"},
+		"storage":  map[string]interface{}{"filesystems": filesystems},
+		"systemd":  map[string]interface{}{"units": units},
+	}
+	rawConfig, err := json.Marshal(ignitionConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal data volumes ignition config: %w", err)
+	}
+	machineConfig.Spec.Config.Raw = rawConfig
+
+	buf := &bytes.Buffer{}
+	machineConfig.APIVersion = mcfgv1.SchemeGroupVersion.String()
+	machineConfig.Kind = "MachineConfig"
+	if err := api.YamlSerializer.Encode(machineConfig, buf); err != nil {
+		return "", fmt.Errorf("failed to serialize data volumes machine config: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// systemdMountUnitName returns the name systemd requires for a mount unit of
+// the given path, i.e. the escaped path with a .mount suffix.
+func systemdMountUnitName(mountPath string) string {
+	escaped := strings.ReplaceAll(strings.Trim(mountPath, "/"), "-", `\x2d`)
+	return strings.ReplaceAll(escaped, "/", "-") + ".mount"
+}
+
+// validateDataVolumes checks the NodePool's data volumes for invalid or
+// colliding mount paths.
+func validateDataVolumes(nodePool *hyperv1.NodePool) error {
+	seen := map[string]bool{}
+	for _, dataVolume := range nodePool.Spec.Platform.AWS.DataVolumes {
+		mountPath := path.Clean(dataVolume.MountPath)
+		if !path.IsAbs(mountPath) || mountPath == "/" {
+			return fmt.Errorf("invalid data volume mount path %q, must be an absolute path other than /", dataVolume.MountPath)
+		}
+		if seen[mountPath] {
+			return fmt.Errorf("duplicate data volume mount path %q", mountPath)
+		}
+		for other := range seen {
+			if strings.HasPrefix(mountPath+"/", other+"/") || strings.HasPrefix(other+"/", mountPath+"/") {
+				return fmt.Errorf("data volume mount path %q collides with %q", mountPath, other)
+			}
+		}
+		seen[mountPath] = true
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package nodepool
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDataVolumesMachineConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	nodePool := &hyperv1.NodePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: hyperv1.NodePoolSpec{
+			Platform: hyperv1.NodePoolPlatform{
+				AWS: &hyperv1.AWSNodePoolPlatform{
+					DataVolumes: []hyperv1.AWSDataVolume{
+						{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "/var/lib/data"},
+						{Volume: hyperv1.Volume{Size: 500, Type: "io1", IOPS: 4000}, MountPath: "/srv/cache"},
+					},
+				},
+			},
+		},
+	}
+	config, err := dataVolumesMachineConfig(nodePool)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config).To(ContainSubstring("name: 20-data-volumes"))
+	g.Expect(config).To(ContainSubstring("machineconfiguration.openshift.io/role: worker"))
+	g.Expect(config).To(ContainSubstring("/dev/xvdf"))
+	g.Expect(config).To(ContainSubstring("/dev/xvdg"))
+	g.Expect(config).To(ContainSubstring("var-lib-data.mount"))
+	g.Expect(config).To(ContainSubstring("srv-cache.mount"))
+	g.Expect(config).To(ContainSubstring("/dev/disk/by-label/data-0"))
+	g.Expect(config).To(ContainSubstring("Where=/var/lib/data"))
+}
+
+func TestSystemdMountUnitName(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(systemdMountUnitName("/var/lib/data")).To(Equal("var-lib-data.mount"))
+	g.Expect(systemdMountUnitName("/srv/my-cache")).To(Equal(`srv-my\x2dcache.mount`))
+}
+
+func TestValidateDataVolumes(t *testing.T) {
+	testCases := []struct {
+		name          string
+		dataVolumes   []hyperv1.AWSDataVolume
+		expectedError string
+	}{
+		{
+			name: "valid volumes",
+			dataVolumes: []hyperv1.AWSDataVolume{
+				{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "/var/lib/data"},
+				{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "/srv/cache"},
+			},
+		},
+		{
+			name: "relative mount path",
+			dataVolumes: []hyperv1.AWSDataVolume{
+				{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "var/lib/data"},
+			},
+			expectedError: "must be an absolute path",
+		},
+		{
+			name: "root mount path",
+			dataVolumes: []hyperv1.AWSDataVolume{
+				{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "/"},
+			},
+			expectedError: "must be an absolute path",
+		},
+		{
+			name: "duplicate mount path",
+			dataVolumes: []hyperv1.AWSDataVolume{
+				{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "/var/lib/data"},
+				{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "/var/lib/data/"},
+			},
+			expectedError: "duplicate data volume mount path",
+		},
+		{
+			name: "nested mount paths",
+			dataVolumes: []hyperv1.AWSDataVolume{
+				{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "/var/lib"},
+				{Volume: hyperv1.Volume{Size: 100, Type: "gp3"}, MountPath: "/var/lib/data"},
+			},
+			expectedError: "collides with",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			nodePool := &hyperv1.NodePool{
+				Spec: hyperv1.NodePoolSpec{
+					Platform: hyperv1.NodePoolPlatform{
+						AWS: &hyperv1.AWSNodePoolPlatform{
+							DataVolumes: tc.dataVolumes,
+						},
+					},
+				},
+			}
+			err := validateDataVolumes(nodePool)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
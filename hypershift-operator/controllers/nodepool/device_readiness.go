@@ -0,0 +1,47 @@
+package nodepool
+
+import (
+	"fmt"
+	"strings"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// validateDeviceReadiness checks that the configured device resource name is a
+// valid extended resource name. Native resources such as cpu or memory are
+// always advertised, so gating on them would be meaningless.
+func validateDeviceReadiness(nodePool *hyperv1.NodePool) error {
+	if nodePool.Spec.DeviceReadiness == nil {
+		return nil
+	}
+	resourceName := nodePool.Spec.DeviceReadiness.ResourceName
+	if errs := validation.IsQualifiedName(resourceName); len(errs) > 0 {
+		return fmt.Errorf("invalid device resource name %q: %s", resourceName, strings.Join(errs, ", "))
+	}
+	domain, _, found := strings.Cut(resourceName, "/")
+	if !found || domain == "kubernetes.io" || strings.HasSuffix(domain, ".kubernetes.io") {
+		return fmt.Errorf("device resource name %q must be an extended resource name such as nvidia.com/gpu", resourceName)
+	}
+	return nil
+}
+
+// aggregateDeviceReadiness counts the Nodes advertising nonzero allocatable
+// capacity for the device resource configured on the NodePool, or returns nil
+// when no device readiness reporting is configured.
+func aggregateDeviceReadiness(nodePool *hyperv1.NodePool, nodes []corev1.Node) *hyperv1.NodePoolDeviceReadinessStatus {
+	if nodePool.Spec.DeviceReadiness == nil {
+		return nil
+	}
+	resourceName := corev1.ResourceName(nodePool.Spec.DeviceReadiness.ResourceName)
+	deviceReadiness := &hyperv1.NodePoolDeviceReadinessStatus{
+		Total: int32(len(nodes)),
+	}
+	for _, node := range nodes {
+		if quantity, ok := node.Status.Allocatable[resourceName]; ok && !quantity.IsZero() {
+			deviceReadiness.Advertising++
+		}
+	}
+	return deviceReadiness
+}
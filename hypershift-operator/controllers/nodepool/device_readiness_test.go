@@ -0,0 +1,112 @@
+package nodepool
+
+import (
+	"testing"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestValidateDeviceReadiness(t *testing.T) {
+	testCases := []struct {
+		name         string
+		resourceName string
+		expectError  bool
+	}{
+		{
+			name:         "When the resource name is a valid extended resource it should pass",
+			resourceName: "nvidia.com/gpu",
+		},
+		{
+			name:         "When the resource name has no domain it should fail",
+			resourceName: "gpu",
+			expectError:  true,
+		},
+		{
+			name:         "When the resource name is a native kubernetes.io resource it should fail",
+			resourceName: "kubernetes.io/cpu",
+			expectError:  true,
+		},
+		{
+			name:         "When the resource name domain is under kubernetes.io it should fail",
+			resourceName: "node.kubernetes.io/memory",
+			expectError:  true,
+		},
+		{
+			name:         "When the resource name is not a qualified name it should fail",
+			resourceName: "nvidia.com/not a name",
+			expectError:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			nodePool := &hyperv1.NodePool{
+				Spec: hyperv1.NodePoolSpec{
+					DeviceReadiness: &hyperv1.DeviceReadinessSpec{
+						ResourceName: tc.resourceName,
+					},
+				},
+			}
+			err := validateDeviceReadiness(nodePool)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestAggregateDeviceReadiness(t *testing.T) {
+	nodeWithAllocatable := func(quantity string) corev1.Node {
+		node := corev1.Node{
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{},
+			},
+		}
+		if quantity != "" {
+			node.Status.Allocatable["nvidia.com/gpu"] = resource.MustParse(quantity)
+		}
+		return node
+	}
+
+	testCases := []struct {
+		name            string
+		deviceReadiness *hyperv1.DeviceReadinessSpec
+		nodes           []corev1.Node
+		expected        *hyperv1.NodePoolDeviceReadinessStatus
+	}{
+		{
+			name:     "When device readiness is not configured it should report nothing",
+			nodes:    []corev1.Node{nodeWithAllocatable("1")},
+			expected: nil,
+		},
+		{
+			name:            "When no Nodes advertise the resource it should report zero advertising",
+			deviceReadiness: &hyperv1.DeviceReadinessSpec{ResourceName: "nvidia.com/gpu"},
+			nodes:           []corev1.Node{nodeWithAllocatable(""), nodeWithAllocatable("0")},
+			expected:        &hyperv1.NodePoolDeviceReadinessStatus{Total: 2},
+		},
+		{
+			name:            "When some Nodes advertise the resource it should count them",
+			deviceReadiness: &hyperv1.DeviceReadinessSpec{ResourceName: "nvidia.com/gpu"},
+			nodes:           []corev1.Node{nodeWithAllocatable("4"), nodeWithAllocatable(""), nodeWithAllocatable("1")},
+			expected:        &hyperv1.NodePoolDeviceReadinessStatus{Total: 3, Advertising: 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			nodePool := &hyperv1.NodePool{
+				Spec: hyperv1.NodePoolSpec{
+					DeviceReadiness: tc.deviceReadiness,
+				},
+			}
+			g.Expect(aggregateDeviceReadiness(nodePool, tc.nodes)).To(Equal(tc.expected))
+		})
+	}
+}
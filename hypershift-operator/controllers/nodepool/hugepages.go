@@ -0,0 +1,89 @@
+package nodepool
+
+import (
+	"bytes"
+	"fmt"
+
+	api "github.com/openshift/hypershift/api"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/control-plane-operator/controllers/hostedcontrolplane/ignition"
+	"github.com/openshift/hypershift/hypershift-operator/controllers/manifests"
+	mcfgv1 "github.com/openshift/hypershift/thirdparty/machineconfigoperator/pkg/apis/machineconfiguration.openshift.io/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// hugePageSizeBytes maps the supported hugepage sizes to their size in bytes.
+var hugePageSizeBytes = map[hyperv1.HugePageSize]int64{
+	hyperv1.HugePageSize2M: 2 * 1024 * 1024,
+	hyperv1.HugePageSize1G: 1024 * 1024 * 1024,
+}
+
+// hugePagesMachineConfig renders the NodePool's hugepage reservations into a
+// serialized MachineConfig carrying the corresponding kernel arguments. The
+// kubelet discovers the reserved pages once the nodes boot with them, so no
+// further kubelet configuration is required.
+func hugePagesMachineConfig(nodePool *hyperv1.NodePool) (string, error) {
+	machineConfig := manifests.MachineConfigHugePages()
+	ignition.SetMachineConfigLabels(machineConfig)
+
+	var kernelArguments []string
+	for _, reservation := range nodePool.Spec.HugePages {
+		kernelArguments = append(kernelArguments,
+			fmt.Sprintf("hugepagesz=%s", reservation.Size),
+			fmt.Sprintf("hugepages=%d", reservation.Count),
+		)
+	}
+	machineConfig.Spec.KernelArguments = kernelArguments
+	// The MCO requires a parseable ignition payload even when the
+	// MachineConfig only carries kernel arguments.
+	machineConfig.Spec.Config.Raw = []byte(`{"ignition":{"version":"3.2.0"}}`)
+
+	buf := &bytes.Buffer{}
+	machineConfig.APIVersion = mcfgv1.SchemeGroupVersion.String()
+	machineConfig.Kind = "MachineConfig"
+	if err := api.YamlSerializer.Encode(machineConfig, buf); err != nil {
+		return "", fmt.Errorf("failed to serialize hugepages machine config: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// validateHugePages checks the NodePool's hugepage reservations for duplicate
+// sizes and, on platforms which declare the node memory, that the reserved
+// pages fit into the instance memory.
+func validateHugePages(nodePool *hyperv1.NodePool) error {
+	if len(nodePool.Spec.HugePages) == 0 {
+		return nil
+	}
+
+	var totalBytes int64
+	seen := map[hyperv1.HugePageSize]bool{}
+	for _, reservation := range nodePool.Spec.HugePages {
+		sizeBytes, known := hugePageSizeBytes[reservation.Size]
+		if !known {
+			return fmt.Errorf("invalid hugepage size %q, must be one of 2M or 1G", reservation.Size)
+		}
+		if seen[reservation.Size] {
+			return fmt.Errorf("duplicate hugepage reservation for size %s", reservation.Size)
+		}
+		seen[reservation.Size] = true
+		if reservation.Count < 1 {
+			return fmt.Errorf("hugepage count for size %s must be at least 1", reservation.Size)
+		}
+		totalBytes += sizeBytes * reservation.Count
+	}
+
+	if nodeMemory := nodePoolMemory(nodePool); nodeMemory != nil && totalBytes >= nodeMemory.Value() {
+		return fmt.Errorf("hugepage reservations total %s which does not fit into the node memory of %s",
+			resource.NewQuantity(totalBytes, resource.BinarySI), nodeMemory)
+	}
+	return nil
+}
+
+// nodePoolMemory returns the memory available on each node of the NodePool,
+// or nil when the platform does not declare it.
+func nodePoolMemory(nodePool *hyperv1.NodePool) *resource.Quantity {
+	if nodePool.Spec.Platform.Kubevirt != nil && nodePool.Spec.Platform.Kubevirt.Compute != nil {
+		return nodePool.Spec.Platform.Kubevirt.Compute.Memory
+	}
+	return nil
+}
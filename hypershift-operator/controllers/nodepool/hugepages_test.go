@@ -0,0 +1,130 @@
+package nodepool
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHugePagesMachineConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	nodePool := &hyperv1.NodePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: hyperv1.NodePoolSpec{
+			HugePages: []hyperv1.HugePageReservation{
+				{Size: hyperv1.HugePageSize2M, Count: 512},
+				{Size: hyperv1.HugePageSize1G, Count: 2},
+			},
+		},
+	}
+	config, err := hugePagesMachineConfig(nodePool)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config).To(ContainSubstring("name: 20-hugepages"))
+	g.Expect(config).To(ContainSubstring("machineconfiguration.openshift.io/role: worker"))
+	for _, arg := range []string{"hugepagesz=2M", "hugepages=512", "hugepagesz=1G", "hugepages=2"} {
+		g.Expect(config).To(ContainSubstring(arg))
+	}
+	// Kernel arguments must preserve the user's order so each count follows
+	// its size.
+	g.Expect(strings.Index(config, "hugepagesz=2M")).To(BeNumerically("<", strings.Index(config, "hugepagesz=1G")))
+}
+
+func TestValidateHugePages(t *testing.T) {
+	testCases := []struct {
+		name          string
+		hugePages     []hyperv1.HugePageReservation
+		platform      hyperv1.NodePoolPlatform
+		expectedError string
+	}{
+		{
+			name: "valid reservations",
+			hugePages: []hyperv1.HugePageReservation{
+				{Size: hyperv1.HugePageSize2M, Count: 512},
+				{Size: hyperv1.HugePageSize1G, Count: 2},
+			},
+		},
+		{
+			name:      "no reservations",
+			hugePages: nil,
+		},
+		{
+			name: "unknown size",
+			hugePages: []hyperv1.HugePageReservation{
+				{Size: "4M", Count: 1},
+			},
+			expectedError: "invalid hugepage size",
+		},
+		{
+			name: "duplicate size",
+			hugePages: []hyperv1.HugePageReservation{
+				{Size: hyperv1.HugePageSize2M, Count: 1},
+				{Size: hyperv1.HugePageSize2M, Count: 2},
+			},
+			expectedError: "duplicate hugepage reservation",
+		},
+		{
+			name: "count below one",
+			hugePages: []hyperv1.HugePageReservation{
+				{Size: hyperv1.HugePageSize2M, Count: 0},
+			},
+			expectedError: "must be at least 1",
+		},
+		{
+			name: "reservation exceeds kubevirt node memory",
+			hugePages: []hyperv1.HugePageReservation{
+				{Size: hyperv1.HugePageSize1G, Count: 8},
+			},
+			platform: hyperv1.NodePoolPlatform{
+				Kubevirt: &hyperv1.KubevirtNodePoolPlatform{
+					Compute: &hyperv1.KubevirtCompute{
+						Memory: resourcePtr("4Gi"),
+					},
+				},
+			},
+			expectedError: "does not fit into the node memory",
+		},
+		{
+			name: "reservation fits into kubevirt node memory",
+			hugePages: []hyperv1.HugePageReservation{
+				{Size: hyperv1.HugePageSize1G, Count: 2},
+			},
+			platform: hyperv1.NodePoolPlatform{
+				Kubevirt: &hyperv1.KubevirtNodePoolPlatform{
+					Compute: &hyperv1.KubevirtCompute{
+						Memory: resourcePtr("8Gi"),
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			nodePool := &hyperv1.NodePool{
+				Spec: hyperv1.NodePoolSpec{
+					HugePages: tc.hugePages,
+					Platform:  tc.platform,
+				},
+			}
+			err := validateHugePages(nodePool)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func resourcePtr(value string) *resource.Quantity {
+	quantity := resource.MustParse(value)
+	return &quantity
+}
@@ -106,7 +106,19 @@ func (r *NodePoolReconciler) reconcileMachineSet(ctx context.Context,
 	}
 	machineSet.Spec.Template.Annotations[nodePoolAnnotationTaints] = taintsInJSON
 
+	startupTaintsInJSON, err := taintsToJSON(nodePool.Spec.StartupTaints)
+	if err != nil {
+		return err
+	}
+	machineSet.Spec.Template.Annotations[nodePoolAnnotationStartupTaints] = startupTaintsInJSON
+
+	currentReplicas := k8sutilspointer.Int32Deref(machineSet.Spec.Replicas, 0)
 	setMachineSetReplicas(nodePool, machineSet)
+	// Cap scale ups to what the fleet-wide scale up limiter admits.
+	desiredReplicas := k8sutilspointer.Int32Deref(machineSet.Spec.Replicas, 0)
+	if cappedReplicas := r.throttleScaleUp(log, nodePool, currentReplicas, desiredReplicas); cappedReplicas != desiredReplicas {
+		machineSet.Spec.Replicas = k8sutilspointer.Int32(cappedReplicas)
+	}
 
 	isUpdating := false
 	// Propagate version and userData Secret to the MachineSet.
@@ -0,0 +1,72 @@
+package nodepool
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	api "github.com/openshift/hypershift/api"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/control-plane-operator/controllers/hostedcontrolplane/ignition"
+	"github.com/openshift/hypershift/hypershift-operator/controllers/manifests"
+	mcfgv1 "github.com/openshift/hypershift/thirdparty/machineconfigoperator/pkg/apis/machineconfiguration.openshift.io/v1"
+)
+
+// kernelArgumentRegexp matches a single kernel argument: a parameter name
+// optionally followed by a value, with no whitespace or shell metacharacters.
+var kernelArgumentRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+(=[A-Za-z0-9.,:=_/-]*)?$`)
+
+// deniedKernelArguments are parameters which change the boot or init flow and
+// would leave the nodes unable to join the cluster, or which weaken the node
+// security posture. They are rejected rather than rendered.
+var deniedKernelArguments = map[string]bool{
+	"init":                 true,
+	"root":                 true,
+	"rd.break":             true,
+	"single":               true,
+	"emergency":            true,
+	"rescue":               true,
+	"systemd.unit":         true,
+	"selinux":              true,
+	"enforcing":            true,
+	"initrd":               true,
+	"boot":                 true,
+	"ostree":               true,
+	"ignition.platform.id": true,
+}
+
+// validateKernelArguments checks the NodePool's kernel arguments for
+// malformed or denied parameters.
+func validateKernelArguments(nodePool *hyperv1.NodePool) error {
+	for _, argument := range nodePool.Spec.KernelArguments {
+		if !kernelArgumentRegexp.MatchString(argument) {
+			return fmt.Errorf("invalid kernel argument %q: must be a parameter name optionally followed by =value, without whitespace", argument)
+		}
+		name, _, _ := strings.Cut(argument, "=")
+		if deniedKernelArguments[name] {
+			return fmt.Errorf("kernel argument %q is not allowed: it would change the boot flow or security posture of the node", name)
+		}
+	}
+	return nil
+}
+
+// kernelArgumentsMachineConfig renders the NodePool's kernel arguments into a
+// serialized MachineConfig. The MCO reboots the nodes when the rendered
+// arguments change.
+func kernelArgumentsMachineConfig(nodePool *hyperv1.NodePool) (string, error) {
+	machineConfig := manifests.MachineConfigKernelArguments()
+	ignition.SetMachineConfigLabels(machineConfig)
+	machineConfig.Spec.KernelArguments = nodePool.Spec.KernelArguments
+	// The MCO requires a parseable ignition payload even when the
+	// MachineConfig only carries kernel arguments.
+	machineConfig.Spec.Config.Raw = []byte(`{"ignition":{"version":"3.2.0"}}`)
+
+	buf := &bytes.Buffer{}
+	machineConfig.APIVersion = mcfgv1.SchemeGroupVersion.String()
+	machineConfig.Kind = "MachineConfig"
+	if err := api.YamlSerializer.Encode(machineConfig, buf); err != nil {
+		return "", fmt.Errorf("failed to serialize kernel arguments machine config: %w", err)
+	}
+	return buf.String(), nil
+}
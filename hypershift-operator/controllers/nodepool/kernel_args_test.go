@@ -0,0 +1,84 @@
+package nodepool
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKernelArgumentsMachineConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	nodePool := &hyperv1.NodePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: hyperv1.NodePoolSpec{
+			KernelArguments: []string{"intel_iommu=on", "isolcpus=2-5"},
+		},
+	}
+	config, err := kernelArgumentsMachineConfig(nodePool)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config).To(ContainSubstring("name: 20-kernel-arguments"))
+	g.Expect(config).To(ContainSubstring("machineconfiguration.openshift.io/role: worker"))
+	g.Expect(config).To(ContainSubstring("intel_iommu=on"))
+	g.Expect(config).To(ContainSubstring("isolcpus=2-5"))
+}
+
+func TestValidateKernelArguments(t *testing.T) {
+	testCases := []struct {
+		name            string
+		kernelArguments []string
+		expectedError   string
+	}{
+		{
+			name:            "valid arguments",
+			kernelArguments: []string{"intel_iommu=on", "iommu=pt", "isolcpus=2-5", "nosmt"},
+		},
+		{
+			name:            "no arguments",
+			kernelArguments: nil,
+		},
+		{
+			name:            "whitespace in argument",
+			kernelArguments: []string{"intel_iommu=on foo"},
+			expectedError:   "invalid kernel argument",
+		},
+		{
+			name:            "shell metacharacter",
+			kernelArguments: []string{"isolcpus=$(reboot)"},
+			expectedError:   "invalid kernel argument",
+		},
+		{
+			name:            "denied init argument",
+			kernelArguments: []string{"init=/bin/sh"},
+			expectedError:   "not allowed",
+		},
+		{
+			name:            "denied rd.break argument",
+			kernelArguments: []string{"rd.break"},
+			expectedError:   "not allowed",
+		},
+		{
+			name:            "denied selinux argument",
+			kernelArguments: []string{"selinux=0"},
+			expectedError:   "not allowed",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			nodePool := &hyperv1.NodePool{
+				Spec: hyperv1.NodePoolSpec{KernelArguments: tc.kernelArguments},
+			}
+			err := validateKernelArguments(nodePool)
+			if tc.expectedError == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedError)))
+			}
+		})
+	}
+}
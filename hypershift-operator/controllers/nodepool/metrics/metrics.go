@@ -157,12 +157,13 @@ func (c *nodePoolsMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 }
 
 type hclusterData struct {
-	id             string
-	namespace      string
-	name           string
-	platform       hyperv1.PlatformType
-	nodePoolsCount int
-	coresCount     int32
+	id                    string
+	namespace             string
+	name                  string
+	controlPlaneNamespace string
+	platform              hyperv1.PlatformType
+	nodePoolsCount        int
+	coresCount            int32
 }
 
 func createFailureConditionToNodePoolsCountMap() *map[string]int {
@@ -258,10 +259,11 @@ func (c *nodePoolsMetricsCollector) Collect(ch chan<- prometheus.Metric) {
 			hcluster := &hclusters.Items[k]
 
 			hclusterPathToData[hcluster.Namespace+"/"+hcluster.Name] = &hclusterData{
-				id:        hcluster.Spec.ClusterID,
-				namespace: hcluster.Namespace,
-				name:      hcluster.Name,
-				platform:  hcluster.Spec.Platform.Type,
+				id:                    hcluster.Spec.ClusterID,
+				namespace:             hcluster.Namespace,
+				name:                  hcluster.Name,
+				controlPlaneNamespace: manifests.HostedControlPlaneNamespaceFor(hcluster),
+				platform:              hcluster.Spec.Platform.Type,
 			}
 		}
 	}
@@ -414,7 +416,12 @@ func (c *nodePoolsMetricsCollector) Collect(ch chan<- prometheus.Metric) {
 				}
 
 				if pathToReplicasCount != nil {
+					// The annotation-driven control plane namespace override is
+					// recorded per cluster, so look it up rather than deriving it.
 					hcpNs := manifests.HostedControlPlaneNamespace(nodePool.Namespace, nodePool.Spec.ClusterName)
+					if hclusterData := hclusterPathToData[nodePool.Namespace+"/"+nodePool.Spec.ClusterName]; hclusterData != nil {
+						hcpNs = hclusterData.controlPlaneNamespace
+					}
 					wishedReplicas := float64((*pathToReplicasCount)[hcpNs+"/"+nodePool.Name])
 
 					ch <- prometheus.MustNewConstMetric(
@@ -0,0 +1,97 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileNodeHealthStatus aggregates the guest cluster Node conditions for
+// the pool into nodePool.Status.NodeHealth. The aggregation is computed from a
+// single List of Nodes labelled with the NodePool name, so the query cost is
+// bounded to one API call per NodePool reconcile regardless of the pool size.
+func (r *NodePoolReconciler) reconcileNodeHealthStatus(ctx context.Context, hcluster *hyperv1.HostedCluster, nodePool *hyperv1.NodePool) error {
+	if hcluster.Status.KubeConfig == nil {
+		// The guest cluster API is not available yet.
+		nodePool.Status.NodeHealth = nil
+		nodePool.Status.DeviceReadiness = nil
+		return nil
+	}
+
+	var kubeconfig corev1.Secret
+	if err := r.Get(ctx, crclient.ObjectKey{Namespace: hcluster.Namespace, Name: hcluster.Status.KubeConfig.Name}, &kubeconfig); err != nil {
+		return fmt.Errorf("failed to get kubeconfig: %w", err)
+	}
+	kubeconfigBytes, found := kubeconfig.Data["kubeconfig"]
+	if !found {
+		return fmt.Errorf("kubeconfig secret %s has no 'kubeconfig' key", crclient.ObjectKeyFromObject(&kubeconfig))
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfigBytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse kubeconfig from secret %s: %w", crclient.ObjectKeyFromObject(&kubeconfig), err)
+	}
+	guestClient, err := crclient.New(restConfig, crclient.Options{})
+	if err != nil {
+		return fmt.Errorf("failed to create guest cluster client: %w", err)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := guestClient.List(ctx, nodeList, crclient.MatchingLabels{hyperv1.NodePoolLabel: nodePool.GetName()}); err != nil {
+		return fmt.Errorf("failed to list Nodes for NodePool: %w", err)
+	}
+
+	nodePool.Status.NodeHealth = aggregateNodeHealth(nodeList.Items)
+	// Device readiness is derived from the same List of Nodes, so reporting it
+	// adds no extra API calls.
+	nodePool.Status.DeviceReadiness = aggregateDeviceReadiness(nodePool, nodeList.Items)
+	return nil
+}
+
+// aggregateNodeHealth summarizes the conditions of the given Nodes into a
+// NodePoolNodeHealthStatus with distinct, sorted reasons.
+func aggregateNodeHealth(nodes []corev1.Node) *hyperv1.NodePoolNodeHealthStatus {
+	nodeHealth := &hyperv1.NodePoolNodeHealthStatus{
+		Total: int32(len(nodes)),
+	}
+
+	reasons := map[string]struct{}{}
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			nodeHealth.Cordoned++
+		}
+
+		ready := false
+		for _, condition := range node.Status.Conditions {
+			switch condition.Type {
+			case corev1.NodeReady:
+				if condition.Status == corev1.ConditionTrue {
+					ready = true
+				} else if condition.Reason != "" {
+					reasons[condition.Reason] = struct{}{}
+				}
+			case corev1.NodeDiskPressure:
+				if condition.Status == corev1.ConditionTrue {
+					nodeHealth.DiskPressure++
+					if condition.Reason != "" {
+						reasons[condition.Reason] = struct{}{}
+					}
+				}
+			}
+		}
+		if !ready {
+			nodeHealth.NotReady++
+		}
+	}
+
+	for reason := range reasons {
+		nodeHealth.Reasons = append(nodeHealth.Reasons, reason)
+	}
+	sort.Strings(nodeHealth.Reasons)
+
+	return nodeHealth
+}
@@ -0,0 +1,142 @@
+package nodepool
+
+import (
+	"testing"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAggregateNodeHealth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nodes    []corev1.Node
+		expected *hyperv1.NodePoolNodeHealthStatus
+	}{
+		{
+			name:     "When there are no Nodes it should report zero totals",
+			nodes:    nil,
+			expected: &hyperv1.NodePoolNodeHealthStatus{},
+		},
+		{
+			name: "When all Nodes are healthy it should report only the total",
+			nodes: []corev1.Node{
+				{
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+						},
+					},
+				},
+				{
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+						},
+					},
+				},
+			},
+			expected: &hyperv1.NodePoolNodeHealthStatus{
+				Total: 2,
+			},
+		},
+		{
+			name: "When a Node is not ready it should report the count and reason",
+			nodes: []corev1.Node{
+				{
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Reason: "KubeletNotReady"},
+						},
+					},
+				},
+			},
+			expected: &hyperv1.NodePoolNodeHealthStatus{
+				Total:    1,
+				NotReady: 1,
+				Reasons:  []string{"KubeletNotReady"},
+			},
+		},
+		{
+			name: "When a Node has no Ready condition it should count as not ready",
+			nodes: []corev1.Node{
+				{},
+			},
+			expected: &hyperv1.NodePoolNodeHealthStatus{
+				Total:    1,
+				NotReady: 1,
+			},
+		},
+		{
+			name: "When a Node is cordoned it should report the count",
+			nodes: []corev1.Node{
+				{
+					Spec: corev1.NodeSpec{
+						Unschedulable: true,
+					},
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+						},
+					},
+				},
+			},
+			expected: &hyperv1.NodePoolNodeHealthStatus{
+				Total:    1,
+				Cordoned: 1,
+			},
+		},
+		{
+			name: "When a Node reports disk pressure it should report the count and reason",
+			nodes: []corev1.Node{
+				{
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+							{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue, Reason: "KubeletHasDiskPressure"},
+						},
+					},
+				},
+			},
+			expected: &hyperv1.NodePoolNodeHealthStatus{
+				Total:        1,
+				DiskPressure: 1,
+				Reasons:      []string{"KubeletHasDiskPressure"},
+			},
+		},
+		{
+			name: "When multiple Nodes are unhealthy it should deduplicate and sort reasons",
+			nodes: []corev1.Node{
+				{
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Reason: "NodeStatusUnknown"},
+						},
+					},
+				},
+				{
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Reason: "NodeStatusUnknown"},
+							{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue, Reason: "KubeletHasDiskPressure"},
+						},
+					},
+				},
+			},
+			expected: &hyperv1.NodePoolNodeHealthStatus{
+				Total:        2,
+				NotReady:     2,
+				DiskPressure: 1,
+				Reasons:      []string{"KubeletHasDiskPressure", "NodeStatusUnknown"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(aggregateNodeHealth(tc.nodes)).To(Equal(tc.expected))
+		})
+	}
+}
@@ -81,16 +81,23 @@ const (
 	nodePoolAnnotationMaxUnavailable         = "hypershift.openshift.io/nodePoolMaxUnavailable"
 
 	nodePoolAnnotationPlatformMachineTemplate = "hypershift.openshift.io/nodePoolPlatformMachineTemplate"
-	nodePoolAnnotationTaints                  = "hypershift.openshift.io/nodePoolTaints"
-	nodePoolCoreIgnitionConfigLabel           = "hypershift.openshift.io/core-ignition-config"
-	TokenSecretTokenGenerationTime            = "hypershift.openshift.io/last-token-generation-time"
-	TokenSecretReleaseKey                     = "release"
-	TokenSecretTokenKey                       = "token"
-	TokenSecretPullSecretHashKey              = "pull-secret-hash"
-	TokenSecretConfigKey                      = "config"
-	TokenSecretAnnotation                     = "hypershift.openshift.io/ignition-config"
-	TokenSecretIgnitionReachedAnnotation      = "hypershift.openshift.io/ignition-reached"
-	TokenSecretNodePoolUpgradeType            = "hypershift.openshift.io/node-pool-upgrade-type"
+	nodePoolAnnotationCurrentPlatformImage    = "hypershift.openshift.io/nodePoolCurrentPlatformImage"
+	// nodePoolAnnotationCurrentPlatformImageVersion records the release version the
+	// current platform image belongs to, so a pinned image can be checked against the
+	// supported version skew.
+	nodePoolAnnotationCurrentPlatformImageVersion = "hypershift.openshift.io/nodePoolCurrentPlatformImageVersion"
+	nodePoolAnnotationTaints                      = "hypershift.openshift.io/nodePoolTaints"
+	nodePoolAnnotationStartupTaints               = "hypershift.openshift.io/nodePoolStartupTaints"
+	nodePoolAnnotationManagedLabels               = "hypershift.openshift.io/nodePoolManagedLabels"
+	nodePoolCoreIgnitionConfigLabel               = "hypershift.openshift.io/core-ignition-config"
+	TokenSecretTokenGenerationTime                = "hypershift.openshift.io/last-token-generation-time"
+	TokenSecretReleaseKey                         = "release"
+	TokenSecretTokenKey                           = "token"
+	TokenSecretPullSecretHashKey                  = "pull-secret-hash"
+	TokenSecretConfigKey                          = "config"
+	TokenSecretAnnotation                         = "hypershift.openshift.io/ignition-config"
+	TokenSecretIgnitionReachedAnnotation          = "hypershift.openshift.io/ignition-reached"
+	TokenSecretNodePoolUpgradeType                = "hypershift.openshift.io/node-pool-upgrade-type"
 
 	tuningConfigKey                = "tuning"
 	tuningConfigMapLabel           = "hypershift.openshift.io/tuned-config"
@@ -101,6 +108,11 @@ const (
 	controlPlaneOperatorCreatesDefaultAWSSecurityGroup = "io.openshift.hypershift.control-plane-operator-creates-aws-sg"
 
 	labelManagedPrefix = "managed.hypershift.openshift.io"
+
+	// minimumMaxNodeLifetime is the shortest node lifetime accepted for
+	// spec.management.maxNodeLifetime. Shorter lifetimes would keep the pool
+	// in a near constant rolling replacement.
+	minimumMaxNodeLifetime = 24 * time.Hour
 )
 
 type NodePoolReconciler struct {
@@ -112,6 +124,9 @@ type NodePoolReconciler struct {
 	HypershiftOperatorImage string
 	ImageMetadataProvider   supportutil.ImageMetadataProvider
 	KubevirtInfraClients    kvinfra.KubevirtInfraClientMap
+	// ScaleUpLimiter throttles machine scale ups across all NodePools.
+	// A nil limiter admits every scale up immediately.
+	ScaleUpLimiter *ScaleUpLimiter
 }
 
 type NotReadyError struct {
@@ -168,7 +183,13 @@ func (r *NodePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, err
 	}
 
+	// Derive the control plane namespace from the HostedCluster when it still
+	// exists so the control-plane-namespace override annotation is honored.
+	// When the HostedCluster is already gone fall back to the derived name.
 	controlPlaneNamespace := manifests.HostedControlPlaneNamespace(nodePool.Namespace, nodePool.Spec.ClusterName)
+	if hcluster, err := GetHostedClusterByName(ctx, r.Client, nodePool.GetNamespace(), nodePool.Spec.ClusterName); err == nil {
+		controlPlaneNamespace = manifests.HostedControlPlaneNamespaceFor(hcluster)
+	}
 
 	// If deleted, clean up and return early.
 	if !nodePool.DeletionTimestamp.IsZero() {
@@ -239,7 +260,7 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 	})
 
 	// Get HostedCluster deps.
-	controlPlaneNamespace := manifests.HostedControlPlaneNamespace(hcluster.Namespace, hcluster.Name)
+	controlPlaneNamespace := manifests.HostedControlPlaneNamespaceFor(hcluster)
 	ignEndpoint := hcluster.Status.IgnitionEndpoint
 	infraID := hcluster.Spec.InfraID
 	if err := validateInfraID(infraID); err != nil {
@@ -306,6 +327,50 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 		ObservedGeneration: nodePool.Generation,
 	})
 
+	// Validate managed node labels input.
+	if err := validateManagedNodeLabels(nodePool); err != nil {
+		SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
+			Type:               hyperv1.NodePoolValidManagedNodeLabelsConditionType,
+			Status:             corev1.ConditionFalse,
+			Message:            err.Error(),
+			Reason:             hyperv1.NodePoolValidationFailedReason,
+			ObservedGeneration: nodePool.Generation,
+		})
+		// We don't return the error here as reconciling won't solve the input problem.
+		// An update event will trigger reconciliation.
+		log.Error(err, "validating managed node labels failed")
+		return ctrl.Result{}, nil
+	}
+	SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
+		Type:               hyperv1.NodePoolValidManagedNodeLabelsConditionType,
+		Status:             corev1.ConditionTrue,
+		Reason:             hyperv1.AsExpectedReason,
+		ObservedGeneration: nodePool.Generation,
+	})
+
+	// Validate device readiness input.
+	if nodePool.Spec.DeviceReadiness != nil {
+		if err := validateDeviceReadiness(nodePool); err != nil {
+			SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
+				Type:               hyperv1.NodePoolValidDeviceReadinessConditionType,
+				Status:             corev1.ConditionFalse,
+				Message:            err.Error(),
+				Reason:             hyperv1.NodePoolValidationFailedReason,
+				ObservedGeneration: nodePool.Generation,
+			})
+			// We don't return the error here as reconciling won't solve the input problem.
+			// An update event will trigger reconciliation.
+			log.Error(err, "validating device readiness failed")
+			return ctrl.Result{}, nil
+		}
+		SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
+			Type:               hyperv1.NodePoolValidDeviceReadinessConditionType,
+			Status:             corev1.ConditionTrue,
+			Reason:             hyperv1.AsExpectedReason,
+			ObservedGeneration: nodePool.Generation,
+		})
+	}
+
 	// Validate and get releaseImage.
 	releaseImage, err := r.getReleaseImage(ctx, hcluster, nodePool.Status.Version, nodePool.Spec.Release.Image)
 	if err != nil {
@@ -484,6 +549,20 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 				})
 				return ctrl.Result{}, fmt.Errorf("couldn't discover an AMI for release image: %w", err)
 			}
+			if machineImageUpdatesPaused(nodePool) {
+				pinnedAMI, pinErr := pinnedMachineImage(nodePool, supportedversion.GetMinSupportedVersion(hcluster))
+				if pinErr != nil {
+					SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
+						Type:               hyperv1.NodePoolValidPlatformImageType,
+						Status:             corev1.ConditionFalse,
+						Reason:             hyperv1.NodePoolValidationFailedReason,
+						Message:            fmt.Sprintf("Machine image updates are paused but the pinned image is not usable: %s", pinErr.Error()),
+						ObservedGeneration: nodePool.Generation,
+					})
+					return ctrl.Result{}, fmt.Errorf("machine image updates are paused but the pinned image is not usable: %w", pinErr)
+				}
+				ami = pinnedAMI
+			}
 			SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
 				Type:               hyperv1.NodePoolValidPlatformImageType,
 				Status:             corev1.ConditionTrue,
@@ -491,6 +570,13 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 				Message:            fmt.Sprintf("Bootstrap AMI is %q", ami),
 				ObservedGeneration: nodePool.Generation,
 			})
+			if nodePool.Annotations == nil {
+				nodePool.Annotations = map[string]string{}
+			}
+			nodePool.Annotations[nodePoolAnnotationCurrentPlatformImage] = ami
+			if !machineImageUpdatesPaused(nodePool) {
+				nodePool.Annotations[nodePoolAnnotationCurrentPlatformImageVersion] = releaseImage.Version()
+			}
 		}
 
 		if len(nodePool.Spec.Platform.AWS.SecurityGroups) == 0 &&
@@ -606,6 +692,26 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 		removeStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolUpdatingConfigConditionType)
 	}
 
+	// If machine config updates are paused, keep serving the current config
+	// payload while the rest of the reconcile proceeds, so replica scaling and
+	// autoscaler bounds stay live. Day one the annotation has no effect so the
+	// initial config can land.
+	configUpdatesPaused := isUpdatingConfig && machineConfigUpdatesPaused(nodePool)
+	if configUpdatesPaused {
+		SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
+			Type:               hyperv1.NodePoolMachineConfigUpdatesPausedConditionType,
+			Status:             corev1.ConditionTrue,
+			Reason:             hyperv1.AsExpectedReason,
+			Message:            fmt.Sprintf("Machine config updates are paused. Pending target config: %s", targetConfigHash),
+			ObservedGeneration: nodePool.Generation,
+		})
+		log.Info("Machine config update is paused",
+			"current", nodePool.GetAnnotations()[nodePoolAnnotationCurrentConfig],
+			"target", targetConfigHash)
+	} else {
+		removeStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolMachineConfigUpdatesPausedConditionType)
+	}
+
 	// Check if release image version needs to be updated.
 	targetVersion := releaseImage.Version()
 	isUpdatingVersion := isUpdatingVersion(nodePool, targetVersion)
@@ -623,8 +729,39 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 		removeStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolUpdatingVersionConditionType)
 	}
 
+	// When the HostedCluster serializes NodePool rollouts, keep serving the
+	// current payload while another NodePool of the cluster is still updating.
+	// Pools proceed in lexical name order so fleet-wide changes roll out one
+	// pool at a time.
+	if (isUpdatingConfig || isUpdatingVersion) && serializeNodePoolRollouts(hcluster) {
+		blockingPool, err := r.updatingNodePoolAhead(ctx, hcluster, nodePool)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to check NodePool rollout ordering: %w", err)
+		}
+		if blockingPool != "" {
+			SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
+				Type:               hyperv1.NodePoolRolloutDeferredConditionType,
+				Status:             corev1.ConditionTrue,
+				Reason:             hyperv1.AsExpectedReason,
+				Message:            fmt.Sprintf("Rollout deferred. Waiting for NodePool %s to finish updating", blockingPool),
+				ObservedGeneration: nodePool.Generation,
+			})
+			log.Info("NodePool rollout is deferred", "waitingFor", blockingPool)
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+	}
+	removeStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolRolloutDeferredConditionType)
+
 	// Signal ignition payload generation
 	targetPayloadConfigHash := supportutil.HashStruct(config + targetVersion + pullSecretName)
+	if configUpdatesPaused {
+		// Pin the served payload and version to what is currently rolled out
+		// so the pending config is neither generated nor propagated while
+		// paused. Conditions above keep reporting the pending update.
+		targetVersion = nodePool.Status.Version
+		targetConfigHash = nodePool.Annotations[nodePoolAnnotationCurrentConfig]
+		targetPayloadConfigHash = nodePool.Annotations[nodePoolAnnotationCurrentConfigVersion]
+	}
 	tokenSecret := TokenSecret(controlPlaneNamespace, nodePool.Name, targetPayloadConfigHash)
 	condition, err := r.createValidGeneratedPayloadCondition(ctx, tokenSecret, nodePool.Generation)
 	if err != nil {
@@ -803,6 +940,13 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 	}
 	SetStatusCondition(&nodePool.Status.Conditions, *allMachinesHealthyCondition)
 
+	// Aggregate guest cluster Node conditions into status.NodeHealth.
+	if err := r.reconcileNodeHealthStatus(ctx, hcluster, nodePool); err != nil {
+		// Node health is informational; do not block the NodePool reconcile
+		// when the guest cluster API is not reachable yet.
+		log.Error(err, "failed to aggregate node health")
+	}
+
 	// 2. - Reconcile towards expected state of the world.
 	compressedConfig, err := supportutil.CompressAndEncode([]byte(config))
 	if err != nil {
@@ -825,7 +969,9 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 
 	// Token Secrets exist for each NodePool config/version and follow "prefixName-configVersionHash" naming convention.
 	// Ensure old configVersionHash resources are deleted, i.e. token Secret and userdata Secret.
-	if isUpdatingVersion || isUpdatingConfig {
+	// While config updates are paused the current payload keeps being served,
+	// so its token must not be expired.
+	if (isUpdatingVersion || isUpdatingConfig) && !configUpdatesPaused {
 		tokenSecret := TokenSecret(controlPlaneNamespace, nodePool.Name, nodePool.GetAnnotations()[nodePoolAnnotationCurrentConfigVersion])
 		err := r.Get(ctx, client.ObjectKeyFromObject(tokenSecret), tokenSecret)
 		if err != nil && !apierrors.IsNotFound(err) {
@@ -874,7 +1020,19 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 	}
 
 	userDataSecret := IgnitionUserDataSecret(controlPlaneNamespace, nodePool.GetName(), targetPayloadConfigHash)
-	if result, err := r.CreateOrUpdate(ctx, r.Client, userDataSecret, func() error {
+	if nodePool.Spec.UserDataSecret != nil {
+		customUserData, err := r.getCustomUserData(ctx, nodePool)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get custom userData: %w", err)
+		}
+		if result, err := r.CreateOrUpdate(ctx, r.Client, userDataSecret, func() error {
+			return reconcileCustomUserDataSecret(userDataSecret, nodePool, customUserData)
+		}); err != nil {
+			return ctrl.Result{}, err
+		} else {
+			log.Info("Reconciled custom userData Secret", "result", result)
+		}
+	} else if result, err := r.CreateOrUpdate(ctx, r.Client, userDataSecret, func() error {
 		return reconcileUserDataSecret(userDataSecret, nodePool, caCertBytes, tokenBytes, ignEndpoint, targetPayloadConfigHash, proxy)
 	}); err != nil {
 		return ctrl.Result{}, err
@@ -1025,6 +1183,13 @@ func (r *NodePoolReconciler) reconcile(ctx context.Context, hcluster *hyperv1.Ho
 			ObservedGeneration: nodePool.Generation,
 		})
 	}
+
+	// When a scale up was throttled, come back to resume it once the limiter
+	// admits more machines.
+	if c := FindStatusCondition(nodePool.Status.Conditions, hyperv1.NodePoolScalingThrottledConditionType); c != nil && c.Status == corev1.ConditionTrue {
+		return ctrl.Result{RequeueAfter: r.ScaleUpLimiter.delay()}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -1395,6 +1560,34 @@ func (r *NodePoolReconciler) deleteNodePoolSecrets(ctx context.Context, nodePool
 	return nil
 }
 
+// getCustomUserData returns the data of the user provided userData Secret
+// referenced by the NodePool, validating it has the expected keys.
+func (r *NodePoolReconciler) getCustomUserData(ctx context.Context, nodePool *hyperv1.NodePool) (map[string][]byte, error) {
+	customSecret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: nodePool.GetNamespace(), Name: nodePool.Spec.UserDataSecret.Name}, customSecret); err != nil {
+		return nil, fmt.Errorf("failed to get userData Secret %q: %w", nodePool.Spec.UserDataSecret.Name, err)
+	}
+	if _, hasValue := customSecret.Data["value"]; !hasValue {
+		return nil, fmt.Errorf("userData Secret %q must have a %q key", customSecret.Name, "value")
+	}
+	return customSecret.Data, nil
+}
+
+// reconcileCustomUserDataSecret copies the user provided userData into the
+// Secret consumed by the machine templates.
+func reconcileCustomUserDataSecret(userDataSecret *corev1.Secret, nodePool *hyperv1.NodePool, data map[string][]byte) error {
+	// The user owns the content, so keep the Secret mutable to pick up updates.
+	userDataSecret.Immutable = k8sutilspointer.Bool(false)
+
+	if userDataSecret.Annotations == nil {
+		userDataSecret.Annotations = make(map[string]string)
+	}
+	userDataSecret.Annotations[nodePoolAnnotation] = client.ObjectKeyFromObject(nodePool).String()
+
+	userDataSecret.Data = data
+	return nil
+}
+
 func reconcileUserDataSecret(userDataSecret *corev1.Secret, nodePool *hyperv1.NodePool, CA, token []byte, ignEndpoint, targetConfigVersionHash string, proxy *configv1.Proxy) error {
 	// The token secret controller deletes expired token Secrets.
 	// When that happens the NodePool controller reconciles and create a new one.
@@ -1580,8 +1773,25 @@ func (r *NodePoolReconciler) reconcileMachineDeployment(log logr.Logger,
 			if err != nil {
 				return err
 			}
-
 			machine.Annotations[nodePoolAnnotationTaints] = taintsInJSON
+
+			startupTaintsInJSON, err := taintsToJSON(nodePool.Spec.StartupTaints)
+			if err != nil {
+				return err
+			}
+			machine.Annotations[nodePoolAnnotationStartupTaints] = startupTaintsInJSON
+
+			// Propagate managed labels. These are applied to Nodes by the CPO
+			// HCCO Node controller, which continuously reconciles them.
+			if len(nodePool.Spec.ManagedNodeLabels) > 0 {
+				managedLabelsInJSON, err := json.Marshal(nodePool.Spec.ManagedNodeLabels)
+				if err != nil {
+					return err
+				}
+				machine.Annotations[nodePoolAnnotationManagedLabels] = string(managedLabelsInJSON)
+			} else {
+				delete(machine.Annotations, nodePoolAnnotationManagedLabels)
+			}
 			return nil
 		}); err != nil {
 			return fmt.Errorf("failed to reconcile Machine %q: %w",
@@ -1601,7 +1811,26 @@ func (r *NodePoolReconciler) reconcileMachineDeployment(log logr.Logger,
 		}
 	}
 
+	// Recycle machines that exceed the maximum node lifetime by scheduling a
+	// rollout for when the oldest machine crosses the threshold. CAPI replaces
+	// machines created before spec.rolloutAfter, paced by the rolling update
+	// strategy above.
+	if maxNodeLifetime := nodePool.Spec.Management.MaxNodeLifetime; maxNodeLifetime != nil {
+		if oldest := oldestMachineCreationTimestamp(machineList.Items, nodePool); oldest != nil {
+			rolloutAfter := metav1.NewTime(oldest.Add(maxNodeLifetime.Duration))
+			machineDeployment.Spec.RolloutAfter = &rolloutAfter
+		}
+	} else {
+		machineDeployment.Spec.RolloutAfter = nil
+	}
+
+	currentReplicas := k8sutilspointer.Int32Deref(machineDeployment.Spec.Replicas, 0)
 	setMachineDeploymentReplicas(nodePool, machineDeployment)
+	// Cap scale ups to what the fleet-wide scale up limiter admits.
+	desiredReplicas := k8sutilspointer.Int32Deref(machineDeployment.Spec.Replicas, 0)
+	if cappedReplicas := r.throttleScaleUp(log, nodePool, currentReplicas, desiredReplicas); cappedReplicas != desiredReplicas {
+		machineDeployment.Spec.Replicas = k8sutilspointer.Int32(cappedReplicas)
+	}
 
 	isUpdating := false
 	// Propagate version and userData Secret to the machineDeployment.
@@ -1708,8 +1937,31 @@ func (r *NodePoolReconciler) reconcileMachineHealthCheck(mhc *capiv1.MachineHeal
 	CAPIClusterName string) error {
 	// Opinionated spec based on
 	// https://github.com/openshift/managed-cluster-config/blob/14d4255ec75dc263ffd3d897dfccc725cb2b7072/deploy/osd-machine-api/011-machine-api.srep-worker-healthcheck.MachineHealthCheck.yaml
-	// TODO (alberto): possibly expose this config at the nodePool API.
+	// The defaults can be tuned per NodePool via annotations.
+	unhealthyTimeout := 8 * time.Minute
+	if value := nodePool.Annotations[hyperv1.MachineHealthCheckTimeoutAnnotation]; value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("invalid value %q for annotation %s, must be a positive duration", value, hyperv1.MachineHealthCheckTimeoutAnnotation)
+		}
+		unhealthyTimeout = timeout
+	}
+	nodeStartupTimeout := 20 * time.Minute
+	if value := nodePool.Annotations[hyperv1.MachineHealthCheckNodeStartupTimeoutAnnotation]; value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("invalid value %q for annotation %s, must be a positive duration", value, hyperv1.MachineHealthCheckNodeStartupTimeoutAnnotation)
+		}
+		nodeStartupTimeout = timeout
+	}
 	maxUnhealthy := intstr.FromInt(2)
+	if value := nodePool.Annotations[hyperv1.MachineHealthCheckMaxUnhealthyAnnotation]; value != "" {
+		parsed := intstr.Parse(value)
+		if scaled, err := intstr.GetScaledValueFromIntOrPercent(&parsed, 100, false); err != nil || scaled < 0 {
+			return fmt.Errorf("invalid value %q for annotation %s, must be a non-negative integer or percentage", value, hyperv1.MachineHealthCheckMaxUnhealthyAnnotation)
+		}
+		maxUnhealthy = parsed
+	}
 	resourcesName := generateName(CAPIClusterName, nodePool.Spec.ClusterName, nodePool.GetName())
 	mhc.Spec = capiv1.MachineHealthCheckSpec{
 		ClusterName: CAPIClusterName,
@@ -1723,20 +1975,20 @@ func (r *NodePoolReconciler) reconcileMachineHealthCheck(mhc *capiv1.MachineHeal
 				Type:   corev1.NodeReady,
 				Status: corev1.ConditionFalse,
 				Timeout: metav1.Duration{
-					Duration: 8 * time.Minute,
+					Duration: unhealthyTimeout,
 				},
 			},
 			{
 				Type:   corev1.NodeReady,
 				Status: corev1.ConditionUnknown,
 				Timeout: metav1.Duration{
-					Duration: 8 * time.Minute,
+					Duration: unhealthyTimeout,
 				},
 			},
 		},
 		MaxUnhealthy: &maxUnhealthy,
 		NodeStartupTimeout: &metav1.Duration{
-			Duration: 20 * time.Minute,
+			Duration: nodeStartupTimeout,
 		},
 	}
 	return nil
@@ -1774,6 +2026,56 @@ func setMachineDeploymentReplicas(nodePool *hyperv1.NodePool, machineDeployment
 	}
 }
 
+// oldestMachineCreationTimestamp returns the creation timestamp of the oldest
+// machine belonging to the given NodePool that is not already being deleted,
+// or nil when the NodePool has no such machines.
+func oldestMachineCreationTimestamp(machines []capiv1.Machine, nodePool *hyperv1.NodePool) *time.Time {
+	var oldest *time.Time
+	for i := range machines {
+		machine := &machines[i]
+		if machine.GetAnnotations()[nodePoolAnnotation] != client.ObjectKeyFromObject(nodePool).String() {
+			continue
+		}
+		if !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		creationTime := machine.CreationTimestamp.Time
+		if oldest == nil || creationTime.Before(*oldest) {
+			oldest = &creationTime
+		}
+	}
+	return oldest
+}
+
+// throttleScaleUp caps a replica increase to what the fleet-wide scale up
+// limiter admits and surfaces throttling in a NodePool condition. It returns
+// the replica count to apply. NodePools with autoscaling enabled are not
+// throttled as the autoscaler owns the replicas field.
+func (r *NodePoolReconciler) throttleScaleUp(log logr.Logger, nodePool *hyperv1.NodePool, currentReplicas, desiredReplicas int32) int32 {
+	delta := desiredReplicas - currentReplicas
+	if delta <= 0 || isAutoscalingEnabled(nodePool) {
+		removeStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolScalingThrottledConditionType)
+		return desiredReplicas
+	}
+
+	admitted := r.ScaleUpLimiter.admit(delta)
+	if admitted == delta {
+		removeStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolScalingThrottledConditionType)
+		return desiredReplicas
+	}
+
+	SetStatusCondition(&nodePool.Status.Conditions, hyperv1.NodePoolCondition{
+		Type:               hyperv1.NodePoolScalingThrottledConditionType,
+		Status:             corev1.ConditionTrue,
+		Reason:             hyperv1.NodePoolScaleUpThrottledReason,
+		Message:            fmt.Sprintf("Scale up to %d replicas is throttled by the operator machine scale up rate limit, currently scaling to %d", desiredReplicas, currentReplicas+admitted),
+		ObservedGeneration: nodePool.Generation,
+	})
+	log.Info("NodePool scale up is throttled",
+		"desired", desiredReplicas, "current", currentReplicas, "admitted", admitted)
+	return currentReplicas + admitted
+}
+
 func ignConfig(encodedCACert, encodedToken, endpoint, targetConfigVersionHash string, proxy *configv1.Proxy, nodePool *hyperv1.NodePool) ignitionapi.Config {
 	cfg := ignitionapi.Config{
 		Ignition: ignitionapi.Ignition{
@@ -1891,6 +2193,57 @@ func (r *NodePoolReconciler) getConfig(ctx context.Context,
 		configs = append(configs, *configConfigMap)
 	}
 
+	if len(nodePool.Spec.HugePages) > 0 {
+		if err := validateHugePages(nodePool); err != nil {
+			errors = append(errors, fmt.Errorf("invalid hugepages configuration: %w", err))
+		} else if hugePagesConfig, err := hugePagesMachineConfig(nodePool); err != nil {
+			errors = append(errors, err)
+		} else {
+			allConfigPlainText = append(allConfigPlainText, hugePagesConfig)
+		}
+	}
+
+	if len(nodePool.Spec.KernelArguments) > 0 {
+		if err := validateKernelArguments(nodePool); err != nil {
+			errors = append(errors, fmt.Errorf("invalid kernel arguments configuration: %w", err))
+		} else if kernelArgumentsConfig, err := kernelArgumentsMachineConfig(nodePool); err != nil {
+			errors = append(errors, err)
+		} else {
+			allConfigPlainText = append(allConfigPlainText, kernelArgumentsConfig)
+		}
+	}
+
+	if nodePool.Spec.PIDLimits != nil {
+		if err := validatePIDLimits(nodePool); err != nil {
+			errors = append(errors, fmt.Errorf("invalid pid limits configuration: %w", err))
+		} else {
+			if nodePool.Spec.PIDLimits.PodPIDsLimit != nil {
+				if kubeletConfig, err := pidLimitsKubeletConfig(nodePool); err != nil {
+					errors = append(errors, err)
+				} else {
+					allConfigPlainText = append(allConfigPlainText, kubeletConfig)
+				}
+			}
+			if nodePool.Spec.PIDLimits.ContainerPIDsLimit != nil {
+				if containerRuntimeConfig, err := pidLimitsContainerRuntimeConfig(nodePool); err != nil {
+					errors = append(errors, err)
+				} else {
+					allConfigPlainText = append(allConfigPlainText, containerRuntimeConfig)
+				}
+			}
+		}
+	}
+
+	if nodePool.Spec.Platform.AWS != nil && len(nodePool.Spec.Platform.AWS.DataVolumes) > 0 {
+		if err := validateDataVolumes(nodePool); err != nil {
+			errors = append(errors, fmt.Errorf("invalid data volumes configuration: %w", err))
+		} else if dataVolumesConfig, err := dataVolumesMachineConfig(nodePool); err != nil {
+			errors = append(errors, err)
+		} else {
+			allConfigPlainText = append(allConfigPlainText, dataVolumesConfig)
+		}
+	}
+
 	// Look for NTO generated MachineConfigs from the hosted control plane namespace
 	nodeTuningGeneratedConfigs := &corev1.ConfigMapList{}
 	if err := r.List(ctx, nodeTuningGeneratedConfigs, client.MatchingLabels{
@@ -1992,12 +2345,46 @@ func validateTuningConfigManifest(manifest []byte) ([]byte, error) {
 
 // validateManagement does additional backend validation. API validation/default should
 // prevent this from ever fail.
+// validateManagedNodeLabels checks that managed node label keys and values are
+// syntactically valid and only use label namespaces that the control plane is
+// allowed to manage on behalf of Nodes.
+func validateManagedNodeLabels(nodePool *hyperv1.NodePool) error {
+	for key, value := range nodePool.Spec.ManagedNodeLabels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid managed node label key %q: %s", key, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid managed node label value %q: %s", value, strings.Join(errs, ", "))
+		}
+		prefix := ""
+		if index := strings.Index(key, "/"); index >= 0 {
+			prefix = key[:index]
+		}
+		if prefix == "node-role.kubernetes.io" || strings.HasPrefix(key, "node-role.kubernetes.io/") {
+			continue
+		}
+		if prefix == "kubernetes.io" || prefix == "k8s.io" ||
+			strings.HasSuffix(prefix, ".kubernetes.io") || strings.HasSuffix(prefix, ".k8s.io") {
+			return fmt.Errorf("managed node label %q uses a restricted prefix, only node-role.kubernetes.io is allowed within the kubernetes.io and k8s.io namespaces", key)
+		}
+	}
+	return nil
+}
+
 func validateManagement(nodePool *hyperv1.NodePool) error {
 	// TODO actually validate the inplace upgrade type
 	if nodePool.Spec.Management.UpgradeType == hyperv1.UpgradeTypeInPlace {
+		if nodePool.Spec.Management.MaxNodeLifetime != nil {
+			return fmt.Errorf("maxNodeLifetime is only supported with the %q upgrade type", hyperv1.UpgradeTypeReplace)
+		}
 		return nil
 	}
 
+	if maxNodeLifetime := nodePool.Spec.Management.MaxNodeLifetime; maxNodeLifetime != nil &&
+		maxNodeLifetime.Duration < minimumMaxNodeLifetime {
+		return fmt.Errorf("maxNodeLifetime %s is too short, the minimum supported lifetime is %s", maxNodeLifetime.Duration, minimumMaxNodeLifetime)
+	}
+
 	// Only upgradeType "Replace" is supported atm.
 	if nodePool.Spec.Management.UpgradeType != hyperv1.UpgradeTypeReplace ||
 		nodePool.Spec.Management.Replace == nil {
@@ -2112,6 +2499,87 @@ func isUpdatingConfig(nodePool *hyperv1.NodePool, targetConfigHash string) bool
 	return targetConfigHash != nodePool.GetAnnotations()[nodePoolAnnotationCurrentConfig]
 }
 
+// machineConfigUpdatesPaused returns true when the rollout of machine config changes
+// is paused via annotation. The pause only takes effect once an initial config has
+// rolled out so it never blocks day one creation.
+func machineConfigUpdatesPaused(nodePool *hyperv1.NodePool) bool {
+	if nodePool.GetAnnotations()[hyperv1.NodePoolPauseMachineConfigUpdatesAnnotation] != "true" {
+		return false
+	}
+	return nodePool.GetAnnotations()[nodePoolAnnotationCurrentConfig] != ""
+}
+
+// serializeNodePoolRollouts returns true when the HostedCluster restricts its
+// NodePools to rolling out config and version changes one pool at a time.
+func serializeNodePoolRollouts(hcluster *hyperv1.HostedCluster) bool {
+	return hcluster.GetAnnotations()[hyperv1.SerializeNodePoolRolloutsAnnotation] == "true"
+}
+
+// updatingNodePoolAhead returns the name of another NodePool of the same cluster
+// that reports an in-progress update and precedes nodePool in lexical name order,
+// or the empty string when the rollout may proceed. Pools that have not rolled
+// out an initial config yet never wait so day one creation is unaffected.
+func (r *NodePoolReconciler) updatingNodePoolAhead(ctx context.Context, hcluster *hyperv1.HostedCluster, nodePool *hyperv1.NodePool) (string, error) {
+	if nodePool.GetAnnotations()[nodePoolAnnotationCurrentConfig] == "" {
+		return "", nil
+	}
+
+	nodePoolList := &hyperv1.NodePoolList{}
+	if err := r.List(ctx, nodePoolList, client.InNamespace(nodePool.Namespace)); err != nil {
+		return "", fmt.Errorf("failed to list NodePools: %w", err)
+	}
+
+	for i := range nodePoolList.Items {
+		other := &nodePoolList.Items[i]
+		if other.Spec.ClusterName != hcluster.Name || other.Name == nodePool.Name {
+			continue
+		}
+		if other.Name >= nodePool.Name || !nodePoolUpdating(other) {
+			continue
+		}
+		return other.Name, nil
+	}
+
+	return "", nil
+}
+
+// nodePoolUpdating returns true when the NodePool reports an in-progress config
+// or version rollout.
+func nodePoolUpdating(nodePool *hyperv1.NodePool) bool {
+	for _, conditionType := range []string{hyperv1.NodePoolUpdatingConfigConditionType, hyperv1.NodePoolUpdatingVersionConditionType} {
+		if condition := FindStatusCondition(nodePool.Status.Conditions, conditionType); condition != nil && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// machineImageUpdatesPaused returns true when the NodePool keeps its current
+// machine boot image instead of following the release payload's image. The
+// pause only takes effect once an image has rolled out so it never blocks day
+// one creation.
+func machineImageUpdatesPaused(nodePool *hyperv1.NodePool) bool {
+	if nodePool.GetAnnotations()[hyperv1.NodePoolPauseMachineImageUpdatesAnnotation] != "true" {
+		return false
+	}
+	return nodePool.GetAnnotations()[nodePoolAnnotationCurrentPlatformImage] != ""
+}
+
+// pinnedMachineImage returns the machine image the NodePool is pinned to after
+// checking that the release version the image came from is still supported.
+func pinnedMachineImage(nodePool *hyperv1.NodePool, minSupportedVersion semver.Version) (string, error) {
+	if pinnedVersion := nodePool.GetAnnotations()[nodePoolAnnotationCurrentPlatformImageVersion]; pinnedVersion != "" {
+		version, err := semver.Parse(pinnedVersion)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse the pinned machine image version %q: %w", pinnedVersion, err)
+		}
+		if version.LT(minSupportedVersion) {
+			return "", fmt.Errorf("the pinned machine image is from version %s which is below the minimum supported version %s, remove the %s annotation to resume machine image updates", pinnedVersion, minSupportedVersion, hyperv1.NodePoolPauseMachineImageUpdatesAnnotation)
+		}
+	}
+	return nodePool.GetAnnotations()[nodePoolAnnotationCurrentPlatformImage], nil
+}
+
 func isUpdatingMachineTemplate(nodePool *hyperv1.NodePool, targetMachineTemplate string) bool {
 	return targetMachineTemplate != nodePool.GetAnnotations()[nodePoolAnnotationPlatformMachineTemplate]
 }
@@ -2545,7 +3013,7 @@ func machineTemplateBuilders(hcluster *hyperv1.HostedCluster, nodePool *hyperv1.
 		// TODO(alberto): Consider signal in a condition.
 		return nil, nil, "", fmt.Errorf("unsupported platform type: %s", nodePool.Spec.Platform.Type)
 	}
-	template.SetNamespace(manifests.HostedControlPlaneNamespace(hcluster.Namespace, hcluster.Name))
+	template.SetNamespace(manifests.HostedControlPlaneNamespaceFor(hcluster))
 
 	machineTemplateSpecJSON, err := json.Marshal(machineTemplateSpec)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/blang/semver"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
@@ -81,6 +82,69 @@ func TestIsUpdatingConfig(t *testing.T) {
 	}
 }
 
+func TestPinnedMachineImage(t *testing.T) {
+	minSupportedVersion := semver.MustParse("4.10.0")
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		expectImage string
+		expectError bool
+	}{
+		{
+			name: "no image rolled out yet",
+		},
+		{
+			name: "pinned image with supported version",
+			annotations: map[string]string{
+				nodePoolAnnotationCurrentPlatformImage:        "ami-123",
+				nodePoolAnnotationCurrentPlatformImageVersion: "4.12.3",
+			},
+			expectImage: "ami-123",
+		},
+		{
+			name: "pinned image without recorded version",
+			annotations: map[string]string{
+				nodePoolAnnotationCurrentPlatformImage: "ami-123",
+			},
+			expectImage: "ami-123",
+		},
+		{
+			name: "pinned image below minimum supported version",
+			annotations: map[string]string{
+				nodePoolAnnotationCurrentPlatformImage:        "ami-123",
+				nodePoolAnnotationCurrentPlatformImageVersion: "4.9.8",
+			},
+			expectError: true,
+		},
+		{
+			name: "unparseable pinned version",
+			annotations: map[string]string{
+				nodePoolAnnotationCurrentPlatformImage:        "ami-123",
+				nodePoolAnnotationCurrentPlatformImageVersion: "not-a-version",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			nodePool := &hyperv1.NodePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+			image, err := pinnedMachineImage(nodePool, minSupportedVersion)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(image).To(Equal(tc.expectImage))
+		})
+	}
+}
+
 func TestIsUpdatingVersion(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -118,6 +182,98 @@ func TestIsUpdatingVersion(t *testing.T) {
 	}
 }
 
+func TestUpdatingNodePoolAhead(t *testing.T) {
+	hcluster := &hyperv1.HostedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "clusters",
+		},
+	}
+	makeNodePool := func(name string, currentConfig string, updating bool) *hyperv1.NodePool {
+		nodePool := &hyperv1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "clusters",
+			},
+			Spec: hyperv1.NodePoolSpec{
+				ClusterName: "cluster",
+			},
+		}
+		if currentConfig != "" {
+			nodePool.Annotations = map[string]string{
+				nodePoolAnnotationCurrentConfig: currentConfig,
+			}
+		}
+		if updating {
+			nodePool.Status.Conditions = []hyperv1.NodePoolCondition{
+				{
+					Type:   hyperv1.NodePoolUpdatingConfigConditionType,
+					Status: corev1.ConditionTrue,
+				},
+			}
+		}
+		return nodePool
+	}
+
+	testCases := []struct {
+		name       string
+		nodePool   *hyperv1.NodePool
+		otherPools []client.Object
+		expect     string
+	}{
+		{
+			name:     "proceeds when no other pool is updating",
+			nodePool: makeNodePool("pool-b", "hash", false),
+			otherPools: []client.Object{
+				makeNodePool("pool-a", "hash", false),
+				makeNodePool("pool-c", "hash", true),
+			},
+			expect: "",
+		},
+		{
+			name:     "waits for an updating pool that precedes it",
+			nodePool: makeNodePool("pool-b", "hash", false),
+			otherPools: []client.Object{
+				makeNodePool("pool-a", "hash", true),
+			},
+			expect: "pool-a",
+		},
+		{
+			name:     "day one pools never wait",
+			nodePool: makeNodePool("pool-b", "", false),
+			otherPools: []client.Object{
+				makeNodePool("pool-a", "hash", true),
+			},
+			expect: "",
+		},
+		{
+			name:     "pools of other clusters are ignored",
+			nodePool: makeNodePool("pool-b", "hash", false),
+			otherPools: []client.Object{
+				func() *hyperv1.NodePool {
+					nodePool := makeNodePool("pool-a", "hash", true)
+					nodePool.Spec.ClusterName = "other-cluster"
+					return nodePool
+				}(),
+			},
+			expect: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			objects := append([]client.Object{tc.nodePool}, tc.otherPools...)
+			r := &NodePoolReconciler{
+				Client: fake.NewClientBuilder().WithScheme(api.Scheme).WithObjects(objects...).Build(),
+			}
+			blockingPool, err := r.updatingNodePoolAhead(context.Background(), hcluster, tc.nodePool)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(blockingPool).To(Equal(tc.expect))
+		})
+	}
+}
+
 func TestIsAutoscalingEnabled(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -1259,6 +1415,79 @@ func TestSetMachineDeploymentReplicas(t *testing.T) {
 	}
 }
 
+func TestThrottleScaleUp(t *testing.T) {
+	testCases := []struct {
+		name            string
+		limiter         *ScaleUpLimiter
+		nodePool        *hyperv1.NodePool
+		currentReplicas int32
+		desiredReplicas int32
+		expectReplicas  int32
+		expectThrottled bool
+	}{
+		{
+			name:            "it admits every scale up when no limiter is configured",
+			limiter:         nil,
+			nodePool:        &hyperv1.NodePool{},
+			currentReplicas: 0,
+			desiredReplicas: 100,
+			expectReplicas:  100,
+			expectThrottled: false,
+		},
+		{
+			name:            "it admits scale downs without consuming the limiter",
+			limiter:         NewScaleUpLimiter(1, 1),
+			nodePool:        &hyperv1.NodePool{},
+			currentReplicas: 5,
+			desiredReplicas: 2,
+			expectReplicas:  2,
+			expectThrottled: false,
+		},
+		{
+			name:    "it does not throttle NodePools with autoscaling enabled",
+			limiter: NewScaleUpLimiter(1, 1),
+			nodePool: &hyperv1.NodePool{
+				Spec: hyperv1.NodePoolSpec{
+					AutoScaling: &hyperv1.NodePoolAutoScaling{
+						Min: 1,
+						Max: 10,
+					},
+				},
+			},
+			currentReplicas: 0,
+			desiredReplicas: 10,
+			expectReplicas:  10,
+			expectThrottled: false,
+		},
+		{
+			name:            "it caps a scale up beyond the burst and sets the throttled condition",
+			limiter:         NewScaleUpLimiter(0.0001, 2),
+			nodePool:        &hyperv1.NodePool{},
+			currentReplicas: 1,
+			desiredReplicas: 10,
+			expectReplicas:  3,
+			expectThrottled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			r := &NodePoolReconciler{ScaleUpLimiter: tc.limiter}
+			replicas := r.throttleScaleUp(logr.Discard(), tc.nodePool, tc.currentReplicas, tc.desiredReplicas)
+			g.Expect(replicas).To(Equal(tc.expectReplicas))
+			throttledCondition := FindStatusCondition(tc.nodePool.Status.Conditions, hyperv1.NodePoolScalingThrottledConditionType)
+			if tc.expectThrottled {
+				g.Expect(throttledCondition).ToNot(BeNil())
+				g.Expect(throttledCondition.Status).To(Equal(corev1.ConditionTrue))
+				g.Expect(throttledCondition.Reason).To(Equal(hyperv1.NodePoolScaleUpThrottledReason))
+			} else {
+				g.Expect(throttledCondition).To(BeNil())
+			}
+		})
+	}
+}
+
 func TestValidateManagement(t *testing.T) {
 	intstrPointer1 := intstr.FromInt(1)
 	testCases := []struct {
@@ -1363,6 +1592,59 @@ func TestValidateManagement(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "it fails with InPlace type and maxNodeLifetime",
+			nodePool: &hyperv1.NodePool{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: hyperv1.NodePoolSpec{
+					Management: hyperv1.NodePoolManagement{
+						UpgradeType:     hyperv1.UpgradeTypeInPlace,
+						MaxNodeLifetime: &metav1.Duration{Duration: 48 * time.Hour},
+					},
+				},
+			},
+			error: true,
+		},
+		{
+			name: "it fails with maxNodeLifetime below the minimum",
+			nodePool: &hyperv1.NodePool{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: hyperv1.NodePoolSpec{
+					Management: hyperv1.NodePoolManagement{
+						UpgradeType:     hyperv1.UpgradeTypeReplace,
+						MaxNodeLifetime: &metav1.Duration{Duration: time.Hour},
+						Replace: &hyperv1.ReplaceUpgrade{
+							Strategy: hyperv1.UpgradeStrategyRollingUpdate,
+							RollingUpdate: &hyperv1.RollingUpdate{
+								MaxUnavailable: &intstrPointer1,
+								MaxSurge:       &intstrPointer1,
+							},
+						},
+					},
+				},
+			},
+			error: true,
+		},
+		{
+			name: "it passes with maxNodeLifetime above the minimum",
+			nodePool: &hyperv1.NodePool{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: hyperv1.NodePoolSpec{
+					Management: hyperv1.NodePoolManagement{
+						UpgradeType:     hyperv1.UpgradeTypeReplace,
+						MaxNodeLifetime: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+						Replace: &hyperv1.ReplaceUpgrade{
+							Strategy: hyperv1.UpgradeStrategyRollingUpdate,
+							RollingUpdate: &hyperv1.RollingUpdate{
+								MaxUnavailable: &intstrPointer1,
+								MaxSurge:       &intstrPointer1,
+							},
+						},
+					},
+				},
+			},
+			error: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1378,6 +1660,85 @@ func TestValidateManagement(t *testing.T) {
 	}
 }
 
+func TestValidateManagedNodeLabels(t *testing.T) {
+	testCases := []struct {
+		name              string
+		managedNodeLabels map[string]string
+		error             bool
+	}{
+		{
+			name:              "it passes with no managed node labels",
+			managedNodeLabels: nil,
+			error:             false,
+		},
+		{
+			name: "it passes with a node-role.kubernetes.io label",
+			managedNodeLabels: map[string]string{
+				"node-role.kubernetes.io/infra": "",
+			},
+			error: false,
+		},
+		{
+			name: "it passes with a custom domain label",
+			managedNodeLabels: map[string]string{
+				"example.com/pool": "gpu",
+			},
+			error: false,
+		},
+		{
+			name: "it fails with a kubernetes.io label outside node-role",
+			managedNodeLabels: map[string]string{
+				"kubernetes.io/hostname": "foo",
+			},
+			error: true,
+		},
+		{
+			name: "it fails with a kubernetes.io subdomain label",
+			managedNodeLabels: map[string]string{
+				"node.kubernetes.io/instance-type": "m5.large",
+			},
+			error: true,
+		},
+		{
+			name: "it fails with a k8s.io label",
+			managedNodeLabels: map[string]string{
+				"k8s.io/foo": "bar",
+			},
+			error: true,
+		},
+		{
+			name: "it fails with an invalid label key",
+			managedNodeLabels: map[string]string{
+				"!invalid": "value",
+			},
+			error: true,
+		},
+		{
+			name: "it fails with an invalid label value",
+			managedNodeLabels: map[string]string{
+				"example.com/pool": "bad value!",
+			},
+			error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateManagedNodeLabels(&hyperv1.NodePool{
+				Spec: hyperv1.NodePoolSpec{
+					ManagedNodeLabels: tc.managedNodeLabels,
+				},
+			})
+			if tc.error {
+				g.Expect(err).Should(HaveOccurred())
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+		})
+	}
+}
+
 // It returns a expected machineTemplateSpecJSON
 // and a template and mutateTemplate able to produce an expected target template.
 func RunTestMachineTemplateBuilders(t *testing.T, preCreateMachineTemplate bool) {
@@ -2193,3 +2554,81 @@ func newKVInfraMapMock(objects []client.Object) kvinfra.KubevirtInfraClientMap {
 		"",
 		"")
 }
+
+func TestReconcileMachineHealthCheck(t *testing.T) {
+	testCases := []struct {
+		name                       string
+		annotations                map[string]string
+		expectedUnhealthyTimeout   time.Duration
+		expectedNodeStartupTimeout time.Duration
+		expectedMaxUnhealthy       intstr.IntOrString
+		expectError                bool
+	}{
+		{
+			name:                       "defaults without annotations",
+			expectedUnhealthyTimeout:   8 * time.Minute,
+			expectedNodeStartupTimeout: 20 * time.Minute,
+			expectedMaxUnhealthy:       intstr.FromInt(2),
+		},
+		{
+			name: "all annotations set",
+			annotations: map[string]string{
+				hyperv1.MachineHealthCheckTimeoutAnnotation:            "10m",
+				hyperv1.MachineHealthCheckNodeStartupTimeoutAnnotation: "30m",
+				hyperv1.MachineHealthCheckMaxUnhealthyAnnotation:       "40%",
+			},
+			expectedUnhealthyTimeout:   10 * time.Minute,
+			expectedNodeStartupTimeout: 30 * time.Minute,
+			expectedMaxUnhealthy:       intstr.FromString("40%"),
+		},
+		{
+			name: "timeout is not a duration",
+			annotations: map[string]string{
+				hyperv1.MachineHealthCheckTimeoutAnnotation: "soon",
+			},
+			expectError: true,
+		},
+		{
+			name: "negative node startup timeout",
+			annotations: map[string]string{
+				hyperv1.MachineHealthCheckNodeStartupTimeoutAnnotation: "-10m",
+			},
+			expectError: true,
+		},
+		{
+			name: "maxUnhealthy is not an integer or percentage",
+			annotations: map[string]string{
+				hyperv1.MachineHealthCheckMaxUnhealthyAnnotation: "some",
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			nodePool := &hyperv1.NodePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "clusters",
+					Name:        "example",
+					Annotations: tc.annotations,
+				},
+				Spec: hyperv1.NodePoolSpec{
+					ClusterName: "example",
+				},
+			}
+			mhc := &capiv1.MachineHealthCheck{}
+			r := &NodePoolReconciler{}
+			err := r.reconcileMachineHealthCheck(mhc, nodePool, "infra")
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			for _, condition := range mhc.Spec.UnhealthyConditions {
+				g.Expect(condition.Timeout.Duration).To(Equal(tc.expectedUnhealthyTimeout))
+			}
+			g.Expect(mhc.Spec.NodeStartupTimeout.Duration).To(Equal(tc.expectedNodeStartupTimeout))
+			g.Expect(*mhc.Spec.MaxUnhealthy).To(Equal(tc.expectedMaxUnhealthy))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package nodepool
+
+import (
+	"bytes"
+	"fmt"
+
+	api "github.com/openshift/hypershift/api"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/hypershift-operator/controllers/manifests"
+	mcfgv1 "github.com/openshift/hypershift/thirdparty/machineconfigoperator/pkg/apis/machineconfiguration.openshift.io/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// minPIDsLimit is the lowest PID limit accepted for pods and containers,
+// matching the floor the machine config operator enforces.
+const minPIDsLimit = 1024
+
+// validatePIDLimits checks the NodePool's PID limits for values the kubelet
+// and container runtime would reject.
+func validatePIDLimits(nodePool *hyperv1.NodePool) error {
+	limits := nodePool.Spec.PIDLimits
+	if limits.PodPIDsLimit == nil && limits.ContainerPIDsLimit == nil {
+		return fmt.Errorf("at least one of podPIDsLimit or containerPIDsLimit must be set")
+	}
+	if limits.PodPIDsLimit != nil && *limits.PodPIDsLimit < minPIDsLimit {
+		return fmt.Errorf("podPIDsLimit must be at least %d, got %d", minPIDsLimit, *limits.PodPIDsLimit)
+	}
+	if limits.ContainerPIDsLimit != nil && *limits.ContainerPIDsLimit < minPIDsLimit {
+		return fmt.Errorf("containerPIDsLimit must be at least %d, got %d", minPIDsLimit, *limits.ContainerPIDsLimit)
+	}
+	if limits.PodPIDsLimit != nil && limits.ContainerPIDsLimit != nil && *limits.ContainerPIDsLimit > *limits.PodPIDsLimit {
+		return fmt.Errorf("containerPIDsLimit %d must not exceed podPIDsLimit %d", *limits.ContainerPIDsLimit, *limits.PodPIDsLimit)
+	}
+	return nil
+}
+
+// pidLimitsKubeletConfig renders the NodePool's pod PID limit into a
+// serialized KubeletConfig.
+func pidLimitsKubeletConfig(nodePool *hyperv1.NodePool) (string, error) {
+	kubeletConfig := manifests.KubeletConfigPIDLimits()
+	kubeletConfig.Spec.KubeletConfig = &runtime.RawExtension{
+		Raw: []byte(fmt.Sprintf(`{"podPidsLimit":%d}`, *nodePool.Spec.PIDLimits.PodPIDsLimit)),
+	}
+
+	buf := &bytes.Buffer{}
+	kubeletConfig.APIVersion = mcfgv1.SchemeGroupVersion.String()
+	kubeletConfig.Kind = "KubeletConfig"
+	if err := api.YamlSerializer.Encode(kubeletConfig, buf); err != nil {
+		return "", fmt.Errorf("failed to serialize pid limits kubelet config: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// pidLimitsContainerRuntimeConfig renders the NodePool's container PID limit
+// into a serialized ContainerRuntimeConfig.
+func pidLimitsContainerRuntimeConfig(nodePool *hyperv1.NodePool) (string, error) {
+	containerRuntimeConfig := manifests.ContainerRuntimeConfigPIDLimits()
+	containerRuntimeConfig.Spec.ContainerRuntimeConfig = &mcfgv1.ContainerRuntimeConfiguration{
+		PidsLimit: nodePool.Spec.PIDLimits.ContainerPIDsLimit,
+	}
+
+	buf := &bytes.Buffer{}
+	containerRuntimeConfig.APIVersion = mcfgv1.SchemeGroupVersion.String()
+	containerRuntimeConfig.Kind = "ContainerRuntimeConfig"
+	if err := api.YamlSerializer.Encode(containerRuntimeConfig, buf); err != nil {
+		return "", fmt.Errorf("failed to serialize pid limits container runtime config: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,104 @@
+package nodepool
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sutilspointer "k8s.io/utils/pointer"
+)
+
+func TestPIDLimitsConfigs(t *testing.T) {
+	g := NewGomegaWithT(t)
+	nodePool := &hyperv1.NodePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: hyperv1.NodePoolSpec{
+			PIDLimits: &hyperv1.PIDLimitsSpec{
+				PodPIDsLimit:       k8sutilspointer.Int64(8192),
+				ContainerPIDsLimit: k8sutilspointer.Int64(4096),
+			},
+		},
+	}
+
+	kubeletConfig, err := pidLimitsKubeletConfig(nodePool)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(kubeletConfig).To(ContainSubstring("kind: KubeletConfig"))
+	g.Expect(kubeletConfig).To(ContainSubstring("name: pid-limits"))
+	g.Expect(kubeletConfig).To(ContainSubstring("podPidsLimit"))
+	g.Expect(kubeletConfig).To(ContainSubstring("8192"))
+
+	containerRuntimeConfig, err := pidLimitsContainerRuntimeConfig(nodePool)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(containerRuntimeConfig).To(ContainSubstring("kind: ContainerRuntimeConfig"))
+	g.Expect(containerRuntimeConfig).To(ContainSubstring("name: pid-limits"))
+	g.Expect(containerRuntimeConfig).To(ContainSubstring("pidsLimit: 4096"))
+}
+
+func TestValidatePIDLimits(t *testing.T) {
+	testCases := []struct {
+		name        string
+		limits      *hyperv1.PIDLimitsSpec
+		expectError bool
+	}{
+		{
+			name: "valid limits",
+			limits: &hyperv1.PIDLimitsSpec{
+				PodPIDsLimit:       k8sutilspointer.Int64(8192),
+				ContainerPIDsLimit: k8sutilspointer.Int64(4096),
+			},
+		},
+		{
+			name: "pod limit only",
+			limits: &hyperv1.PIDLimitsSpec{
+				PodPIDsLimit: k8sutilspointer.Int64(4096),
+			},
+		},
+		{
+			name:        "no limit set",
+			limits:      &hyperv1.PIDLimitsSpec{},
+			expectError: true,
+		},
+		{
+			name: "pod limit below floor",
+			limits: &hyperv1.PIDLimitsSpec{
+				PodPIDsLimit: k8sutilspointer.Int64(100),
+			},
+			expectError: true,
+		},
+		{
+			name: "container limit below floor",
+			limits: &hyperv1.PIDLimitsSpec{
+				ContainerPIDsLimit: k8sutilspointer.Int64(512),
+			},
+			expectError: true,
+		},
+		{
+			name: "container limit exceeds pod limit",
+			limits: &hyperv1.PIDLimitsSpec{
+				PodPIDsLimit:       k8sutilspointer.Int64(4096),
+				ContainerPIDsLimit: k8sutilspointer.Int64(8192),
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			nodePool := &hyperv1.NodePool{
+				Spec: hyperv1.NodePoolSpec{
+					PIDLimits: tc.limits,
+				},
+			}
+			err := validatePIDLimits(nodePool)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
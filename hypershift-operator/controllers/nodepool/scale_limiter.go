@@ -0,0 +1,52 @@
+package nodepool
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ScaleUpLimiter throttles machine scale ups across all NodePools managed by
+// this operator so mass scale events do not overwhelm cloud provider API
+// limits. A nil *ScaleUpLimiter admits every scale up immediately.
+type ScaleUpLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewScaleUpLimiter returns a limiter that admits machinesPerSecond machine
+// creations fleet-wide, allowing bursts of up to burst machines. A
+// machinesPerSecond of 0 or less disables throttling and returns nil.
+func NewScaleUpLimiter(machinesPerSecond float64, burst int) *ScaleUpLimiter {
+	if machinesPerSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &ScaleUpLimiter{
+		limiter: rate.NewLimiter(rate.Limit(machinesPerSecond), burst),
+	}
+}
+
+// admit returns how many of the requested machine creations are admitted now.
+func (l *ScaleUpLimiter) admit(requested int32) int32 {
+	if l == nil {
+		return requested
+	}
+	var admitted int32
+	for admitted < requested && l.limiter.Allow() {
+		admitted++
+	}
+	return admitted
+}
+
+// delay returns an estimate of how long to wait before the next machine
+// creation is admitted.
+func (l *ScaleUpLimiter) delay() time.Duration {
+	if l == nil {
+		return 0
+	}
+	reservation := l.limiter.Reserve()
+	defer reservation.Cancel()
+	return reservation.Delay()
+}
@@ -48,6 +48,7 @@ import (
 	kvinfra "github.com/openshift/hypershift/kubevirtexternalinfra"
 	"github.com/openshift/hypershift/pkg/version"
 	"github.com/openshift/hypershift/support/capabilities"
+	"github.com/openshift/hypershift/support/config"
 	"github.com/openshift/hypershift/support/metrics"
 	"github.com/openshift/hypershift/support/releaseinfo"
 	"github.com/openshift/hypershift/support/upsert"
@@ -107,6 +108,11 @@ type StartOptions struct {
 	OIDCStorageProviderS3Credentials string
 	EnableUWMTelemetryRemoteWrite    bool
 	EnableValidatingWebhook          bool
+	LeaderElectionLeaseDuration      time.Duration
+	LeaderElectionRenewDeadline      time.Duration
+	LeaderElectionRetryPeriod        time.Duration
+	MachineScaleUpRate               float64
+	MachineScaleUpBurst              int
 }
 
 func NewStartCommand() *cobra.Command {
@@ -125,6 +131,10 @@ func NewStartCommand() *cobra.Command {
 		PrivatePlatform:                  string(hyperv1.NonePlatform),
 		OIDCStorageProviderS3Region:      "",
 		OIDCStorageProviderS3Credentials: "",
+		LeaderElectionLeaseDuration:      config.DefaultLeaseDuration,
+		LeaderElectionRenewDeadline:      config.DefaultRenewDeadline,
+		LeaderElectionRetryPeriod:        config.DefaultRetryPeriod,
+		MachineScaleUpBurst:              10,
 	}
 
 	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "The namespace this operator lives in")
@@ -142,6 +152,11 @@ func NewStartCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.OIDCStorageProviderS3Credentials, "oidc-storage-provider-s3-credentials", opts.OIDCStorageProviderS3Credentials, "Location of the credentials file for the OIDC bucket. Required for AWS guest clusters.")
 	cmd.Flags().BoolVar(&opts.EnableUWMTelemetryRemoteWrite, "enable-uwm-telemetry-remote-write", opts.EnableUWMTelemetryRemoteWrite, "If true, enables a controller that ensures user workload monitoring is enabled and that it is configured to remote write telemetry metrics from control planes")
 	cmd.Flags().BoolVar(&opts.EnableValidatingWebhook, "enable-validating-webhook", false, "Enable webhook for validating hypershift API types")
+	cmd.Flags().DurationVar(&opts.LeaderElectionLeaseDuration, "leader-elect-lease-duration", opts.LeaderElectionLeaseDuration, "The duration that non-leader candidates will wait to force acquire leadership")
+	cmd.Flags().DurationVar(&opts.LeaderElectionRenewDeadline, "leader-elect-renew-deadline", opts.LeaderElectionRenewDeadline, "The duration that the acting leader will retry refreshing leadership before giving up")
+	cmd.Flags().DurationVar(&opts.LeaderElectionRetryPeriod, "leader-elect-retry-period", opts.LeaderElectionRetryPeriod, "The duration the leader election clients should wait between attempts of actions")
+	cmd.Flags().Float64Var(&opts.MachineScaleUpRate, "machine-scale-up-rate", opts.MachineScaleUpRate, "The maximum number of machines per second that may be added across all NodePools. 0 disables throttling")
+	cmd.Flags().IntVar(&opts.MachineScaleUpBurst, "machine-scale-up-burst", opts.MachineScaleUpBurst, "The maximum burst of machine additions allowed when --machine-scale-up-rate is set")
 
 	cmd.Run = func(cmd *cobra.Command, args []string) {
 		ctx, cancel := context.WithCancel(ctrl.SetupSignalHandler())
@@ -169,10 +184,15 @@ func run(ctx context.Context, opts *StartOptions, log logr.Logger) error {
 
 	restConfig := ctrl.GetConfigOrDie()
 	restConfig.UserAgent = "hypershift-operator-manager"
-	leaseDuration := time.Second * 60
-	renewDeadline := time.Second * 40
-	retryPeriod := time.Second * 15
-	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
+	leaderElection := config.LeaderElectionConfig{
+		LeaseDuration: opts.LeaderElectionLeaseDuration,
+		RenewDeadline: opts.LeaderElectionRenewDeadline,
+		RetryPeriod:   opts.LeaderElectionRetryPeriod,
+	}
+	if err := leaderElection.Validate(); err != nil {
+		return fmt.Errorf("invalid leader election configuration: %w", err)
+	}
+	managerOptions := ctrl.Options{
 		Scheme: hyperapi.Scheme,
 		Metrics: metricsserver.Options{
 			BindAddress: opts.MetricsAddr,
@@ -191,10 +211,9 @@ func run(ctx context.Context, opts *StartOptions, log logr.Logger) error {
 		LeaderElectionResourceLock:    "leases",
 		LeaderElectionReleaseOnCancel: true,
 		LeaderElectionNamespace:       opts.Namespace,
-		LeaseDuration:                 &leaseDuration,
-		RenewDeadline:                 &renewDeadline,
-		RetryPeriod:                   &retryPeriod,
-	})
+	}
+	leaderElection.ApplyTo(&managerOptions)
+	mgr, err := ctrl.NewManager(restConfig, managerOptions)
 	if err != nil {
 		return fmt.Errorf("unable to start manager: %w", err)
 	}
@@ -373,6 +392,7 @@ func run(ctx context.Context, opts *StartOptions, log logr.Logger) error {
 		HypershiftOperatorImage: operatorImage,
 		ImageMetadataProvider:   &hyperutil.RegistryClientImageMetadataProvider{},
 		KubevirtInfraClients:    kvinfra.NewKubevirtInfraClientMap(),
+		ScaleUpLimiter:          nodepool.NewScaleUpLimiter(opts.MachineScaleUpRate, opts.MachineScaleUpBurst),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("unable to create controller: %w", err)
 	}
@@ -29,8 +29,15 @@ import (
 	"github.com/openshift/hypershift/cmd/consolelogs"
 	createcmd "github.com/openshift/hypershift/cmd/create"
 	destroycmd "github.com/openshift/hypershift/cmd/destroy"
+	diffcmd "github.com/openshift/hypershift/cmd/diff"
 	dumpcmd "github.com/openshift/hypershift/cmd/dump"
 	installcmd "github.com/openshift/hypershift/cmd/install"
+	listcmd "github.com/openshift/hypershift/cmd/list"
+	pausecmd "github.com/openshift/hypershift/cmd/pause"
+	rotatecmd "github.com/openshift/hypershift/cmd/rotate"
+	statuscmd "github.com/openshift/hypershift/cmd/status"
+	upgradecmd "github.com/openshift/hypershift/cmd/upgrade"
+	cmdutil "github.com/openshift/hypershift/cmd/util"
 	cliversion "github.com/openshift/hypershift/cmd/version"
 	"github.com/openshift/hypershift/pkg/version"
 
@@ -56,6 +63,8 @@ func main() {
 
 	cmd.Version = version.String()
 
+	cmdutil.BindManagementKubeconfigContextFlag(cmd.PersistentFlags())
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	defer cancel()
@@ -63,8 +72,15 @@ func main() {
 	cmd.AddCommand(installcmd.NewCommand())
 	cmd.AddCommand(createcmd.NewCommand())
 	cmd.AddCommand(destroycmd.NewCommand())
+	cmd.AddCommand(diffcmd.NewCommand())
 	cmd.AddCommand(dumpcmd.NewCommand())
 	cmd.AddCommand(consolelogs.NewCommand())
+	cmd.AddCommand(listcmd.NewCommand())
+	cmd.AddCommand(pausecmd.NewPauseCommand())
+	cmd.AddCommand(pausecmd.NewResumeCommand())
+	cmd.AddCommand(rotatecmd.NewCommand())
+	cmd.AddCommand(statuscmd.NewCommand())
+	cmd.AddCommand(upgradecmd.NewCommand())
 	cmd.AddCommand(cliversion.NewVersionCommand())
 
 	sigs := make(chan os.Signal, 1)
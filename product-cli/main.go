@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	cmdutil "github.com/openshift/hypershift/cmd/util"
 	"github.com/openshift/hypershift/pkg/version"
 	"github.com/openshift/hypershift/product-cli/cmd/create"
 	"github.com/openshift/hypershift/product-cli/cmd/destroy"
@@ -42,6 +43,8 @@ func main() {
 
 	cmd.Version = version.String()
 
+	cmdutil.BindManagementKubeconfigContextFlag(cmd.PersistentFlags())
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	defer cancel()
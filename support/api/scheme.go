@@ -4,14 +4,17 @@ import (
 	"os"
 
 	configv1 "github.com/openshift/api/config/v1"
+	consolev1 "github.com/openshift/api/console/v1"
 	imagev1 "github.com/openshift/api/image/v1"
 	oauthv1 "github.com/openshift/api/oauth/v1"
 	openshiftcpv1 "github.com/openshift/api/openshiftcontrolplane/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/openshift/api/operator/v1alpha1"
 	osinv1 "github.com/openshift/api/osin/v1"
+	projectv1 "github.com/openshift/api/project/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	securityv1 "github.com/openshift/api/security/v1"
+	templatev1 "github.com/openshift/api/template/v1"
 	hyperv1alpha1 "github.com/openshift/hypershift/api/hypershift/v1alpha1"
 	hyperv1beta1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	"github.com/openshift/hypershift/support/rhobsmonitoring"
@@ -60,11 +63,14 @@ func init() {
 	hyperv1beta1.AddToScheme(Scheme)
 	capiv1.AddToScheme(Scheme)
 	configv1.AddToScheme(Scheme)
+	consolev1.AddToScheme(Scheme)
 	securityv1.AddToScheme(Scheme)
 	operatorv1.AddToScheme(Scheme)
 	oauthv1.AddToScheme(Scheme)
 	osinv1.AddToScheme(Scheme)
+	projectv1.AddToScheme(Scheme)
 	routev1.AddToScheme(Scheme)
+	templatev1.AddToScheme(Scheme)
 	imagev1.AddToScheme(Scheme)
 	clientgoscheme.AddToScheme(Scheme)
 	apiextensionsv1.AddToScheme(Scheme)
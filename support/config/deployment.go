@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strconv"
 	"strings"
 
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
@@ -189,6 +190,37 @@ func (c *DeploymentConfig) setMultizoneSpread(labels map[string]string) {
 		}
 }
 
+// setTopologySpread sets TopologySpreadConstraints for a given set of labels based on the
+// TopologySpreadConstraintsAnnotation. Entries that do not parse as topologyKey=maxSkew
+// pairs are skipped.
+func (c *DeploymentConfig) setTopologySpread(hcp *hyperv1.HostedControlPlane, labels map[string]string) {
+	val, exists := hcp.Annotations[hyperv1.TopologySpreadConstraintsAnnotation]
+	if !exists || labels == nil {
+		return
+	}
+	var constraints []corev1.TopologySpreadConstraint
+	for _, entry := range strings.Split(val, ",") {
+		entryParts := strings.SplitN(entry, "=", 2)
+		if len(entryParts) != 2 {
+			continue
+		}
+		maxSkew, err := strconv.ParseInt(strings.TrimSpace(entryParts[1]), 10, 32)
+		if err != nil || maxSkew < 1 {
+			// Skip this constraint if the skew is invalid
+			continue
+		}
+		constraints = append(constraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           int32(maxSkew),
+			TopologyKey:       strings.TrimSpace(entryParts[0]),
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+		})
+	}
+	c.Scheduling.TopologySpreadConstraints = constraints
+}
+
 // setColocation sets labels and PodAffinity rules for this deployment so that pods
 // of the deployment will prefer to group with pods of the anchor deployment.
 func (c *DeploymentConfig) setColocation(hcp *hyperv1.HostedControlPlane) {
@@ -314,6 +346,7 @@ func (c *DeploymentConfig) setLocation(hcp *hyperv1.HostedControlPlane, multiZon
 	// TODO (alberto): pass labels with deployment hash and set this unconditionally so we don't skew setup.
 	if c.Replicas > 1 {
 		c.setMultizoneSpread(multiZoneSpreadLabels)
+		c.setTopologySpread(hcp, multiZoneSpreadLabels)
 	}
 }
 
@@ -77,6 +77,33 @@ func TestSetMultizoneSpread(t *testing.T) {
 	}
 }
 
+func TestSetTopologySpread(t *testing.T) {
+	labels := map[string]string{
+		"app":                         "etcd",
+		hyperv1.ControlPlaneComponent: "etcd",
+	}
+	hcp := &hyperv1.HostedControlPlane{}
+	hcp.Annotations = map[string]string{
+		hyperv1.TopologySpreadConstraintsAnnotation: "topology.kubernetes.io/zone=1,invalid-entry,bad-skew=x",
+	}
+	cfg := &DeploymentConfig{}
+	cfg.setTopologySpread(hcp, labels)
+	if len(cfg.Scheduling.TopologySpreadConstraints) != 1 {
+		t.Fatalf("Expecting exactly one topology spread constraint, got %d", len(cfg.Scheduling.TopologySpreadConstraints))
+	}
+	expectedConstraint := corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       "topology.kubernetes.io/zone",
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: labels,
+		},
+	}
+	if !reflect.DeepEqual(expectedConstraint, cfg.Scheduling.TopologySpreadConstraints[0]) {
+		t.Fatalf("Unexpected topology spread constraint")
+	}
+}
+
 func TestSetColocation(t *testing.T) {
 	hcp := &hyperv1.HostedControlPlane{}
 	hcp.Name = "hcp-name"
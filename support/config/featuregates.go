@@ -2,10 +2,37 @@ package config
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	configv1 "github.com/openshift/api/config/v1"
 )
 
+// featureGateRegexp matches a single gate=true|false pair. Gate names follow the
+// upstream convention of alphanumeric CamelCase identifiers.
+var featureGateRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*=(true|false)$`)
+
+// ValidateFeatureGates checks that every entry is a well formed gate=true|false
+// pair suitable for a kubernetes component's --feature-gates argument.
+func ValidateFeatureGates(featureGates []string) error {
+	for _, gate := range featureGates {
+		if !featureGateRegexp.MatchString(gate) {
+			return fmt.Errorf("invalid feature gate %q, must be of the form Name=true|false", gate)
+		}
+	}
+	return nil
+}
+
+// FeatureGatesFromAnnotation parses a comma-separated list of gate=true|false
+// pairs, as stored in the hypershift.openshift.io/ccm-feature-gates annotation.
+func FeatureGatesFromAnnotation(value string) ([]string, error) {
+	featureGates := strings.Split(value, ",")
+	if err := ValidateFeatureGates(featureGates); err != nil {
+		return nil, err
+	}
+	return featureGates, nil
+}
+
 func FeatureGates(fg *configv1.FeatureGateSelection) []string {
 	result := []string{}
 	var enabled, disabled []configv1.FeatureGateName
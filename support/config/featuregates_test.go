@@ -0,0 +1,47 @@
+package config
+
+import "testing"
+
+func TestValidateFeatureGates(t *testing.T) {
+	tests := []struct {
+		name         string
+		featureGates []string
+		expectError  bool
+	}{
+		{
+			name:         "valid gates",
+			featureGates: []string{"CloudDualStackNodeIPs=true", "SomeGate=false"},
+		},
+		{
+			name:         "missing value",
+			featureGates: []string{"CloudDualStackNodeIPs"},
+			expectError:  true,
+		},
+		{
+			name:         "invalid value",
+			featureGates: []string{"CloudDualStackNodeIPs=yes"},
+			expectError:  true,
+		},
+		{
+			name:         "invalid name",
+			featureGates: []string{"invalid gate=true"},
+			expectError:  true,
+		},
+		{
+			name:         "empty entry",
+			featureGates: []string{""},
+			expectError:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateFeatureGates(test.featureGates)
+			if test.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// Default leader election timing settings for the operator managers.
+	DefaultLeaseDuration = 60 * time.Second
+	DefaultRenewDeadline = 40 * time.Second
+	DefaultRetryPeriod   = 15 * time.Second
+
+	// minLeaseDuration is the lower bound accepted for the leader election
+	// lease duration; anything shorter risks constant lease churn.
+	minLeaseDuration = 10 * time.Second
+)
+
+// LeaderElectionConfig holds the configurable leader election timing settings
+// applied to an operator manager.
+type LeaderElectionConfig struct {
+	// LeaseDuration is the duration that non-leader candidates will wait to
+	// force acquire leadership.
+	LeaseDuration time.Duration
+
+	// RenewDeadline is the duration that the acting leader will retry
+	// refreshing leadership before giving up.
+	RenewDeadline time.Duration
+
+	// RetryPeriod is the duration the clients should wait between tries of
+	// actions.
+	RetryPeriod time.Duration
+}
+
+// DefaultLeaderElectionConfig returns the timing settings used when no
+// overrides are given.
+func DefaultLeaderElectionConfig() LeaderElectionConfig {
+	return LeaderElectionConfig{
+		LeaseDuration: DefaultLeaseDuration,
+		RenewDeadline: DefaultRenewDeadline,
+		RetryPeriod:   DefaultRetryPeriod,
+	}
+}
+
+// Validate enforces sane bounds on the timing settings.
+func (c LeaderElectionConfig) Validate() error {
+	if c.LeaseDuration < minLeaseDuration {
+		return fmt.Errorf("lease duration %s is below the minimum of %s", c.LeaseDuration, minLeaseDuration)
+	}
+	if c.RenewDeadline >= c.LeaseDuration {
+		return fmt.Errorf("renew deadline %s must be less than the lease duration %s", c.RenewDeadline, c.LeaseDuration)
+	}
+	if c.RetryPeriod <= 0 {
+		return fmt.Errorf("retry period %s must be greater than zero", c.RetryPeriod)
+	}
+	if c.RetryPeriod >= c.RenewDeadline {
+		return fmt.Errorf("retry period %s must be less than the renew deadline %s", c.RetryPeriod, c.RenewDeadline)
+	}
+	return nil
+}
+
+// ApplyTo sets the timing settings on the given manager options.
+func (c LeaderElectionConfig) ApplyTo(opts *ctrl.Options) {
+	leaseDuration := c.LeaseDuration
+	renewDeadline := c.RenewDeadline
+	retryPeriod := c.RetryPeriod
+	opts.LeaseDuration = &leaseDuration
+	opts.RenewDeadline = &renewDeadline
+	opts.RetryPeriod = &retryPeriod
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestLeaderElectionConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      LeaderElectionConfig
+		expectError bool
+	}{
+		{
+			name:   "defaults are valid",
+			config: DefaultLeaderElectionConfig(),
+		},
+		{
+			name: "lease duration below minimum",
+			config: LeaderElectionConfig{
+				LeaseDuration: 5 * time.Second,
+				RenewDeadline: 3 * time.Second,
+				RetryPeriod:   1 * time.Second,
+			},
+			expectError: true,
+		},
+		{
+			name: "renew deadline not less than lease duration",
+			config: LeaderElectionConfig{
+				LeaseDuration: 60 * time.Second,
+				RenewDeadline: 60 * time.Second,
+				RetryPeriod:   15 * time.Second,
+			},
+			expectError: true,
+		},
+		{
+			name: "retry period not less than renew deadline",
+			config: LeaderElectionConfig{
+				LeaseDuration: 60 * time.Second,
+				RenewDeadline: 40 * time.Second,
+				RetryPeriod:   40 * time.Second,
+			},
+			expectError: true,
+		},
+		{
+			name: "zero retry period",
+			config: LeaderElectionConfig{
+				LeaseDuration: 60 * time.Second,
+				RenewDeadline: 40 * time.Second,
+			},
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestLeaderElectionConfigApplyTo(t *testing.T) {
+	config := LeaderElectionConfig{
+		LeaseDuration: 137 * time.Second,
+		RenewDeadline: 107 * time.Second,
+		RetryPeriod:   26 * time.Second,
+	}
+	opts := &ctrl.Options{}
+	config.ApplyTo(opts)
+	if opts.LeaseDuration == nil || *opts.LeaseDuration != config.LeaseDuration {
+		t.Errorf("expected lease duration %s, got %v", config.LeaseDuration, opts.LeaseDuration)
+	}
+	if opts.RenewDeadline == nil || *opts.RenewDeadline != config.RenewDeadline {
+		t.Errorf("expected renew deadline %s, got %v", config.RenewDeadline, opts.RenewDeadline)
+	}
+	if opts.RetryPeriod == nil || *opts.RetryPeriod != config.RetryPeriod {
+		t.Errorf("expected retry period %s, got %v", config.RetryPeriod, opts.RetryPeriod)
+	}
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// Default reconcile tuning for the operator managers. The rate limiter
+	// defaults match the workqueue controller rate limiter.
+	DefaultResyncPeriod          = 10 * time.Hour
+	DefaultRateLimiterBaseDelay  = 5 * time.Millisecond
+	DefaultRateLimiterMaxDelay   = 1000 * time.Second
+	DefaultRateLimiterBucketSize = 100
+
+	// rateLimiterQPS is the steady-state reconcile rate allowed by the bucket
+	// rate limiter once the bucket is drained.
+	rateLimiterQPS = 10
+)
+
+// ReconcileConfig holds the configurable reconcile cadence settings applied to
+// an operator manager and its controllers.
+type ReconcileConfig struct {
+	// ResyncPeriod is the minimum interval at which watched resources are
+	// reconciled regardless of events.
+	ResyncPeriod time.Duration
+
+	// RateLimiterBaseDelay is the initial per-item requeue backoff.
+	RateLimiterBaseDelay time.Duration
+
+	// RateLimiterMaxDelay is the maximum per-item requeue backoff.
+	RateLimiterMaxDelay time.Duration
+
+	// RateLimiterBucketSize is the burst size of the overall token bucket
+	// limiting how many reconciles may be started at once.
+	RateLimiterBucketSize int
+}
+
+// DefaultReconcileConfig returns the reconcile cadence settings used when no
+// overrides are given.
+func DefaultReconcileConfig() ReconcileConfig {
+	return ReconcileConfig{
+		ResyncPeriod:          DefaultResyncPeriod,
+		RateLimiterBaseDelay:  DefaultRateLimiterBaseDelay,
+		RateLimiterMaxDelay:   DefaultRateLimiterMaxDelay,
+		RateLimiterBucketSize: DefaultRateLimiterBucketSize,
+	}
+}
+
+// Validate enforces sane bounds on the reconcile cadence settings.
+func (c ReconcileConfig) Validate() error {
+	if c.ResyncPeriod <= 0 {
+		return fmt.Errorf("resync period %s must be greater than zero", c.ResyncPeriod)
+	}
+	if c.RateLimiterBaseDelay <= 0 {
+		return fmt.Errorf("rate limiter base delay %s must be greater than zero", c.RateLimiterBaseDelay)
+	}
+	if c.RateLimiterMaxDelay < c.RateLimiterBaseDelay {
+		return fmt.Errorf("rate limiter max delay %s must not be less than the base delay %s", c.RateLimiterMaxDelay, c.RateLimiterBaseDelay)
+	}
+	if c.RateLimiterBucketSize < 1 {
+		return fmt.Errorf("rate limiter bucket size %d must be at least 1", c.RateLimiterBucketSize)
+	}
+	return nil
+}
+
+// RateLimiter returns a workqueue rate limiter combining the per-item
+// exponential backoff with an overall token bucket, mirroring the default
+// controller rate limiter but with the configured parameters.
+func (c ReconcileConfig) RateLimiter() workqueue.RateLimiter {
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(c.RateLimiterBaseDelay, c.RateLimiterMaxDelay),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(rateLimiterQPS), c.RateLimiterBucketSize)},
+	)
+}
+
+// ApplyTo sets the resync period on the given manager options.
+func (c ReconcileConfig) ApplyTo(opts *ctrl.Options) {
+	resyncPeriod := c.ResyncPeriod
+	opts.Cache.SyncPeriod = &resyncPeriod
+}
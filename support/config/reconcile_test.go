@@ -0,0 +1,100 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestReconcileConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      ReconcileConfig
+		expectError bool
+	}{
+		{
+			name:   "defaults are valid",
+			config: DefaultReconcileConfig(),
+		},
+		{
+			name: "zero resync period",
+			config: ReconcileConfig{
+				RateLimiterBaseDelay:  DefaultRateLimiterBaseDelay,
+				RateLimiterMaxDelay:   DefaultRateLimiterMaxDelay,
+				RateLimiterBucketSize: DefaultRateLimiterBucketSize,
+			},
+			expectError: true,
+		},
+		{
+			name: "zero base delay",
+			config: ReconcileConfig{
+				ResyncPeriod:          DefaultResyncPeriod,
+				RateLimiterMaxDelay:   DefaultRateLimiterMaxDelay,
+				RateLimiterBucketSize: DefaultRateLimiterBucketSize,
+			},
+			expectError: true,
+		},
+		{
+			name: "max delay less than base delay",
+			config: ReconcileConfig{
+				ResyncPeriod:          DefaultResyncPeriod,
+				RateLimiterBaseDelay:  time.Second,
+				RateLimiterMaxDelay:   time.Millisecond,
+				RateLimiterBucketSize: DefaultRateLimiterBucketSize,
+			},
+			expectError: true,
+		},
+		{
+			name: "zero bucket size",
+			config: ReconcileConfig{
+				ResyncPeriod:         DefaultResyncPeriod,
+				RateLimiterBaseDelay: DefaultRateLimiterBaseDelay,
+				RateLimiterMaxDelay:  DefaultRateLimiterMaxDelay,
+			},
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReconcileConfigApplyTo(t *testing.T) {
+	config := ReconcileConfig{
+		ResyncPeriod:          37 * time.Minute,
+		RateLimiterBaseDelay:  DefaultRateLimiterBaseDelay,
+		RateLimiterMaxDelay:   DefaultRateLimiterMaxDelay,
+		RateLimiterBucketSize: DefaultRateLimiterBucketSize,
+	}
+	opts := &ctrl.Options{}
+	config.ApplyTo(opts)
+	if opts.Cache.SyncPeriod == nil || *opts.Cache.SyncPeriod != config.ResyncPeriod {
+		t.Errorf("expected resync period %s, got %v", config.ResyncPeriod, opts.Cache.SyncPeriod)
+	}
+}
+
+func TestReconcileConfigRateLimiter(t *testing.T) {
+	config := ReconcileConfig{
+		ResyncPeriod:          DefaultResyncPeriod,
+		RateLimiterBaseDelay:  time.Second,
+		RateLimiterMaxDelay:   4 * time.Second,
+		RateLimiterBucketSize: 1000,
+	}
+	limiter := config.RateLimiter()
+	item := "item"
+	delays := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}
+	for i, expected := range delays {
+		if delay := limiter.When(item); delay != expected {
+			t.Errorf("retry %d: expected delay %s, got %s", i, expected, delay)
+		}
+	}
+}
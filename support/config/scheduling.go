@@ -5,10 +5,11 @@ import (
 )
 
 type Scheduling struct {
-	Affinity      *corev1.Affinity    `json:"affinity,omitempty"`
-	Tolerations   []corev1.Toleration `json:"tolerations,omitempty"`
-	PriorityClass string              `json:"priorityClass"`
-	NodeSelector  map[string]string   `json:"nodeSelector"`
+	Affinity                  *corev1.Affinity                  `json:"affinity,omitempty"`
+	Tolerations               []corev1.Toleration               `json:"tolerations,omitempty"`
+	PriorityClass             string                            `json:"priorityClass"`
+	NodeSelector              map[string]string                 `json:"nodeSelector"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 func (s *Scheduling) ApplyTo(podSpec *corev1.PodSpec) {
@@ -16,4 +17,5 @@ func (s *Scheduling) ApplyTo(podSpec *corev1.PodSpec) {
 	podSpec.Tolerations = s.Tolerations
 	podSpec.PriorityClassName = s.PriorityClass
 	podSpec.NodeSelector = s.NodeSelector
+	podSpec.TopologySpreadConstraints = s.TopologySpreadConstraints
 }
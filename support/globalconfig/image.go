@@ -1,10 +1,13 @@
 package globalconfig
 
 import (
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	configv1 "github.com/openshift/api/config/v1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 )
 
 func ImageConfig() *configv1.Image {
@@ -23,3 +26,49 @@ func ObservedImageConfig(ns string) *corev1.ConfigMap {
 		},
 	}
 }
+
+func ReconcileImageConfig(imageConfig *configv1.Image, hcp *hyperv1.HostedControlPlane) {
+	if hcp.Spec.Configuration == nil || hcp.Spec.Configuration.Image == nil {
+		return
+	}
+	spec := hcp.Spec.Configuration.Image.DeepCopy()
+	// ImageStream imports resolve through the cluster's mirrors, so the mirror
+	// registries need to be importable alongside the registries the user
+	// explicitly listed. Otherwise imports in disconnected clusters fail even
+	// though pulls through the mirror would succeed.
+	if len(spec.AllowedRegistriesForImport) > 0 {
+		for _, mirrorHost := range imageContentSourceMirrorHosts(hcp) {
+			if !registryLocationsContain(spec.AllowedRegistriesForImport, mirrorHost) {
+				spec.AllowedRegistriesForImport = append(spec.AllowedRegistriesForImport, configv1.RegistryLocation{DomainName: mirrorHost})
+			}
+		}
+	}
+	imageConfig.Spec = *spec
+}
+
+// imageContentSourceMirrorHosts returns the registry hostnames of all mirrors
+// configured through the hosted cluster's image content sources.
+func imageContentSourceMirrorHosts(hcp *hyperv1.HostedControlPlane) []string {
+	var hosts []string
+	for _, imageContentSource := range hcp.Spec.ImageContentSources {
+		for _, mirror := range imageContentSource.Mirrors {
+			host := mirror
+			if index := strings.Index(host, "/"); index >= 0 {
+				host = host[:index]
+			}
+			if len(host) > 0 {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+	return hosts
+}
+
+func registryLocationsContain(locations []configv1.RegistryLocation, domainName string) bool {
+	for _, location := range locations {
+		if location.DomainName == domainName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package globalconfig
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+func TestReconcileImageConfig(t *testing.T) {
+	testsCases := []struct {
+		name             string
+		inputHCP         *hyperv1.HostedControlPlane
+		expectedSpec     configv1.ImageSpec
+		expectUnmodified bool
+	}{
+		{
+			name:             "when no image configuration is specified then the image config is left alone",
+			inputHCP:         &hyperv1.HostedControlPlane{},
+			expectUnmodified: true,
+		},
+		{
+			name: "when image configuration is specified then it is copied to the image config",
+			inputHCP: &hyperv1.HostedControlPlane{
+				Spec: hyperv1.HostedControlPlaneSpec{
+					Configuration: &hyperv1.ClusterConfiguration{
+						Image: &configv1.ImageSpec{
+							RegistrySources: configv1.RegistrySources{
+								BlockedRegistries: []string{"badregistry.io"},
+							},
+						},
+					},
+				},
+			},
+			expectedSpec: configv1.ImageSpec{
+				RegistrySources: configv1.RegistrySources{
+					BlockedRegistries: []string{"badregistry.io"},
+				},
+			},
+		},
+		{
+			name: "when registries are allowed for import then mirror registries are allowed as well",
+			inputHCP: &hyperv1.HostedControlPlane{
+				Spec: hyperv1.HostedControlPlaneSpec{
+					Configuration: &hyperv1.ClusterConfiguration{
+						Image: &configv1.ImageSpec{
+							AllowedRegistriesForImport: []configv1.RegistryLocation{
+								{DomainName: "quay.io"},
+							},
+						},
+					},
+					ImageContentSources: []hyperv1.ImageContentSource{
+						{
+							Source:  "quay.io/openshift-release-dev/ocp-v4.0-art-dev",
+							Mirrors: []string{"mirror.example.com:5000/openshift"},
+						},
+					},
+				},
+			},
+			expectedSpec: configv1.ImageSpec{
+				AllowedRegistriesForImport: []configv1.RegistryLocation{
+					{DomainName: "quay.io"},
+					{DomainName: "mirror.example.com:5000"},
+				},
+			},
+		},
+		{
+			name: "when a mirror registry is already allowed for import then it is not duplicated",
+			inputHCP: &hyperv1.HostedControlPlane{
+				Spec: hyperv1.HostedControlPlaneSpec{
+					Configuration: &hyperv1.ClusterConfiguration{
+						Image: &configv1.ImageSpec{
+							AllowedRegistriesForImport: []configv1.RegistryLocation{
+								{DomainName: "mirror.example.com:5000"},
+							},
+						},
+					},
+					ImageContentSources: []hyperv1.ImageContentSource{
+						{
+							Source:  "quay.io/openshift-release-dev/ocp-v4.0-art-dev",
+							Mirrors: []string{"mirror.example.com:5000/openshift"},
+						},
+					},
+				},
+			},
+			expectedSpec: configv1.ImageSpec{
+				AllowedRegistriesForImport: []configv1.RegistryLocation{
+					{DomainName: "mirror.example.com:5000"},
+				},
+			},
+		},
+	}
+	for _, tc := range testsCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			imageConfig := ImageConfig()
+			ReconcileImageConfig(imageConfig, tc.inputHCP)
+			if tc.expectUnmodified {
+				g.Expect(imageConfig).To(BeEquivalentTo(ImageConfig()))
+				return
+			}
+			g.Expect(imageConfig.Spec).To(BeEquivalentTo(tc.expectedSpec))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package globalconfig
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ClusterImagePolicy returns an initialized ClusterImagePolicy. The type is
+// not part of the vendored openshift API yet, so the object is built
+// unstructured.
+func ClusterImagePolicy(name string) *unstructured.Unstructured {
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1alpha1",
+		Kind:    "ClusterImagePolicy",
+	})
+	policy.SetName(name)
+	return policy
+}
+
+// ReconcileClusterImagePolicy renders the given image signature policy from
+// the HostedCluster spec into a ClusterImagePolicy.
+func ReconcileClusterImagePolicy(policy *unstructured.Unstructured, spec hyperv1.ImageSignaturePolicy) error {
+	var rootOfTrust map[string]interface{}
+	switch {
+	case len(spec.PublicKey) > 0:
+		rootOfTrust = map[string]interface{}{
+			"policyType": "PublicKey",
+			"publicKey": map[string]interface{}{
+				"keyData": base64.StdEncoding.EncodeToString(spec.PublicKey),
+			},
+		}
+	case spec.Fulcio != nil:
+		rootOfTrust = map[string]interface{}{
+			"policyType": "FulcioCAWithRekor",
+			"fulcioCAWithRekor": map[string]interface{}{
+				"fulcioCAData": base64.StdEncoding.EncodeToString(spec.Fulcio.CAData),
+				"fulcioSubject": map[string]interface{}{
+					"oidcIssuer":  spec.Fulcio.OIDCIssuer,
+					"signedEmail": spec.Fulcio.SignedEmail,
+				},
+			},
+		}
+	default:
+		return fmt.Errorf("image signature policy %s has no root of trust", spec.Name)
+	}
+
+	scopes := make([]interface{}, 0, len(spec.Scopes))
+	for _, scope := range spec.Scopes {
+		scopes = append(scopes, scope)
+	}
+	policy.Object["spec"] = map[string]interface{}{
+		"scopes": scopes,
+		"policy": map[string]interface{}{
+			"rootOfTrust": rootOfTrust,
+		},
+	}
+	return nil
+}
@@ -75,6 +75,18 @@ func ReconcileInfrastructure(infra *configv1.Infrastructure, hcp *hyperv1.Hosted
 			CloudName:         configv1.AzurePublicCloud,
 			ResourceGroupName: hcp.Spec.Platform.Azure.ResourceGroupName,
 		}
+	case hyperv1.AgentPlatform:
+		if hcp.Spec.Platform.Agent != nil && (hcp.Spec.Platform.Agent.APIVIP != "" || hcp.Spec.Platform.Agent.IngressVIP != "") {
+			infra.Status.PlatformStatus.BareMetal = &configv1.BareMetalPlatformStatus{}
+			if apiVIP := hcp.Spec.Platform.Agent.APIVIP; apiVIP != "" {
+				infra.Status.PlatformStatus.BareMetal.APIServerInternalIP = apiVIP
+				infra.Status.PlatformStatus.BareMetal.APIServerInternalIPs = []string{apiVIP}
+			}
+			if ingressVIP := hcp.Spec.Platform.Agent.IngressVIP; ingressVIP != "" {
+				infra.Status.PlatformStatus.BareMetal.IngressIP = ingressVIP
+				infra.Status.PlatformStatus.BareMetal.IngressIPs = []string{ingressVIP}
+			}
+		}
 	case hyperv1.PowerVSPlatform:
 		infra.Status.PlatformStatus.PowerVS = &configv1.PowerVSPlatformStatus{
 			Region:         hcp.Spec.Platform.PowerVS.Region,
@@ -0,0 +1,23 @@
+package globalconfig
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1 "github.com/openshift/api/config/v1"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+)
+
+func OperatorHubConfig() *configv1.OperatorHub {
+	return &configv1.OperatorHub{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster",
+		},
+	}
+}
+
+func ReconcileOperatorHubConfig(operatorHub *configv1.OperatorHub, hcp *hyperv1.HostedControlPlane) {
+	if hcp.Spec.Configuration == nil || hcp.Spec.Configuration.OperatorHub == nil {
+		return
+	}
+	hcp.Spec.Configuration.OperatorHub.DeepCopyInto(&operatorHub.Spec)
+}
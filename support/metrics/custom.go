@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	prometheusoperatorv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// CustomRelabelConfigs converts the metric relabeling rules configured on the
+// hosted cluster into prometheus-operator RelabelConfigs so they can be
+// appended to the scrape endpoints of the control plane ServiceMonitors.
+func CustomRelabelConfigs(configs []hyperv1.MetricsRelabelConfig) []*prometheusoperatorv1.RelabelConfig {
+	var relabelConfigs []*prometheusoperatorv1.RelabelConfig
+	for _, config := range configs {
+		relabelConfig := &prometheusoperatorv1.RelabelConfig{
+			Separator:   config.Separator,
+			Regex:       config.Regex,
+			TargetLabel: config.TargetLabel,
+			Replacement: config.Replacement,
+			Action:      config.Action,
+		}
+		for _, sourceLabel := range config.SourceLabels {
+			relabelConfig.SourceLabels = append(relabelConfig.SourceLabels, prometheusoperatorv1.LabelName(sourceLabel))
+		}
+		relabelConfigs = append(relabelConfigs, relabelConfig)
+	}
+	return relabelConfigs
+}
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	hyperapi "github.com/openshift/hypershift/support/api"
+)
+
+// ParseGuestRBACManifests decodes the entries of a guest RBAC manifests
+// ConfigMap and ensures every entry is a ClusterRole or ClusterRoleBinding.
+// Each entry must contain a single manifest. The objects are returned sorted
+// by entry key so that callers apply them in a deterministic order.
+func ParseGuestRBACManifests(manifests map[string]string) ([]crclient.Object, error) {
+	keys := make([]string, 0, len(manifests))
+	for key := range manifests {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var objects []crclient.Object
+	for _, key := range keys {
+		obj, _, err := hyperapi.YamlSerializer.Decode([]byte(manifests[key]), nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode manifest %q: %w", key, err)
+		}
+		switch obj.(type) {
+		case *rbacv1.ClusterRole, *rbacv1.ClusterRoleBinding:
+			objects = append(objects, obj.(crclient.Object))
+		default:
+			return nil, fmt.Errorf("manifest %q has unsupported kind %T, only ClusterRole and ClusterRoleBinding manifests are allowed", key, obj)
+		}
+	}
+	return objects, nil
+}
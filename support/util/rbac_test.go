@@ -0,0 +1,85 @@
+package util
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseGuestRBACManifests(t *testing.T) {
+	clusterRole := `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-reader
+rules:
+- apiGroups: [""]
+  resources: ["configmaps"]
+  verbs: ["get", "list", "watch"]
+`
+	clusterRoleBinding := `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: example-reader-binding
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: example-reader
+subjects:
+- kind: Group
+  name: example-group
+`
+	configMap := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: not-rbac
+`
+	testCases := []struct {
+		name          string
+		manifests     map[string]string
+		expectedNames []string
+		expectError   bool
+	}{
+		{
+			name: "cluster role and binding are returned sorted by key",
+			manifests: map[string]string{
+				"20-binding.yaml": clusterRoleBinding,
+				"10-role.yaml":    clusterRole,
+			},
+			expectedNames: []string{"example-reader", "example-reader-binding"},
+		},
+		{
+			name:          "empty input yields no objects",
+			manifests:     map[string]string{},
+			expectedNames: []string{},
+		},
+		{
+			name: "non rbac kind is rejected",
+			manifests: map[string]string{
+				"configmap.yaml": configMap,
+			},
+			expectError: true,
+		},
+		{
+			name: "unparseable manifest is rejected",
+			manifests: map[string]string{
+				"garbage.yaml": "not: [valid",
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			objects, err := ParseGuestRBACManifests(tc.manifests)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(objects).To(HaveLen(len(tc.expectedNames)))
+			for i, name := range tc.expectedNames {
+				g.Expect(objects[i].GetName()).To(Equal(name))
+			}
+		})
+	}
+}
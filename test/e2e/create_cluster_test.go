@@ -90,6 +90,75 @@ func TestCreateCluster(t *testing.T) {
 				t.Fatalf("did not get correct SSAR response: %#v", response)
 			}
 		})
+
+		e2eutil.EnsureBreakGlassSignerScoping(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureSharedVPC(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureNodePoolCustomUserData(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureEncryptionProvider(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureMixedInstanceTypes(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureGuestDefaultDeny(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureKASTuning(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureSerialNodePoolRollout(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureNodePIDLimits(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureControlPlanePodDeletionRecovery(t, ctx, mgtClient, hostedCluster, "")
+		e2eutil.EnsureCCMFeatureGates(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureAPIAudiences(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureGuestLimitRange(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureControlPlanePodDisruptionBudgets(t, ctx, mgtClient, hostedCluster)
+
+		guestClient := e2eutil.WaitForGuestClient(t, ctx, mgtClient, hostedCluster)
+		e2eutil.EnsureInfrastructureVIPs(t, ctx, guestClient, hostedCluster)
+		e2eutil.EnsureAuditLogForwarding(t, ctx, guestClient, hostedCluster)
+		e2eutil.EnsureClockSync(t, ctx, mgtClient, guestClient, hostedCluster)
+		e2eutil.EnsureScaleFromZero(t, ctx, mgtClient, guestClient, hostedCluster, globalOpts.LatestReleaseImage)
+
+		nodePoolList := &hyperv1.NodePoolList{}
+		if err := mgtClient.List(ctx, nodePoolList, crclient.InNamespace(hostedCluster.Namespace)); err != nil {
+			t.Fatalf("failed to list nodepools: %v", err)
+		}
+		for i := range nodePoolList.Items {
+			nodePool := &nodePoolList.Items[i]
+			if nodePool.Spec.ClusterName != hostedCluster.Name {
+				continue
+			}
+			e2eutil.EnsureTimeSyncPersists(t, ctx, mgtClient, guestClient, hostedCluster, nodePool)
+			e2eutil.EnsureNodePoolLabelsAndTaints(t, ctx, guestClient, hostedCluster, nodePool)
+		}
+
+		if globalOpts.Platform == hyperv1.AWSPlatform {
+			ec2client := ec2Client(globalOpts.configurableClusterOptions.AWSCredentialsFile, globalOpts.configurableClusterOptions.Region)
+			e2eutil.EnsurePlacementGroup(t, ctx, mgtClient, ec2client, hostedCluster)
+			e2eutil.EnsureTenancy(t, ctx, mgtClient, ec2client, hostedCluster)
+		}
+
+		e2eutil.EnsureHostedClusterConditions(t, ctx, mgtClient, hostedCluster, nil)
+
+		e2eutil.EnsureAPIServerSNIRouting(t, ctx, mgtClient, hostedCluster)
+
+		e2eutil.EnsureAPILoadBalancer(t, ctx, mgtClient, hostedCluster)
+
+		if globalOpts.DisruptManagementEtcd {
+			e2eutil.EnsureSurvivesManagementEtcdRestart(t, ctx, mgtClient, hostedCluster)
+		}
+
+		if globalOpts.TestCredentialRotation {
+			e2eutil.EnsureAWSCredentialRotation(t, ctx, mgtClient, hostedCluster, globalOpts.configurableClusterOptions.AWSCredentialsFile, globalOpts.configurableClusterOptions.Region)
+		}
+
+		if globalOpts.ImagePolicySignedImage != "" || globalOpts.ImagePolicyUnsignedImage != "" {
+			guestClient := e2eutil.WaitForGuestClient(t, ctx, mgtClient, hostedCluster)
+			e2eutil.EnsureImageSignaturePolicy(t, ctx, guestClient, hostedCluster, globalOpts.ImagePolicySignedImage, globalOpts.ImagePolicyUnsignedImage)
+		}
+
+		if clusterOpts.DisableTelemetry {
+			guestClient := e2eutil.WaitForGuestClient(t, ctx, mgtClient, hostedCluster)
+			e2eutil.EnsureTelemetryDisabled(t, ctx, guestClient, hostedCluster)
+		}
+
+		if len(clusterOpts.GuestRBACManifests) > 0 {
+			guestClient := e2eutil.WaitForGuestClient(t, ctx, mgtClient, hostedCluster)
+			e2eutil.EnsureGuestRBACBootstrap(t, ctx, mgtClient, guestClient, hostedCluster)
+		}
 	}).
 		Execute(&clusterOpts, globalOpts.Platform, globalOpts.ArtifactDir, globalOpts.ServiceAccountSigningKey)
 }
@@ -198,6 +267,23 @@ func TestCreateClusterProxy(t *testing.T) {
 		Execute(&clusterOpts, globalOpts.Platform, globalOpts.ArtifactDir, globalOpts.ServiceAccountSigningKey)
 }
 
+// TestCreateClusterProxyAuthenticated is a variant of TestCreateClusterProxy in which
+// the proxy requires basic auth credentials, as is common with corporate proxies.
+func TestCreateClusterProxyAuthenticated(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(testContext)
+	defer cancel()
+
+	clusterOpts := globalOpts.DefaultClusterOptions(t)
+	clusterOpts.AWSPlatform.EnableProxy = true
+	clusterOpts.AWSPlatform.ProxyUsername = "hypershift"
+	clusterOpts.AWSPlatform.ProxyPassword = e2eutil.SimpleNameGenerator.GenerateName("")
+	clusterOpts.ControlPlaneAvailabilityPolicy = string(hyperv1.SingleReplica)
+
+	e2eutil.NewHypershiftTest(t, ctx, nil).
+		Execute(&clusterOpts, globalOpts.Platform, globalOpts.ArtifactDir, globalOpts.ServiceAccountSigningKey)
+}
+
 // TestCreateClusterPrivate implements a smoke test that creates a private cluster.
 // Validations requiring guest cluster client are dropped here since the kas is not accessible when private.
 // In the future we might want to leverage https://issues.redhat.com/browse/HOSTEDCP-697 to access guest cluster.
@@ -108,6 +108,11 @@ func TestMain(m *testing.M) {
 	flag.BoolVar(&globalOpts.SkipAPIBudgetVerification, "e2e.skip-api-budget", false, "Bool to avoid send metrics to E2E Server on local test execution.")
 	flag.StringVar(&globalOpts.configurableClusterOptions.EtcdStorageClass, "e2e.etcd-storage-class", "", "The persistent volume storage class for etcd data volumes")
 	flag.BoolVar(&globalOpts.RequestServingIsolation, "e2e.test-request-serving-isolation", false, "If set, TestCreate creates a cluster with request serving isolation topology")
+	flag.BoolVar(&globalOpts.DisruptManagementEtcd, "e2e.test-management-etcd-restart", false, "If set, TestCreateCluster restarts the management cluster etcd and verifies hosted clusters survive the disruption. Only safe on dedicated management clusters")
+	flag.BoolVar(&globalOpts.TestCredentialRotation, "e2e.test-credential-rotation", false, "If set, TestCreateCluster rotates the hosted cluster cloud credentials and verifies the cluster keeps functioning (AWS only)")
+	flag.Int64Var(&e2eutil.FailureDiagnosticsTailLines, "e2e.failure-diagnostics-log-lines", e2eutil.FailureDiagnosticsTailLines, "The number of log lines captured from each control plane container in the test output when a test fails")
+	flag.StringVar(&globalOpts.ImagePolicySignedImage, "e2e.image-policy-signed-image", "", "An image signed for the configured image signature policy, used to verify signature enforcement")
+	flag.StringVar(&globalOpts.ImagePolicyUnsignedImage, "e2e.image-policy-unsigned-image", "", "An unsigned image within the configured image signature policy scope, used to verify signature enforcement")
 	flag.StringVar(&globalOpts.ManagementParentKubeconfig, "e2e.management-parent-kubeconfig", "", "Kubeconfig of the management cluster's parent cluster (required to test request serving isolation)")
 	flag.StringVar(&globalOpts.ManagementClusterNamespace, "e2e.management-cluster-namespace", "", "Namespace of the management cluster's HostedCluster (required to test request serving isolation)")
 	flag.StringVar(&globalOpts.ManagementClusterName, "e2e.management-cluster-name", "", "Name of the management cluster's HostedCluster (required to test request serving isolation)")
@@ -372,6 +377,20 @@ type options struct {
 	// isolation topology.
 	RequestServingIsolation bool
 
+	// If set, TestCreateCluster restarts the management cluster etcd and
+	// verifies the hosted cluster survives the disruption. Only safe on
+	// dedicated management clusters.
+	DisruptManagementEtcd bool
+
+	// If set, TestCreateCluster rotates the hosted cluster cloud credentials
+	// and verifies the cluster keeps functioning. AWS only.
+	TestCredentialRotation bool
+
+	// A signed and an unsigned image used to verify image signature
+	// enforcement when the cluster is created with an image signature policy.
+	ImagePolicySignedImage   string
+	ImagePolicyUnsignedImage string
+
 	// If testing request serving isolation topology, we need a kubeconfig to the
 	// parent of the management cluster, name and namespace of the management cluster
 	// so we can create additional nodepools for it.
@@ -0,0 +1,164 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	ignitionapi "github.com/coreos/ignition/v2/config/v3_2/types"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	hyperapi "github.com/openshift/hypershift/support/api"
+	e2eutil "github.com/openshift/hypershift/test/e2e/util"
+	mcfgv1 "github.com/openshift/hypershift/thirdparty/machineconfigoperator/pkg/apis/machineconfiguration.openshift.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilpointer "k8s.io/utils/pointer"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+// nodePoolCurrentConfigAnnotation mirrors the annotation the NodePool controller
+// uses to track the config that has been rolled out.
+const nodePoolCurrentConfigAnnotation = "hypershift.openshift.io/nodePoolCurrentConfig"
+
+type NodePoolMachineconfigPauseTest struct {
+	ctx        context.Context
+	mgmtClient crclient.Client
+
+	hostedCluster *hyperv1.HostedCluster
+}
+
+func NewNodePoolMachineconfigPauseTest(ctx context.Context, mgmtClient crclient.Client, hostedCluster *hyperv1.HostedCluster) *NodePoolMachineconfigPauseTest {
+	return &NodePoolMachineconfigPauseTest{
+		ctx:           ctx,
+		mgmtClient:    mgmtClient,
+		hostedCluster: hostedCluster,
+	}
+}
+
+func (mp *NodePoolMachineconfigPauseTest) Setup(t *testing.T) {
+	t.Log("Starting test NodePoolMachineconfigPauseTest")
+}
+
+func (mp *NodePoolMachineconfigPauseTest) BuildNodePoolManifest(defaultNodepool hyperv1.NodePool) (*hyperv1.NodePool, error) {
+	nodePool := &hyperv1.NodePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mp.hostedCluster.Name + "-" + "test-machineconfig-pause",
+			Namespace: mp.hostedCluster.Namespace,
+			Annotations: map[string]string{
+				hyperv1.NodePoolPauseMachineConfigUpdatesAnnotation: "true",
+			},
+		},
+	}
+	defaultNodepool.Spec.DeepCopyInto(&nodePool.Spec)
+	nodePool.Spec.Replicas = &oneReplicas
+
+	return nodePool, nil
+}
+
+func (mp *NodePoolMachineconfigPauseTest) Run(t *testing.T, nodePool hyperv1.NodePool, nodes []corev1.Node) {
+	g := NewWithT(t)
+	ctx := mp.ctx
+
+	ignitionConfig := ignitionapi.Config{
+		Ignition: ignitionapi.Ignition{
+			Version: "3.2.0",
+		},
+		Storage: ignitionapi.Storage{
+			Files: []ignitionapi.File{{
+				Node:          ignitionapi.Node{Path: "/etc/pause-test-config"},
+				FileEmbedded1: ignitionapi.FileEmbedded1{Contents: ignitionapi.Resource{Source: utilpointer.String("data:,content%0A")}},
+			}},
+		},
+	}
+	serializedIgnitionConfig, err := json.Marshal(ignitionConfig)
+	g.Expect(err).NotTo(HaveOccurred(), "failed to serialize ignition config")
+	machineConfig := &mcfgv1.MachineConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "pause-test",
+			Labels: map[string]string{"machineconfiguration.openshift.io/role": "worker"},
+		},
+		Spec: mcfgv1.MachineConfigSpec{Config: runtime.RawExtension{Raw: serializedIgnitionConfig}},
+	}
+	gvk, err := apiutil.GVKForObject(machineConfig, hyperapi.Scheme)
+	g.Expect(err).NotTo(HaveOccurred(), "failed to get typeinfo for MachineConfig from scheme")
+	machineConfig.SetGroupVersionKind(gvk)
+	serializedMachineConfig, err := yaml.Marshal(machineConfig)
+	g.Expect(err).NotTo(HaveOccurred(), "failed to serialize machineConfig")
+	machineConfigConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pause-test-machine-config",
+			Namespace: mp.hostedCluster.Namespace,
+		},
+		Data: map[string]string{"config": string(serializedMachineConfig)},
+	}
+	if err := mp.mgmtClient.Create(ctx, machineConfigConfigMap); err != nil {
+		t.Fatalf("failed to create configmap for pause-test machineconfig: %v", err)
+	}
+
+	currentConfig := nodePool.Annotations[nodePoolCurrentConfigAnnotation]
+	g.Expect(currentConfig).NotTo(BeEmpty(), "expected an initial config to have rolled out")
+
+	np := nodePool.DeepCopy()
+	nodePool.Spec.Config = append(nodePool.Spec.Config, corev1.LocalObjectReference{Name: machineConfigConfigMap.Name})
+	if err := mp.mgmtClient.Patch(ctx, &nodePool, crclient.MergeFrom(np)); err != nil {
+		t.Fatalf("failed to update nodepool %s after adding machineconfig: %v", nodePool.Name, err)
+	}
+
+	t.Logf("waiting for the NodePool to report machine config updates as paused")
+	err = wait.PollImmediateWithContext(ctx, 10*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
+		if err := mp.mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(&nodePool), &nodePool); err != nil {
+			t.Logf("WARNING: failed to get nodepool, will retry: %v", err)
+			return false, nil
+		}
+		for _, condition := range nodePool.Status.Conditions {
+			if condition.Type == hyperv1.NodePoolMachineConfigUpdatesPausedConditionType && condition.Status == corev1.ConditionTrue {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	g.Expect(err).NotTo(HaveOccurred(), "NodePool never reported MachineConfigUpdatesPaused")
+
+	// Give the controller time to act, then verify the config was not rolled out
+	// while paused.
+	time.Sleep(1 * time.Minute)
+	err = mp.mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(&nodePool), &nodePool)
+	g.Expect(err).NotTo(HaveOccurred(), "failed to get nodepool")
+	g.Expect(nodePool.Annotations[nodePoolCurrentConfigAnnotation]).To(Equal(currentConfig), "config was rolled out while machine config updates were paused")
+
+	t.Logf("unpausing machine config updates")
+	np = nodePool.DeepCopy()
+	delete(nodePool.Annotations, hyperv1.NodePoolPauseMachineConfigUpdatesAnnotation)
+	if err := mp.mgmtClient.Patch(ctx, &nodePool, crclient.MergeFrom(np)); err != nil {
+		t.Fatalf("failed to remove pause annotation from nodepool %s: %v", nodePool.Name, err)
+	}
+
+	timeout := 15 * time.Minute
+	if nodePool.Spec.Platform.Type == hyperv1.KubevirtPlatform {
+		timeout = 25 * time.Minute
+	}
+	t.Logf("waiting for the pending config to roll out")
+	err = wait.PollImmediateWithContext(ctx, 10*time.Second, timeout, func(ctx context.Context) (bool, error) {
+		if err := mp.mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(&nodePool), &nodePool); err != nil {
+			t.Logf("WARNING: failed to get nodepool, will retry: %v", err)
+			return false, nil
+		}
+		return nodePool.Annotations[nodePoolCurrentConfigAnnotation] != currentConfig, nil
+	})
+	g.Expect(err).NotTo(HaveOccurred(), "pending config never rolled out after unpausing")
+
+	e2eutil.WaitForNodePoolConditionsNotToBePresent(t, ctx, mp.mgmtClient, &nodePool,
+		hyperv1.NodePoolMachineConfigUpdatesPausedConditionType, hyperv1.NodePoolUpdatingConfigConditionType)
+
+	e2eutil.EnsureNoCrashingPods(t, ctx, mp.mgmtClient, mp.hostedCluster)
+}
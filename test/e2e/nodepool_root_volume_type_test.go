@@ -0,0 +1,82 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/cmd/cluster/core"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/gomega"
+)
+
+// DefaultRootVolumeTypeTest verifies that a NodePool created without any root
+// volume configuration provisions gp3 root volumes, exercising the operator's
+// default rather than the CLI's.
+type DefaultRootVolumeTypeTest struct {
+	hostedCluster *hyperv1.HostedCluster
+	clusterOpts   core.CreateOptions
+}
+
+func NewDefaultRootVolumeTypeTest(hostedCluster *hyperv1.HostedCluster, clusterOpts core.CreateOptions) *DefaultRootVolumeTypeTest {
+	return &DefaultRootVolumeTypeTest{
+		hostedCluster: hostedCluster,
+		clusterOpts:   clusterOpts,
+	}
+}
+
+func (k *DefaultRootVolumeTypeTest) Setup(t *testing.T) {
+	if globalOpts.Platform != hyperv1.AWSPlatform {
+		t.Skip("test only supported on platform AWS")
+	}
+
+	t.Log("Starting test DefaultRootVolumeTypeTest")
+}
+
+func (k *DefaultRootVolumeTypeTest) BuildNodePoolManifest(defaultNodepool hyperv1.NodePool) (*hyperv1.NodePool, error) {
+	nodePool := &hyperv1.NodePool{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      k.hostedCluster.Name + "-" + "test-default-root-volume-type",
+			Namespace: k.hostedCluster.Namespace,
+		},
+	}
+	defaultNodepool.Spec.DeepCopyInto(&nodePool.Spec)
+
+	nodePool.Spec.Replicas = &oneReplicas
+	nodePool.Spec.Platform.AWS.RootVolume = nil
+
+	return nodePool, nil
+}
+
+func (k *DefaultRootVolumeTypeTest) Run(t *testing.T, nodePool hyperv1.NodePool, nodes []corev1.Node) {
+	g := NewWithT(t)
+
+	providerID := nodes[0].Spec.ProviderID
+	g.Expect(providerID).NotTo(BeEmpty())
+
+	instanceID := providerID[strings.LastIndex(providerID, "/")+1:]
+	t.Logf("instanceID: %s", instanceID)
+
+	ec2client := ec2Client(k.clusterOpts.AWSPlatform.AWSCredentialsFile, k.clusterOpts.AWSPlatform.Region)
+	output, err := ec2client.DescribeVolumes(&ec2.DescribeVolumesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.instance-id"),
+				Values: aws.StringSlice([]string{instanceID}),
+			},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(output).NotTo(BeNil())
+	g.Expect(output.Volumes).NotTo(BeEmpty())
+
+	rootVolume := output.Volumes[0]
+	g.Expect(rootVolume.VolumeType).To(HaveValue(Equal("gp3")))
+}
@@ -62,6 +62,10 @@ func TestNodePool(t *testing.T) {
 				name: "TestKMSRootVolumeEncryption",
 				test: NewKMSRootVolumeTest(hostedCluster, clusterOpts),
 			},
+			{
+				name: "TestNodePoolDefaultRootVolumeType",
+				test: NewDefaultRootVolumeTypeTest(hostedCluster, clusterOpts),
+			},
 			{
 				name: "TestNodePoolAutoRepair",
 				test: NewNodePoolAutoRepairTest(ctx, hostedCluster, hostedClusterClient, clusterOpts),
@@ -70,6 +74,10 @@ func TestNodePool(t *testing.T) {
 				name: "TestNodepoolMachineconfigGetsRolledout",
 				test: NewNodePoolMachineconfigRolloutTest(ctx, mgtClient, hostedCluster, hostedClusterClient, clusterOpts),
 			},
+			{
+				name: "TestNodepoolMachineconfigUpdatesPaused",
+				test: NewNodePoolMachineconfigPauseTest(ctx, mgtClient, hostedCluster),
+			},
 			{
 				name: "TestNTOMachineConfigGetsRolledOut",
 				test: NewNTOMachineConfigRolloutTest(ctx, mgtClient, hostedCluster, hostedClusterClient, false),
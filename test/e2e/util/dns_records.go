@@ -0,0 +1,117 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	awsutil "github.com/openshift/hypershift/cmd/infra/aws/util"
+)
+
+// EnsureDNSRecordsCleanedUp verifies that the external-DNS records of a hosted
+// cluster no longer exist in the DNS provider after the cluster was destroyed.
+// Stale API or ingress records confuse later cluster creations reusing the
+// same name. Only AWS Route 53 is supported for now; other platforms are a
+// no-op. It is meant to run from teardown, so it does not use subtests.
+func EnsureDNSRecordsCleanedUp(t *testing.T, ctx context.Context, hostedCluster *hyperv1.HostedCluster, awsCredentialsFile string, awsRegion string) {
+	if hostedCluster.Spec.Platform.Type != hyperv1.AWSPlatform || awsCredentialsFile == "" {
+		return
+	}
+	baseDomain := hostedCluster.Spec.DNS.BaseDomain
+	if baseDomain == "" {
+		return
+	}
+	clusterDomain := fmt.Sprintf("%s.%s", hostedCluster.Name, baseDomain)
+	if prefix := hostedCluster.Spec.DNS.BaseDomainPrefix; prefix != nil {
+		if *prefix == "" {
+			clusterDomain = baseDomain
+		} else {
+			clusterDomain = fmt.Sprintf("%s.%s", *prefix, baseDomain)
+		}
+	}
+
+	awsSession := awsutil.NewSession("e2e-dns-records-cleanup", awsCredentialsFile, "", "", awsRegion)
+	route53Client := route53.New(awsSession, awsutil.NewAWSRoute53Config())
+
+	zoneID, err := lookupPublicZoneID(ctx, route53Client, baseDomain)
+	if err != nil {
+		t.Logf("Failed to look up public zone for base domain %s; skipping DNS record cleanup check: %v", baseDomain, err)
+		return
+	}
+	if zoneID == "" {
+		t.Logf("No public zone found for base domain %s; skipping DNS record cleanup check", baseDomain)
+		return
+	}
+
+	var leftoverRecords []string
+	for _, recordName := range []string{
+		fmt.Sprintf("api.%s", clusterDomain),
+		fmt.Sprintf("*.apps.%s", clusterDomain),
+	} {
+		exists, err := recordExists(ctx, route53Client, zoneID, recordName)
+		if err != nil {
+			t.Errorf("Failed to check DNS record %s: %v", recordName, err)
+			continue
+		}
+		if exists {
+			leftoverRecords = append(leftoverRecords, recordName)
+		}
+	}
+	if len(leftoverRecords) > 0 {
+		t.Errorf("DNS records not cleaned up after cluster deletion: %s", strings.Join(leftoverRecords, ", "))
+	} else {
+		t.Logf("Verified DNS records of cluster %s were cleaned up", hostedCluster.Name)
+	}
+}
+
+// lookupPublicZoneID returns the ID of the public hosted zone matching name,
+// or an empty string if no such zone exists.
+func lookupPublicZoneID(ctx context.Context, client *route53.Route53, name string) (string, error) {
+	var id string
+	f := func(resp *route53.ListHostedZonesOutput, lastPage bool) (shouldContinue bool) {
+		for _, zone := range resp.HostedZones {
+			if zone.Config != nil && aws.BoolValue(zone.Config.PrivateZone) {
+				continue
+			}
+			if normalizeRecordName(aws.StringValue(zone.Name)) == name {
+				id = aws.StringValue(zone.Id)
+				return false
+			}
+		}
+		return true
+	}
+	if err := client.ListHostedZonesPagesWithContext(ctx, &route53.ListHostedZonesInput{}, f); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// recordExists reports whether a record set with the given name exists in the
+// zone, regardless of record type.
+func recordExists(ctx context.Context, client *route53.Route53, zoneID string, name string) (bool, error) {
+	resp, err := client.ListResourceRecordSetsWithContext(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(name),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, recordSet := range resp.ResourceRecordSets {
+		if normalizeRecordName(aws.StringValue(recordSet.Name)) == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// normalizeRecordName converts a Route 53 record name to its plain form:
+// the trailing dot is removed and the octal escape for the wildcard label is
+// decoded.
+func normalizeRecordName(name string) string {
+	return strings.Replace(strings.TrimSuffix(name, "."), "\\052", "*", 1)
+}
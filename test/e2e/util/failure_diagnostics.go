@@ -0,0 +1,69 @@
+package util
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	"github.com/openshift/hypershift/hypershift-operator/controllers/manifests"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// FailureDiagnosticsTailLines is the number of log lines captured from each
+// control plane container when a test fails. It is configurable through the
+// -e2e.failure-diagnostics-log-lines flag.
+var FailureDiagnosticsTailLines int64 = 50
+
+// LogControlPlaneDiagnostics writes the last FailureDiagnosticsTailLines log
+// lines of every control plane container and the recent events of the control
+// plane namespace to the test output, so the usual suspects of a failed run
+// can be triaged without digging through dumped artifacts.
+func LogControlPlaneDiagnostics(t *testing.T, ctx context.Context, hostedCluster *hyperv1.HostedCluster) {
+	config, err := GetConfig()
+	if err != nil {
+		t.Logf("failed to get config for control plane diagnostics; this is nonfatal: %v", err)
+		return
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Logf("failed to create client for control plane diagnostics; this is nonfatal: %v", err)
+		return
+	}
+	namespace := manifests.HostedControlPlaneNamespaceFor(hostedCluster)
+
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Logf("failed to list control plane pods for diagnostics; this is nonfatal: %v", err)
+		return
+	}
+	tailLines := FailureDiagnosticsTailLines
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			logs, err := clientset.CoreV1().Pods(namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container: container.Name,
+				TailLines: &tailLines,
+			}).DoRaw(ctx)
+			if err != nil {
+				t.Logf("failed to get logs of container %s in pod %s/%s; this is nonfatal: %v", container.Name, namespace, pod.Name, err)
+				continue
+			}
+			t.Logf("=== Last %d log lines of %s/%s container %s ===\n%s", tailLines, namespace, pod.Name, container.Name, logs)
+		}
+	}
+
+	events, err := clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Logf("failed to list control plane events for diagnostics; this is nonfatal: %v", err)
+		return
+	}
+	sort.Slice(events.Items, func(i, j int) bool {
+		return events.Items[i].LastTimestamp.Before(&events.Items[j].LastTimestamp)
+	})
+	t.Logf("=== Recent events in namespace %s ===", namespace)
+	for _, event := range events.Items {
+		t.Logf("%s %s %s %s/%s: %s", event.LastTimestamp.Format("2006-01-02T15:04:05Z"), event.Type, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message)
+	}
+}
@@ -259,6 +259,13 @@ func (h *hypershiftTest) teardownHostedCluster(ctx context.Context, hc *hyperv1.
 	// we should pass dumpDir to the dumpCluster() as <artifactDir>/<testName>_<suffix>
 	dumpCluster := newClusterDumper(hc, opts, artifactDir)
 
+	// On failure, surface the tail of the control plane component logs and the
+	// recent control plane events directly in the test output before dumping,
+	// so triage does not require pulling artifacts.
+	if h.Failed() {
+		LogControlPlaneDiagnostics(h.T, ctx, hc)
+	}
+
 	// First, do a dump of the cluster before tearing it down
 	err := dumpCluster(ctx, h.T, true)
 	if err != nil {
@@ -302,6 +309,7 @@ func (h *hypershiftTest) teardownHostedCluster(ctx context.Context, hc *hyperv1.
 		h.Errorf("Failed to destroy cluster: %v", err)
 	} else {
 		h.Logf("Destroyed cluster. Namespace: %s, name: %s", hc.Namespace, hc.Name)
+		EnsureDNSRecordsCleanedUp(h.T, ctx, hc, opts.AWSPlatform.AWSCredentialsFile, opts.AWSPlatform.Region)
 	}
 
 	// Finally, delete the test namespace containing the HostedCluster/NodePool
@@ -2,16 +2,21 @@ package util
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
+	"github.com/openshift/hypershift/api"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+	mcfgv1 "github.com/openshift/hypershift/thirdparty/machineconfigoperator/pkg/apis/machineconfiguration.openshift.io/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/pointer"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -45,6 +50,133 @@ func EnsureNodeCommunication(t *testing.T, ctx context.Context, client crclient.
 	})
 }
 
+// EnsureContainerRuntime verifies that nodes of NodePools configured with a
+// ContainerRuntimeConfig default to the requested low-level runtime by reading
+// the rendered CRI-O configuration from one node per pool.
+func EnsureContainerRuntime(t *testing.T, ctx context.Context, client crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureContainerRuntime", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var nodePoolList hyperv1.NodePoolList
+		err := client.List(ctx, &nodePoolList, crclient.InNamespace(hostedCluster.Namespace))
+		g.Expect(err).NotTo(HaveOccurred(), "failed to list nodepools")
+
+		expectedRuntimes := map[string]string{}
+		for _, nodePool := range nodePoolList.Items {
+			if nodePool.Spec.ClusterName != hostedCluster.Name {
+				continue
+			}
+			for _, configRef := range nodePool.Spec.Config {
+				config := &corev1.ConfigMap{}
+				if err := client.Get(ctx, crclient.ObjectKey{Namespace: nodePool.Namespace, Name: configRef.Name}, config); err != nil {
+					t.Fatalf("failed to get config %s for nodepool %s: %v", configRef.Name, nodePool.Name, err)
+				}
+				if runtime := defaultRuntimeFromConfig(config.Data["config"]); runtime != "" {
+					expectedRuntimes[nodePool.Name] = runtime
+				}
+			}
+		}
+		if len(expectedRuntimes) == 0 {
+			t.Skip("test only applicable when a nodepool configures a container runtime handler")
+		}
+
+		guestKubeConfigSecretData, err := WaitForGuestKubeConfig(t, ctx, client, hostedCluster)
+		g.Expect(err).NotTo(HaveOccurred(), "couldn't get kubeconfig")
+		guestConfig, err := clientcmd.RESTConfigFromKubeConfig(guestKubeConfigSecretData)
+		g.Expect(err).NotTo(HaveOccurred(), "couldn't load guest kubeconfig")
+		guestClient := kubeclient.NewForConfigOrDie(guestConfig)
+
+		for nodePoolName, runtime := range expectedRuntimes {
+			nodeList, err := guestClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: hyperv1.NodePoolLabel + "=" + nodePoolName})
+			g.Expect(err).NotTo(HaveOccurred(), "failed to list nodes for nodepool %s", nodePoolName)
+			if len(nodeList.Items) == 0 {
+				t.Errorf("nodepool %s has no nodes to verify the container runtime on", nodePoolName)
+				continue
+			}
+			crioConfig := readCRIOConfig(t, ctx, guestClient, hostedCluster.Spec.Release.Image, nodeList.Items[0].Name)
+			if !strings.Contains(crioConfig, fmt.Sprintf("default_runtime = %q", runtime)) &&
+				!(runtime == "runc" && !strings.Contains(crioConfig, "default_runtime")) {
+				t.Errorf("expected node %s of nodepool %s to default to runtime %s", nodeList.Items[0].Name, nodePoolName, runtime)
+			}
+		}
+	})
+}
+
+// defaultRuntimeFromConfig extracts the default runtime from a NodePool config
+// manifest if it holds a ContainerRuntimeConfig, otherwise returns "".
+func defaultRuntimeFromConfig(manifest string) string {
+	if manifest == "" {
+		return ""
+	}
+	containerRuntimeConfig := &mcfgv1.ContainerRuntimeConfig{}
+	if _, _, err := api.YamlSerializer.Decode([]byte(manifest), nil, containerRuntimeConfig); err != nil {
+		return ""
+	}
+	if containerRuntimeConfig.Spec.ContainerRuntimeConfig == nil {
+		return ""
+	}
+	return string(containerRuntimeConfig.Spec.ContainerRuntimeConfig.DefaultRuntime)
+}
+
+// readCRIOConfig runs a host-mount pod on the given node that dumps the CRI-O
+// configuration, and returns its output.
+func readCRIOConfig(t *testing.T, ctx context.Context, guestClient kubeclient.Interface, image, nodeName string) string {
+	g := NewWithT(t)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    "default",
+			GenerateName: "crio-config-",
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      nodeName,
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{
+				{
+					Name:    "read-config",
+					Image:   image,
+					Command: []string{"/bin/sh", "-c", "cat /etc/crio/crio.conf /etc/crio/crio.conf.d/* 2>/dev/null || true"},
+					SecurityContext: &corev1.SecurityContext{
+						Privileged: pointer.Bool(true),
+						RunAsUser:  pointer.Int64(0),
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "crio-config", MountPath: "/etc/crio", ReadOnly: true},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "crio-config",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{Path: "/etc/crio"},
+					},
+				},
+			},
+			Tolerations: []corev1.Toleration{
+				{Operator: corev1.TolerationOpExists},
+			},
+		},
+	}
+	pod, err := guestClient.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred(), "failed to create crio config reader pod")
+	defer func() {
+		_ = guestClient.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+	}()
+
+	err = wait.PollImmediateWithContext(ctx, 5*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
+		current, err := guestClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		return current.Status.Phase == corev1.PodSucceeded, nil
+	})
+	g.Expect(err).NotTo(HaveOccurred(), "crio config reader pod did not complete")
+
+	logs, err := guestClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{}).DoRaw(ctx)
+	g.Expect(err).NotTo(HaveOccurred(), "failed to read crio config reader pod logs")
+	return string(logs)
+}
+
 func EnsureNodesLabelsAndTaints(t *testing.T, nodePool hyperv1.NodePool, nodes []corev1.Node) {
 	g := NewWithT(t)
 
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -93,6 +95,78 @@ func EnsureOAuthWithIdentityProvider(t *testing.T, ctx context.Context, client c
 	})
 }
 
+func EnsureOAuthTemplates(t *testing.T, ctx context.Context, client crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureOAuthTemplates", func(t *testing.T) {
+		if hostedCluster.Spec.Configuration == nil || hostedCluster.Spec.Configuration.OAuth == nil ||
+			hostedCluster.Spec.Configuration.OAuth.Templates.Login.Name == "" {
+			t.Skip("test only applicable when a custom OAuth login template is configured")
+		}
+		g := NewWithT(t)
+
+		templateSecret := &corev1.Secret{}
+		err := client.Get(ctx, crclient.ObjectKey{Namespace: hostedCluster.Namespace, Name: hostedCluster.Spec.Configuration.OAuth.Templates.Login.Name}, templateSecret)
+		g.Expect(err).ToNot(HaveOccurred(), "failed to get login template secret")
+		marker := templateMarker(templateSecret.Data["login.html"])
+		if marker == "" {
+			t.Skip("login template does not contain static markup to look for")
+		}
+
+		guestKubeConfigSecretData, err := WaitForGuestKubeConfig(t, ctx, client, hostedCluster)
+		g.Expect(err).NotTo(HaveOccurred(), "couldn't get kubeconfig")
+		guestConfig, err := clientcmd.RESTConfigFromKubeConfig(guestKubeConfigSecretData)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		oauthRoute := WaitForOAuthRouteReady(t, ctx, client, guestConfig, hostedCluster)
+
+		// The authorize endpoint redirects browser clients to the login page.
+		oauthClient := configmanifests.OAuthServerBrowserClient().Name
+		loginReqURL := fmt.Sprintf("https://%s/oauth/authorize?response_type=code&client_id=%s", oauthRoute.Spec.Host, oauthClient)
+		request, err := http.NewRequest(http.MethodGet, loginReqURL, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		transport, err := restclient.TransportFor(restclient.AnonymousClientConfig(guestConfig))
+		g.Expect(err).ToNot(HaveOccurred(), "error getting transport")
+		httpClient := &http.Client{Transport: transport}
+
+		err = wait.PollImmediateWithContext(ctx, time.Second, time.Minute*2, func(ctx context.Context) (done bool, err error) {
+			resp, err := httpClient.Do(request)
+			if err != nil {
+				t.Logf("Waiting for OAuth login page request to succeed: %v", err)
+				return false, nil
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Logf("Waiting for OAuth login page, got status %v", resp.Status)
+				return false, nil
+			}
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Logf("Failed to read OAuth login page body: %v", err)
+				return false, nil
+			}
+			if !strings.Contains(string(body), marker) {
+				t.Logf("OAuth login page does not yet contain the custom markup %q", marker)
+				return false, nil
+			}
+			return true, nil
+		})
+		g.Expect(err).ToNot(HaveOccurred(), "failed waiting for OAuth login page to serve the custom template")
+	})
+}
+
+// templateMarker returns a line of static markup from an OAuth template that
+// can be searched for in the rendered page. Lines containing Go template
+// directives are skipped because they do not appear verbatim in the output.
+func templateMarker(template []byte) string {
+	for _, line := range strings.Split(string(template), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 10 && strings.Contains(line, "<") && !strings.Contains(line, "{{") {
+			return line
+		}
+	}
+	return ""
+}
+
 func WaitForOAuthToken(t *testing.T, ctx context.Context, oauthRoute *routev1.Route, restConfig *restclient.Config, username, password string) string {
 	g := NewWithT(t)
 
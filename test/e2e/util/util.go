@@ -3,23 +3,40 @@ package util
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"net"
 	"os"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
 	configv1 "github.com/openshift/api/config/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	templatev1 "github.com/openshift/api/template/v1"
 	routev1client "github.com/openshift/client-go/route/clientset/versioned"
 	hyperv1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	"github.com/openshift/hypershift/cmd/cluster/core"
+	awsutil "github.com/openshift/hypershift/cmd/infra/aws/util"
+	"github.com/openshift/hypershift/cmd/rotate"
+	cpomanifests "github.com/openshift/hypershift/control-plane-operator/controllers/hostedcontrolplane/manifests"
+	pkimanifests "github.com/openshift/hypershift/control-plane-pki-operator/manifests"
 	hcmetrics "github.com/openshift/hypershift/hypershift-operator/controllers/hostedcluster/metrics"
 	"github.com/openshift/hypershift/hypershift-operator/controllers/manifests"
+	"github.com/openshift/hypershift/support/certs"
 	"github.com/openshift/hypershift/support/conditions"
 	suppconfig "github.com/openshift/hypershift/support/config"
 	"github.com/openshift/hypershift/support/util"
@@ -35,21 +52,32 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	kapierror "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/config/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes"
 	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/pointer"
 	capiv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
 func UpdateObject[T crclient.Object](t *testing.T, ctx context.Context, client crclient.Client, original T, mutate func(obj T)) error {
@@ -504,6 +532,1491 @@ func EnsureAllContainersHavePullPolicyIfNotPresent(t *testing.T, ctx context.Con
 	})
 }
 
+// EnsureScaleFromZero validates that an autoscaling NodePool with min 0 starts with no
+// Nodes, scales up to satisfy a pending workload that targets the pool, and scales back
+// down to zero once the workload is removed.
+func EnsureScaleFromZero(t *testing.T, ctx context.Context, mgtClient, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster, image string) {
+	t.Run("EnsureScaleFromZero", func(t *testing.T) {
+		g := NewWithT(t)
+
+		// Use the existing NodePool as a template for a pool that autoscales from zero.
+		nodepools := &hyperv1.NodePoolList{}
+		err := mgtClient.List(ctx, nodepools, crclient.InNamespace(hostedCluster.Namespace))
+		g.Expect(err).NotTo(HaveOccurred(), "failed to list nodepools")
+		g.Expect(nodepools.Items).NotTo(BeEmpty(), "expected at least one nodepool")
+
+		nodepool := &hyperv1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      hostedCluster.Name + "-scale-from-zero",
+				Namespace: hostedCluster.Namespace,
+			},
+			Spec: *nodepools.Items[0].Spec.DeepCopy(),
+		}
+		nodepool.Spec.Replicas = nil
+		nodepool.Spec.AutoScaling = &hyperv1.NodePoolAutoScaling{
+			Min: 0,
+			Max: 1,
+		}
+		err = mgtClient.Create(ctx, nodepool)
+		g.Expect(err).NotTo(HaveOccurred(), "failed to create scale-from-zero nodepool")
+		defer func() {
+			if err := mgtClient.Delete(ctx, nodepool); err != nil {
+				t.Logf("failed to delete scale-from-zero nodepool: %v", err)
+			}
+		}()
+
+		// The pool starts at its minimum, so no nodes should belong to it.
+		nodes := &corev1.NodeList{}
+		err = guestClient.List(ctx, nodes)
+		g.Expect(err).NotTo(HaveOccurred(), "failed to list nodes")
+		for _, node := range nodes.Items {
+			g.Expect(node.Labels[hyperv1.NodePoolLabel]).NotTo(Equal(nodepool.Name), "expected no nodes for nodepool %s", nodepool.Name)
+		}
+
+		// Schedule a workload that can only run on nodes from the new pool so the
+		// autoscaler has to scale it up from zero.
+		workload := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "scale-from-zero-workload",
+				Namespace: "default",
+			},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:    "scale-from-zero-workload",
+								Image:   image,
+								Command: []string{"sleep", "86400"},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("500m"),
+										corev1.ResourceMemory: resource.MustParse("256Mi"),
+									},
+								},
+							},
+						},
+						NodeSelector:  map[string]string{hyperv1.NodePoolLabel: nodepool.Name},
+						RestartPolicy: corev1.RestartPolicyNever,
+					},
+				},
+				BackoffLimit: pointer.Int32(4),
+			},
+		}
+		err = guestClient.Create(ctx, workload)
+		g.Expect(err).NotTo(HaveOccurred(), "failed to create workload")
+		t.Logf("Created workload targeting nodepool %s", nodepool.Name)
+
+		_ = WaitForNReadyNodesByNodePool(t, ctx, guestClient, 1, hostedCluster.Spec.Platform.Type, nodepool.Name)
+
+		// Remove the workload and expect the pool to scale back down to zero.
+		cascadeDelete := metav1.DeletePropagationForeground
+		err = guestClient.Delete(ctx, workload, &crclient.DeleteOptions{PropagationPolicy: &cascadeDelete})
+		g.Expect(err).NotTo(HaveOccurred(), "failed to delete workload")
+		t.Logf("Deleted workload")
+
+		_ = WaitForNReadyNodesByNodePool(t, ctx, guestClient, 0, hostedCluster.Spec.Platform.Type, nodepool.Name)
+	})
+}
+
+func EnsureInfrastructureVIPs(t *testing.T, ctx context.Context, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureInfrastructureVIPs", func(t *testing.T) {
+		if hostedCluster.Spec.Platform.Agent == nil ||
+			(hostedCluster.Spec.Platform.Agent.APIVIP == "" && hostedCluster.Spec.Platform.Agent.IngressVIP == "") {
+			t.Skip("test only applicable when agent platform VIPs are configured")
+		}
+		infra := &configv1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}
+		if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(infra), infra); err != nil {
+			t.Fatalf("failed to get guest cluster infrastructure: %v", err)
+		}
+		if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.BareMetal == nil {
+			t.Fatalf("expected bare metal platform status to be populated, got %+v", infra.Status.PlatformStatus)
+		}
+		if apiVIP := hostedCluster.Spec.Platform.Agent.APIVIP; apiVIP != "" {
+			if infra.Status.PlatformStatus.BareMetal.APIServerInternalIP != apiVIP {
+				t.Errorf("expected API server internal IP %s, got %s", apiVIP, infra.Status.PlatformStatus.BareMetal.APIServerInternalIP)
+			}
+		}
+		if ingressVIP := hostedCluster.Spec.Platform.Agent.IngressVIP; ingressVIP != "" {
+			if infra.Status.PlatformStatus.BareMetal.IngressIP != ingressVIP {
+				t.Errorf("expected ingress IP %s, got %s", ingressVIP, infra.Status.PlatformStatus.BareMetal.IngressIP)
+			}
+		}
+	})
+}
+
+func EnsureCCMFeatureGates(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureCCMFeatureGates", func(t *testing.T) {
+		featureGates, ok := hostedCluster.Annotations[hyperv1.CCMFeatureGatesAnnotation]
+		if !ok || hostedCluster.Spec.Platform.Type != hyperv1.AWSPlatform {
+			t.Skip("test only applicable when cloud controller manager feature gates are configured on an AWS cluster")
+		}
+		namespace := manifests.HostedControlPlaneNamespace(hostedCluster.Namespace, hostedCluster.Name)
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "aws-cloud-controller-manager"}}
+		if err := hostClient.Get(ctx, crclient.ObjectKeyFromObject(deployment), deployment); err != nil {
+			t.Fatalf("failed to get cloud controller manager deployment: %v", err)
+		}
+		expectedArg := fmt.Sprintf("--feature-gates=%s", featureGates)
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			if container.Name != "cloud-controller-manager" {
+				continue
+			}
+			for _, arg := range container.Args {
+				if arg == expectedArg {
+					return
+				}
+			}
+		}
+		t.Errorf("expected cloud controller manager to have argument %s", expectedArg)
+	})
+}
+
+func EnsureAuditLogForwarding(t *testing.T, ctx context.Context, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureAuditLogForwarding", func(t *testing.T) {
+		if _, ok := hostedCluster.Annotations[hyperv1.AuditLogForwardingAnnotation]; !ok {
+			t.Skip("test only applicable when audit log forwarding is configured")
+		}
+		forwarder := &unstructured.Unstructured{}
+		forwarder.SetAPIVersion("logging.openshift.io/v1")
+		forwarder.SetKind("ClusterLogForwarder")
+		if err := guestClient.Get(ctx, crclient.ObjectKey{Namespace: "openshift-logging", Name: "instance"}, forwarder); err != nil {
+			if meta.IsNoMatchError(err) {
+				t.Skip("logging operator is not installed in the guest cluster")
+			}
+			t.Fatalf("failed to get cluster log forwarder: %v", err)
+		}
+		pipelines, _, err := unstructured.NestedSlice(forwarder.Object, "spec", "pipelines")
+		if err != nil {
+			t.Fatalf("failed to read cluster log forwarder pipelines: %v", err)
+		}
+		for _, pipeline := range pipelines {
+			pipelineMap, ok := pipeline.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			inputRefs, _, _ := unstructured.NestedStringSlice(pipelineMap, "inputRefs")
+			for _, inputRef := range inputRefs {
+				if inputRef == "audit" {
+					return
+				}
+			}
+		}
+		t.Errorf("expected cluster log forwarder to have a pipeline forwarding audit logs, got %v", pipelines)
+	})
+}
+
+func EnsureAPIAudiences(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureAPIAudiences", func(t *testing.T) {
+		if len(hostedCluster.Spec.ServiceAccountAudiences) == 0 {
+			t.Skip("test only applicable when additional service account audiences are configured")
+		}
+		guestKubeConfigSecretData, err := WaitForGuestKubeConfig(t, ctx, hostClient, hostedCluster)
+		if err != nil {
+			t.Fatalf("couldn't get guest kubeconfig: %v", err)
+		}
+		guestConfig, err := clientcmd.RESTConfigFromKubeConfig(guestKubeConfigSecretData)
+		if err != nil {
+			t.Fatalf("couldn't load guest kubeconfig: %v", err)
+		}
+		kubeClient, err := kubernetes.NewForConfig(guestConfig)
+		if err != nil {
+			t.Fatalf("failed to create guest clientset: %v", err)
+		}
+		for _, audience := range hostedCluster.Spec.ServiceAccountAudiences {
+			tokenRequest, err := kubeClient.CoreV1().ServiceAccounts("default").CreateToken(
+				ctx,
+				"default",
+				&authenticationv1.TokenRequest{
+					Spec: authenticationv1.TokenRequestSpec{
+						Audiences: []string{audience},
+					},
+				},
+				metav1.CreateOptions{},
+			)
+			if err != nil {
+				t.Fatalf("failed to create token for audience %s: %v", audience, err)
+			}
+			tokenReview, err := kubeClient.AuthenticationV1().TokenReviews().Create(
+				ctx,
+				&authenticationv1.TokenReview{
+					Spec: authenticationv1.TokenReviewSpec{
+						Token:     tokenRequest.Status.Token,
+						Audiences: []string{audience},
+					},
+				},
+				metav1.CreateOptions{},
+			)
+			if err != nil {
+				t.Fatalf("failed to review token for audience %s: %v", audience, err)
+			}
+			if !tokenReview.Status.Authenticated {
+				t.Errorf("expected token with audience %s to be accepted by the API server, got: %s", audience, tokenReview.Status.Error)
+			}
+		}
+	})
+}
+
+// EnsureGuestLimitRange verifies that the project request template carrying
+// the guest default LimitRange is installed in the guest cluster and that the
+// cluster project configuration references it.
+func EnsureGuestLimitRange(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureGuestLimitRange", func(t *testing.T) {
+		if hostedCluster.Spec.Configuration == nil || len(hostedCluster.Spec.Configuration.GuestDefaultLimits) == 0 {
+			t.Skip("test only applicable when guest default limits are configured")
+		}
+		guestClient := WaitForGuestClient(t, ctx, hostClient, hostedCluster)
+		var lastError error
+		err := wait.PollImmediateWithContext(ctx, 10*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+			template := &templatev1.Template{ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-config", Name: "project-request"}}
+			if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(template), template); err != nil {
+				lastError = fmt.Errorf("failed to get project request template: %w", err)
+				return false, nil
+			}
+			hasLimitRange := false
+			for _, object := range template.Objects {
+				typeMeta := &metav1.TypeMeta{}
+				if err := json.Unmarshal(object.Raw, typeMeta); err != nil {
+					continue
+				}
+				if typeMeta.Kind == "LimitRange" {
+					hasLimitRange = true
+					break
+				}
+			}
+			if !hasLimitRange {
+				lastError = fmt.Errorf("project request template does not contain a LimitRange")
+				return false, nil
+			}
+			projectConfig := &configv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}
+			if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(projectConfig), projectConfig); err != nil {
+				lastError = fmt.Errorf("failed to get project config: %w", err)
+				return false, nil
+			}
+			if projectConfig.Spec.ProjectRequestTemplate.Name != template.Name {
+				lastError = fmt.Errorf("project config does not reference the project request template, got %q", projectConfig.Spec.ProjectRequestTemplate.Name)
+				return false, nil
+			}
+			return true, nil
+		})
+		if err != nil {
+			t.Fatalf("failed to verify guest limit range: %v, last error: %v", err, lastError)
+		}
+	})
+}
+
+// EnsureGuestDefaultDeny verifies that when the default-deny network policy
+// bootstrap is enabled, a freshly created guest namespace receives a
+// default-deny ingress NetworkPolicy while system namespaces stay exempt.
+func EnsureGuestDefaultDeny(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureGuestDefaultDeny", func(t *testing.T) {
+		if hostedCluster.Spec.Configuration == nil || !hostedCluster.Spec.Configuration.GuestDefaultDenyNetworkPolicy {
+			t.Skip("test only applicable when the guest default-deny network policy is configured")
+		}
+		guestClient := WaitForGuestClient(t, ctx, hostClient, hostedCluster)
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: SimpleNameGenerator.GenerateName("e2e-default-deny-")}}
+		if err := guestClient.Create(ctx, namespace); err != nil {
+			t.Fatalf("failed to create guest namespace: %v", err)
+		}
+		defer func() {
+			if err := guestClient.Delete(ctx, namespace); err != nil {
+				t.Logf("failed to delete guest namespace %s: %v", namespace.Name, err)
+			}
+		}()
+		var lastError error
+		err := wait.PollImmediateWithContext(ctx, 10*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+			policy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: "default-deny-ingress"}}
+			if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(policy), policy); err != nil {
+				lastError = fmt.Errorf("failed to get default deny network policy: %w", err)
+				return false, nil
+			}
+			if len(policy.Spec.PodSelector.MatchLabels) > 0 || len(policy.Spec.PodSelector.MatchExpressions) > 0 {
+				lastError = fmt.Errorf("default deny network policy does not select all pods")
+				return false, nil
+			}
+			if len(policy.Spec.Ingress) > 0 {
+				lastError = fmt.Errorf("default deny network policy allows ingress traffic")
+				return false, nil
+			}
+			return true, nil
+		})
+		if err != nil {
+			t.Fatalf("failed to verify default deny network policy: %v, last error: %v", err, lastError)
+		}
+		systemPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "default-deny-ingress"}}
+		if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(systemPolicy), systemPolicy); err == nil {
+			t.Errorf("default deny network policy unexpectedly present in the default namespace")
+		} else if !errors.IsNotFound(err) {
+			t.Errorf("failed to check the default namespace for a default deny network policy: %v", err)
+		}
+	})
+}
+
+// EnsureSurvivesManagementEtcdRestart restarts etcd on the management cluster
+// and verifies the HostedCluster remains Available and its controllers resume
+// cleanly. It disrupts every workload on the management cluster, so callers
+// must explicitly opt in.
+func EnsureSurvivesManagementEtcdRestart(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureSurvivesManagementEtcdRestart", func(t *testing.T) {
+		var etcdPods corev1.PodList
+		if err := hostClient.List(ctx, &etcdPods, crclient.InNamespace("openshift-etcd"), crclient.MatchingLabels{"app": "etcd"}); err != nil {
+			t.Fatalf("failed to list management cluster etcd pods: %v", err)
+		}
+		if len(etcdPods.Items) == 0 {
+			t.Skip("management cluster does not run a self-hosted etcd")
+		}
+
+		t.Logf("Restarting %d management cluster etcd pods", len(etcdPods.Items))
+		for i := range etcdPods.Items {
+			if err := hostClient.Delete(ctx, &etcdPods.Items[i]); err != nil && !errors.IsNotFound(err) {
+				t.Fatalf("failed to delete management cluster etcd pod %s: %v", etcdPods.Items[i].Name, err)
+			}
+		}
+
+		t.Logf("Waiting for management cluster etcd pods to become ready again")
+		err := wait.PollImmediateWithContext(ctx, 10*time.Second, 15*time.Minute, func(ctx context.Context) (bool, error) {
+			var pods corev1.PodList
+			if err := hostClient.List(ctx, &pods, crclient.InNamespace("openshift-etcd"), crclient.MatchingLabels{"app": "etcd"}); err != nil {
+				return false, nil
+			}
+			if len(pods.Items) < len(etcdPods.Items) {
+				return false, nil
+			}
+			for _, pod := range pods.Items {
+				if !isPodReady(&pod) {
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			t.Fatalf("management cluster etcd pods did not become ready after restart: %v", err)
+		}
+
+		t.Logf("Waiting for the HostedCluster to report Available after the etcd restart")
+		err = wait.PollImmediateWithContext(ctx, 10*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+			latest := &hyperv1.HostedCluster{}
+			if err := hostClient.Get(ctx, crclient.ObjectKeyFromObject(hostedCluster), latest); err != nil {
+				return false, nil
+			}
+			available := meta.FindStatusCondition(latest.Status.Conditions, string(hyperv1.HostedClusterAvailable))
+			return available != nil && available.Status == metav1.ConditionTrue, nil
+		})
+		if err != nil {
+			t.Fatalf("hosted cluster did not report Available after management etcd restart: %v", err)
+		}
+
+		// Verify the control plane resumed cleanly after the disruption.
+		EnsureNoCrashingPods(t, ctx, hostClient, hostedCluster)
+	})
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func EnsurePlacementGroup(t *testing.T, ctx context.Context, hostClient crclient.Client, ec2Client ec2iface.EC2API, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsurePlacementGroup", func(t *testing.T) {
+		var nodePoolList hyperv1.NodePoolList
+		if err := hostClient.List(ctx, &nodePoolList, &crclient.ListOptions{Namespace: hostedCluster.Namespace}); err != nil {
+			t.Fatalf("failed to list nodepools: %v", err)
+		}
+		checked := false
+		for _, nodePool := range nodePoolList.Items {
+			if nodePool.Spec.ClusterName != hostedCluster.Name || nodePool.Spec.Platform.AWS == nil || nodePool.Spec.Platform.AWS.PlacementGroup == "" {
+				continue
+			}
+			checked = true
+			output, err := ec2Client.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+				Filters: []*ec2.Filter{
+					{Name: aws.String(fmt.Sprintf("tag:kubernetes.io/cluster/%s", hostedCluster.Spec.InfraID)), Values: []*string{aws.String("owned")}},
+					{Name: aws.String("placement-group-name"), Values: []*string{aws.String(nodePool.Spec.Platform.AWS.PlacementGroup)}},
+					{Name: aws.String("instance-state-name"), Values: []*string{aws.String("running")}},
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to describe instances in placement group %s: %v", nodePool.Spec.Platform.AWS.PlacementGroup, err)
+			}
+			instances := 0
+			for _, reservation := range output.Reservations {
+				instances += len(reservation.Instances)
+			}
+			if nodePool.Spec.Replicas != nil && instances != int(*nodePool.Spec.Replicas) {
+				t.Errorf("expected %d instances in placement group %s for nodepool %s, got %d", *nodePool.Spec.Replicas, nodePool.Spec.Platform.AWS.PlacementGroup, nodePool.Name, instances)
+			}
+		}
+		if !checked {
+			t.Skip("test only applicable when a nodepool configures a placement group")
+		}
+	})
+}
+
+// EnsureKASTuning verifies the configured kube-apiserver tuning values are
+// rendered into the kube-apiserver configuration of the control plane.
+func EnsureKASTuning(t *testing.T, ctx context.Context, mgmtClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureKASTuning", func(t *testing.T) {
+		if hostedCluster.Spec.Configuration == nil || hostedCluster.Spec.Configuration.APIServerTuning == nil {
+			t.Skip("test only applicable when apiserver tuning is configured")
+		}
+		tuning := hostedCluster.Spec.Configuration.APIServerTuning
+		namespace := manifests.HostedControlPlaneNamespace(hostedCluster.Namespace, hostedCluster.Name)
+		kasConfig := cpomanifests.KASConfig(namespace)
+		if err := mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(kasConfig), kasConfig); err != nil {
+			t.Fatalf("failed to get kube-apiserver config: %v", err)
+		}
+		var config struct {
+			APIServerArguments map[string][]string `json:"apiServerArguments"`
+		}
+		if err := json.Unmarshal([]byte(kasConfig.Data["config.json"]), &config); err != nil {
+			t.Fatalf("failed to parse kube-apiserver config: %v", err)
+		}
+		expectArgument := func(name, value string) {
+			if values := config.APIServerArguments[name]; len(values) != 1 || values[0] != value {
+				t.Errorf("expected kube-apiserver argument %s=%s, got %v", name, value, values)
+			}
+		}
+		if tuning.GoawayChance != "" {
+			expectArgument("goaway-chance", tuning.GoawayChance)
+		}
+		if tuning.MaxRequestsInflight != nil {
+			expectArgument("max-requests-inflight", fmt.Sprintf("%d", *tuning.MaxRequestsInflight))
+		}
+		if tuning.MaxMutatingRequestsInflight != nil {
+			expectArgument("max-mutating-requests-inflight", fmt.Sprintf("%d", *tuning.MaxMutatingRequestsInflight))
+		}
+		if tuning.DefaultWatchCacheSize != nil {
+			expectArgument("default-watch-cache-size", fmt.Sprintf("%d", *tuning.DefaultWatchCacheSize))
+		}
+		if len(tuning.WatchCacheSizes) > 0 {
+			expectArgument("watch-cache-sizes", strings.Join(tuning.WatchCacheSizes, ","))
+		}
+	})
+}
+
+// EnsureSerialNodePoolRollout verifies that a cluster which serializes NodePool
+// rollouts never has more than one pool actively rolling out a config or
+// version change at a time. Pools waiting for their turn report the
+// RolloutDeferred condition and do not count as active.
+func EnsureSerialNodePoolRollout(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureSerialNodePoolRollout", func(t *testing.T) {
+		if hostedCluster.Annotations[hyperv1.SerializeNodePoolRolloutsAnnotation] != "true" {
+			t.Skip("test only applicable when the cluster serializes nodepool rollouts")
+		}
+		nodePoolCondition := func(nodePool *hyperv1.NodePool, conditionType string) *hyperv1.NodePoolCondition {
+			for i, condition := range nodePool.Status.Conditions {
+				if condition.Type == conditionType {
+					return &nodePool.Status.Conditions[i]
+				}
+			}
+			return nil
+		}
+		err := wait.PollImmediateWithContext(ctx, 10*time.Second, 30*time.Minute, func(ctx context.Context) (done bool, err error) {
+			var nodePoolList hyperv1.NodePoolList
+			if err := hostClient.List(ctx, &nodePoolList, &crclient.ListOptions{Namespace: hostedCluster.Namespace}); err != nil {
+				t.Logf("failed to list nodepools: %v", err)
+				return false, nil
+			}
+			var updating []string
+			for i := range nodePoolList.Items {
+				nodePool := &nodePoolList.Items[i]
+				if nodePool.Spec.ClusterName != hostedCluster.Name {
+					continue
+				}
+				if deferred := nodePoolCondition(nodePool, hyperv1.NodePoolRolloutDeferredConditionType); deferred != nil && deferred.Status == corev1.ConditionTrue {
+					continue
+				}
+				for _, conditionType := range []string{hyperv1.NodePoolUpdatingConfigConditionType, hyperv1.NodePoolUpdatingVersionConditionType} {
+					if condition := nodePoolCondition(nodePool, conditionType); condition != nil && condition.Status == corev1.ConditionTrue {
+						updating = append(updating, nodePool.Name)
+						break
+					}
+				}
+			}
+			if len(updating) > 1 {
+				return false, fmt.Errorf("expected at most one nodepool to be updating at a time, got %v", updating)
+			}
+			return len(updating) == 0, nil
+		})
+		if err != nil {
+			t.Errorf("failed to observe serialized nodepool rollouts: %v", err)
+		}
+	})
+}
+
+// EnsureNodePIDLimits verifies that the nodes of every NodePool configuring a
+// pod PID limit run with that limit by reading the kubelet's effective
+// configuration through the node proxy endpoint.
+func EnsureNodePIDLimits(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureNodePIDLimits", func(t *testing.T) {
+		var nodePoolList hyperv1.NodePoolList
+		if err := hostClient.List(ctx, &nodePoolList, &crclient.ListOptions{Namespace: hostedCluster.Namespace}); err != nil {
+			t.Fatalf("failed to list nodepools: %v", err)
+		}
+		var pools []hyperv1.NodePool
+		for _, nodePool := range nodePoolList.Items {
+			if nodePool.Spec.ClusterName == hostedCluster.Name && nodePool.Spec.PIDLimits != nil && nodePool.Spec.PIDLimits.PodPIDsLimit != nil {
+				pools = append(pools, nodePool)
+			}
+		}
+		if len(pools) == 0 {
+			t.Skip("test only applicable when a nodepool configures pid limits")
+		}
+		guestKubeConfigSecretData, err := WaitForGuestKubeConfig(t, ctx, hostClient, hostedCluster)
+		if err != nil {
+			t.Fatalf("couldn't get guest kubeconfig: %v", err)
+		}
+		guestConfig, err := clientcmd.RESTConfigFromKubeConfig(guestKubeConfigSecretData)
+		if err != nil {
+			t.Fatalf("couldn't load guest kubeconfig: %v", err)
+		}
+		kubeClient, err := kubernetes.NewForConfig(guestConfig)
+		if err != nil {
+			t.Fatalf("failed to create guest clientset: %v", err)
+		}
+		for _, nodePool := range pools {
+			nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s", hyperv1.NodePoolLabel, nodePool.Name),
+			})
+			if err != nil {
+				t.Fatalf("failed to list nodes of nodepool %s: %v", nodePool.Name, err)
+			}
+			if len(nodes.Items) == 0 {
+				t.Errorf("expected nodes for nodepool %s, got none", nodePool.Name)
+			}
+			for _, node := range nodes.Items {
+				raw, err := kubeClient.CoreV1().RESTClient().Get().
+					Resource("nodes").Name(node.Name).
+					SubResource("proxy").Suffix("configz").DoRaw(ctx)
+				if err != nil {
+					t.Errorf("failed to read kubelet config of node %s: %v", node.Name, err)
+					continue
+				}
+				var configz struct {
+					KubeletConfig struct {
+						PodPidsLimit int64 `json:"podPidsLimit"`
+					} `json:"kubeletconfig"`
+				}
+				if err := json.Unmarshal(raw, &configz); err != nil {
+					t.Errorf("failed to parse kubelet config of node %s: %v", node.Name, err)
+					continue
+				}
+				if configz.KubeletConfig.PodPidsLimit != *nodePool.Spec.PIDLimits.PodPIDsLimit {
+					t.Errorf("expected node %s of nodepool %s to run with podPidsLimit %d, got %d",
+						node.Name, nodePool.Name, *nodePool.Spec.PIDLimits.PodPIDsLimit, configz.KubeletConfig.PodPidsLimit)
+				}
+			}
+		}
+	})
+}
+
+// EnsureTenancy verifies that every NodePool configured with a placement
+// tenancy runs its instances with that tenancy, and that instances of a pool
+// pinned to a dedicated host actually landed on it.
+func EnsureTenancy(t *testing.T, ctx context.Context, hostClient crclient.Client, ec2Client ec2iface.EC2API, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureTenancy", func(t *testing.T) {
+		var nodePoolList hyperv1.NodePoolList
+		if err := hostClient.List(ctx, &nodePoolList, &crclient.ListOptions{Namespace: hostedCluster.Namespace}); err != nil {
+			t.Fatalf("failed to list nodepools: %v", err)
+		}
+		checked := false
+		for _, nodePool := range nodePoolList.Items {
+			if nodePool.Spec.ClusterName != hostedCluster.Name || nodePool.Spec.Platform.AWS == nil || nodePool.Spec.Platform.AWS.Tenancy == "" {
+				continue
+			}
+			checked = true
+			tenancy := nodePool.Spec.Platform.AWS.Tenancy
+			output, err := ec2Client.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+				Filters: []*ec2.Filter{
+					{Name: aws.String(fmt.Sprintf("tag:kubernetes.io/cluster/%s", hostedCluster.Spec.InfraID)), Values: []*string{aws.String("owned")}},
+					{Name: aws.String("tenancy"), Values: []*string{aws.String(tenancy)}},
+					{Name: aws.String("instance-state-name"), Values: []*string{aws.String("running")}},
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to describe %s tenancy instances for nodepool %s: %v", tenancy, nodePool.Name, err)
+			}
+			instances := 0
+			for _, reservation := range output.Reservations {
+				for _, instance := range reservation.Instances {
+					if hostID := nodePool.Spec.Platform.AWS.DedicatedHostID; hostID != "" && aws.StringValue(instance.Placement.HostId) != hostID {
+						t.Errorf("instance %s of nodepool %s is placed on host %s, expected dedicated host %s", aws.StringValue(instance.InstanceId), nodePool.Name, aws.StringValue(instance.Placement.HostId), hostID)
+					}
+					instances++
+				}
+			}
+			if nodePool.Spec.Replicas != nil && instances != int(*nodePool.Spec.Replicas) {
+				t.Errorf("expected %d instances with %s tenancy for nodepool %s, got %d", *nodePool.Spec.Replicas, tenancy, nodePool.Name, instances)
+			}
+		}
+		if !checked {
+			t.Skip("test only applicable when a nodepool configures a placement tenancy")
+		}
+	})
+}
+
+// EnsureAPIServerSNIRouting verifies that a cluster publishing its API server
+// through the shared ingress serves a certificate matching the route's
+// hostname when addressed via TLS SNI.
+func EnsureAPIServerSNIRouting(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureAPIServerSNIRouting", func(t *testing.T) {
+		strategy := util.ServicePublishingStrategyByTypeByHC(hostedCluster, hyperv1.APIServer)
+		if strategy == nil || strategy.Type != hyperv1.Route {
+			t.Skip("test only applicable when the API server is published through a route")
+		}
+		namespace := manifests.HostedControlPlaneNamespace(hostedCluster.Namespace, hostedCluster.Name)
+		route := cpomanifests.KubeAPIServerExternalPublicRoute(namespace)
+		if err := hostClient.Get(ctx, crclient.ObjectKeyFromObject(route), route); err != nil {
+			t.Fatalf("failed to get kube-apiserver route: %v", err)
+		}
+		if route.Spec.TLS == nil || route.Spec.TLS.Termination != routev1.TLSTerminationPassthrough {
+			t.Errorf("expected the kube-apiserver route to use passthrough TLS termination, got %+v", route.Spec.TLS)
+		}
+		host := route.Spec.Host
+		if host == "" {
+			t.Fatal("the kube-apiserver route reports no host")
+		}
+
+		// The shared router selects the backend by SNI, so the certificate
+		// served for the route host must belong to this cluster's API server.
+		var leafCert *x509.Certificate
+		err := wait.PollImmediateWithContext(ctx, 10*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
+			conn, err := tls.Dial("tcp", net.JoinHostPort(host, "443"), &tls.Config{ServerName: host, InsecureSkipVerify: true})
+			if err != nil {
+				return false, nil
+			}
+			defer conn.Close()
+			peerCertificates := conn.ConnectionState().PeerCertificates
+			if len(peerCertificates) == 0 {
+				return false, nil
+			}
+			leafCert = peerCertificates[0]
+			return true, nil
+		})
+		if err != nil {
+			t.Fatalf("failed to establish a TLS connection to %s: %v", host, err)
+		}
+		if err := leafCert.VerifyHostname(host); err != nil {
+			t.Errorf("certificate served for SNI host %s does not match the host: %v", host, err)
+		}
+	})
+}
+
+// EnsureAPILoadBalancer verifies that the kube-apiserver service carries the
+// load balancer attributes requested through the AWS platform configuration.
+func EnsureAPILoadBalancer(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureAPILoadBalancer", func(t *testing.T) {
+		if hostedCluster.Spec.Platform.AWS == nil || hostedCluster.Spec.Platform.AWS.LoadBalancer == nil {
+			t.Skip("test only applicable when an AWS load balancer configuration is set")
+		}
+		strategy := util.ServicePublishingStrategyByTypeByHC(hostedCluster, hyperv1.APIServer)
+		if strategy == nil || strategy.Type != hyperv1.LoadBalancer {
+			t.Skip("test only applicable when the API server is published through a load balancer")
+		}
+		loadBalancer := hostedCluster.Spec.Platform.AWS.LoadBalancer
+		namespace := manifests.HostedControlPlaneNamespace(hostedCluster.Namespace, hostedCluster.Name)
+		svc := cpomanifests.KubeAPIServerService(namespace)
+		if err := hostClient.Get(ctx, crclient.ObjectKeyFromObject(svc), svc); err != nil {
+			t.Fatalf("failed to get kube-apiserver service: %v", err)
+		}
+		if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			t.Fatalf("expected the kube-apiserver service to be of type %s, got %s", corev1.ServiceTypeLoadBalancer, svc.Spec.Type)
+		}
+		// A classic load balancer is created when the type annotation is absent.
+		lbType, hasLBType := svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-type"]
+		if loadBalancer.Type == hyperv1.ClassicLoadBalancerType {
+			if hasLBType {
+				t.Errorf("expected no load balancer type annotation for a %s load balancer, got %q", hyperv1.ClassicLoadBalancerType, lbType)
+			}
+		} else if lbType != "nlb" {
+			t.Errorf("expected load balancer type annotation %q, got %q", "nlb", lbType)
+		}
+		internal, hasInternal := svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-internal"]
+		if loadBalancer.Scheme == hyperv1.InternalLoadBalancerScheme {
+			if internal != "true" {
+				t.Errorf("expected the internal load balancer annotation to be set for the %s scheme, got %q", hyperv1.InternalLoadBalancerScheme, internal)
+			}
+		} else if hasInternal {
+			t.Errorf("expected no internal load balancer annotation for the %s scheme, got %q", hyperv1.InternetFacingLoadBalancerScheme, internal)
+		}
+		if len(svc.Status.LoadBalancer.Ingress) == 0 {
+			t.Error("the kube-apiserver load balancer is not provisioned")
+		}
+	})
+}
+
+// EnsureTimeSyncPersists verifies that chrony configuration applied through a
+// NodePool survives node lifecycle events: it checks the config is present on
+// the pool's nodes, recycles a node (machine replacement for Replace pools, a
+// reboot for InPlace pools) and confirms the config is still in place
+// afterwards.
+func EnsureTimeSyncPersists(t *testing.T, ctx context.Context, mgmtClient crclient.Client, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster, nodePool *hyperv1.NodePool) {
+	t.Run("EnsureTimeSyncPersists", func(t *testing.T) {
+		if !nodePoolConfiguresChrony(ctx, mgmtClient, nodePool) {
+			t.Skip("test only applicable when the NodePool carries chrony configuration")
+		}
+		replicas := int32(1)
+		if nodePool.Spec.Replicas != nil {
+			replicas = *nodePool.Spec.Replicas
+		}
+
+		ds := timeSyncVerificationDaemonSet(nodePool)
+		if err := guestClient.Create(ctx, ds); err != nil {
+			t.Fatalf("failed to create time sync verification daemonset: %v", err)
+		}
+		defer func() {
+			if err := guestClient.Delete(ctx, ds); err != nil {
+				t.Logf("WARNING: failed to delete time sync verification daemonset: %v", err)
+			}
+		}()
+		waitForDaemonSetPodsReady(t, ctx, guestClient, ds, int(replicas))
+
+		nodes := WaitForNReadyNodesByNodePool(t, ctx, guestClient, replicas, hostedCluster.Spec.Platform.Type, nodePool.Name)
+		node := &nodes[0]
+		switch nodePool.Spec.Management.UpgradeType {
+		case hyperv1.UpgradeTypeReplace:
+			t.Logf("Replacing the machine backing node %s", node.Name)
+			hcpNamespace := manifests.HostedControlPlaneNamespace(hostedCluster.Namespace, hostedCluster.Name)
+			var machines capiv1.MachineList
+			if err := mgmtClient.List(ctx, &machines, crclient.InNamespace(hcpNamespace)); err != nil {
+				t.Fatalf("failed to list machines in %s: %v", hcpNamespace, err)
+			}
+			var machine *capiv1.Machine
+			for i := range machines.Items {
+				if machines.Items[i].Status.NodeRef != nil && machines.Items[i].Status.NodeRef.Name == node.Name {
+					machine = &machines.Items[i]
+					break
+				}
+			}
+			if machine == nil {
+				t.Fatalf("failed to find the machine backing node %s", node.Name)
+			}
+			if err := mgmtClient.Delete(ctx, machine); err != nil {
+				t.Fatalf("failed to delete machine %s: %v", machine.Name, err)
+			}
+		case hyperv1.UpgradeTypeInPlace:
+			t.Logf("Rebooting node %s", node.Name)
+			rebootPod := nodeRebootPod(node.Name)
+			if err := guestClient.Create(ctx, rebootPod); err != nil {
+				t.Fatalf("failed to create reboot pod for node %s: %v", node.Name, err)
+			}
+			defer func() {
+				_ = guestClient.Delete(ctx, rebootPod)
+			}()
+		default:
+			t.Fatalf("unexpected upgrade type %s", nodePool.Spec.Management.UpgradeType)
+		}
+
+		_ = WaitForNReadyNodesByNodePool(t, ctx, guestClient, replicas, hostedCluster.Spec.Platform.Type, nodePool.Name)
+		waitForDaemonSetPodsReady(t, ctx, guestClient, ds, int(replicas))
+	})
+}
+
+// EnsureNodePoolLabelsAndTaints verifies that the labels and taints declared
+// on a NodePool spec actually show up on the Nodes it manages.
+func EnsureNodePoolLabelsAndTaints(t *testing.T, ctx context.Context, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster, nodePool *hyperv1.NodePool) {
+	t.Run("EnsureNodePoolLabelsAndTaints", func(t *testing.T) {
+		if len(nodePool.Spec.NodeLabels) == 0 && len(nodePool.Spec.Taints) == 0 {
+			t.Skip("test only applicable when the NodePool declares node labels or taints")
+		}
+		replicas := int32(1)
+		if nodePool.Spec.Replicas != nil {
+			replicas = *nodePool.Spec.Replicas
+		}
+		nodes := WaitForNReadyNodesByNodePool(t, ctx, guestClient, replicas, hostedCluster.Spec.Platform.Type, nodePool.Name)
+		for i := range nodes {
+			node := &nodes[i]
+			for key, value := range nodePool.Spec.NodeLabels {
+				if got, ok := node.Labels[key]; !ok {
+					t.Errorf("node %s is missing label %s from nodepool %s", node.Name, key, nodePool.Name)
+				} else if got != value {
+					t.Errorf("node %s has label %s=%s, expected %s", node.Name, key, got, value)
+				}
+			}
+			for _, taint := range nodePool.Spec.Taints {
+				found := false
+				for _, nodeTaint := range node.Spec.Taints {
+					if nodeTaint.Key == taint.Key && nodeTaint.Value == taint.Value && nodeTaint.Effect == taint.Effect {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("node %s is missing taint %s=%s:%s from nodepool %s", node.Name, taint.Key, taint.Value, taint.Effect, nodePool.Name)
+				}
+			}
+		}
+	})
+}
+
+// nodePoolConfiguresChrony reports whether any of the NodePool's config
+// entries carry chrony configuration.
+func nodePoolConfiguresChrony(ctx context.Context, mgmtClient crclient.Client, nodePool *hyperv1.NodePool) bool {
+	for _, config := range nodePool.Spec.Config {
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: nodePool.Namespace, Name: config.Name}}
+		if err := mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(configMap), configMap); err != nil {
+			continue
+		}
+		for _, data := range configMap.Data {
+			if strings.Contains(data, "chrony") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// timeSyncVerificationDaemonSet builds a DaemonSet whose pods only report
+// ready when the chrony config exists on the host they run on.
+func timeSyncVerificationDaemonSet(nodePool *hyperv1.NodePool) *appsv1.DaemonSet {
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "time-sync-checker",
+			Namespace: "kube-system",
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": "time-sync-checker"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"name": "time-sync-checker"}},
+				Spec: corev1.PodSpec{
+					Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{{
+						Name:    "time-sync-checker",
+						Image:   "alpine",
+						Command: []string{"/bin/sleep", "24h"},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("100m"),
+								corev1.ResourceMemory: resource.MustParse("200Mi"),
+							},
+						},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								Exec: &corev1.ExecAction{Command: []string{"/bin/cat", "/host/etc/chrony.conf"}},
+							},
+						},
+						VolumeMounts: []corev1.VolumeMount{{Name: "host", MountPath: "/host", ReadOnly: true}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name:         "host",
+						VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+					}},
+				},
+			},
+		},
+	}
+	CorrelateDaemonSet(ds, nodePool, "time-sync-checker-"+nodePool.Name)
+	return ds
+}
+
+// nodeRebootPod builds a privileged pod that reboots the node it is scheduled
+// on, used to exercise in place lifecycle events.
+func nodeRebootPod(nodeName string) *corev1.Pod {
+	privileged := true
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "reboot-" + nodeName,
+			Namespace: "kube-system",
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      nodeName,
+			RestartPolicy: corev1.RestartPolicyNever,
+			Tolerations:   []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:            "reboot",
+				Image:           "alpine",
+				Command:         []string{"chroot", "/host", "systemctl", "reboot"},
+				SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+				VolumeMounts:    []corev1.VolumeMount{{Name: "host", MountPath: "/host"}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name:         "host",
+				VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+			}},
+		},
+	}
+}
+
+// waitForDaemonSetPodsReady waits until the DaemonSet runs the expected number
+// of ready pods.
+func waitForDaemonSetPodsReady(t *testing.T, ctx context.Context, c crclient.Client, ds *appsv1.DaemonSet, expected int) {
+	err := wait.PollImmediateWithContext(ctx, 10*time.Second, 15*time.Minute, func(ctx context.Context) (bool, error) {
+		pods := &corev1.PodList{}
+		if err := c.List(ctx, pods, crclient.InNamespace(ds.Namespace), crclient.MatchingLabels(ds.Spec.Selector.MatchLabels)); err != nil {
+			t.Logf("WARNING: failed to list pods, will retry: %v", err)
+			return false, nil
+		}
+		if len(pods.Items) != expected {
+			return false, nil
+		}
+		for i := range pods.Items {
+			ready := false
+			for _, condition := range pods.Items[i].Status.Conditions {
+				if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+					ready = true
+					break
+				}
+			}
+			if !ready {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("failed waiting for %s daemonset pods to be ready: %v", ds.Name, err)
+	}
+}
+
+// EnsureGuestRBACBootstrap verifies that the ClusterRole and ClusterRoleBinding
+// manifests referenced by the HostedCluster's GuestRBACManifests configuration
+// have been applied to the guest cluster.
+func EnsureGuestRBACBootstrap(t *testing.T, ctx context.Context, mgmtClient crclient.Client, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureGuestRBACBootstrap", func(t *testing.T) {
+		if hostedCluster.Spec.Configuration == nil || hostedCluster.Spec.Configuration.GuestRBACManifests == nil {
+			t.Skip("hosted cluster does not configure guest RBAC manifests")
+		}
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: hostedCluster.Namespace, Name: hostedCluster.Spec.Configuration.GuestRBACManifests.Name}}
+		if err := mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(cm), cm); err != nil {
+			t.Fatalf("failed to get guest rbac manifests configmap %s/%s: %v", cm.Namespace, cm.Name, err)
+		}
+		objects, err := util.ParseGuestRBACManifests(cm.Data)
+		if err != nil {
+			t.Fatalf("failed to parse guest rbac manifests: %v", err)
+		}
+		for _, obj := range objects {
+			switch expected := obj.(type) {
+			case *rbacv1.ClusterRole:
+				if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(expected), &rbacv1.ClusterRole{}); err != nil {
+					t.Errorf("failed to get cluster role %s in guest cluster: %v", expected.Name, err)
+				}
+			case *rbacv1.ClusterRoleBinding:
+				if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(expected), &rbacv1.ClusterRoleBinding{}); err != nil {
+					t.Errorf("failed to get cluster role binding %s in guest cluster: %v", expected.Name, err)
+				}
+			}
+		}
+	})
+}
+
+// EnsureBreakGlassSignerScoping verifies the separation-of-duties design of
+// the break-glass signers: a certificate minted from the customer signer with
+// an identity outside the customer break-glass scope authenticates but is not
+// granted any access by the guest API server, because the authorization that
+// the break-glass flow relies on is bound to the identities the PKI operator
+// issues, not to the signer itself.
+func EnsureBreakGlassSignerScoping(t *testing.T, ctx context.Context, mgmtClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureBreakGlassSignerScoping", func(t *testing.T) {
+		hcpNamespace := manifests.HostedControlPlaneNamespaceFor(hostedCluster)
+		signer := pkimanifests.CustomerSystemAdminSigner(hcpNamespace)
+		if err := mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(signer), signer); err != nil {
+			t.Fatalf("failed to get customer system admin signer: %v", err)
+		}
+		caKey, err := certs.PemToPrivateKey(signer.Data[certs.TLSSignerKeyMapKey])
+		if err != nil {
+			t.Fatalf("failed to parse customer signer key: %v", err)
+		}
+		caCert, err := certs.PemToCertificate(signer.Data[certs.TLSSignerCertMapKey])
+		if err != nil {
+			t.Fatalf("failed to parse customer signer certificate: %v", err)
+		}
+		// Mint a certificate claiming an SRE break-glass identity from the
+		// customer signer. The signer is trusted for authentication, but the
+		// resulting identity must not have any of the access that is bound to
+		// the identities issued by the PKI operator.
+		key, cert, err := certs.GenerateSignedCertificate(caKey, caCert, &certs.CertCfg{
+			Subject:      pkix.Name{CommonName: "sre-break-glass-scoping-check"},
+			KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+			ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			Validity:     certs.ValidityOneDay,
+		})
+		if err != nil {
+			t.Fatalf("failed to generate client certificate: %v", err)
+		}
+
+		guestKubeConfigSecretData, err := WaitForGuestKubeConfig(t, ctx, mgmtClient, hostedCluster)
+		if err != nil {
+			t.Fatalf("couldn't get guest kubeconfig: %v", err)
+		}
+		guestConfig, err := clientcmd.RESTConfigFromKubeConfig(guestKubeConfigSecretData)
+		if err != nil {
+			t.Fatalf("couldn't load guest kubeconfig: %v", err)
+		}
+		certConfig := rest.AnonymousClientConfig(guestConfig)
+		certConfig.TLSClientConfig.CertData = certs.CertToPem(cert)
+		certConfig.TLSClientConfig.KeyData = certs.PrivateKeyToPem(key)
+
+		client, err := kubernetes.NewForConfig(certConfig)
+		if err != nil {
+			t.Fatalf("could not create client: %v", err)
+		}
+		if _, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{}); !errors.IsForbidden(err) {
+			t.Errorf("expected an SRE-scoped identity minted from the customer signer to be forbidden, got: %v", err)
+		}
+	})
+}
+
+// EnsureSharedVPC verifies that the shared VPC configuration of a cluster
+// created with the shared VPC flags made it to the HostedControlPlane, i.e.
+// both cross-account role ARNs are wired through unchanged.
+func EnsureSharedVPC(t *testing.T, ctx context.Context, mgmtClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureSharedVPC", func(t *testing.T) {
+		if hostedCluster.Spec.Platform.AWS == nil || hostedCluster.Spec.Platform.AWS.SharedVPC == nil {
+			t.Skip("test only applicable when the cluster was created with a shared VPC configuration")
+		}
+		sharedVPC := hostedCluster.Spec.Platform.AWS.SharedVPC
+		if sharedVPC.NetworkingAccountRoleARN == "" || sharedVPC.ComputeAccountRoleARN == "" {
+			t.Errorf("expected both shared VPC role ARNs to be set, got networking %q and compute %q", sharedVPC.NetworkingAccountRoleARN, sharedVPC.ComputeAccountRoleARN)
+		}
+
+		hcp := &hyperv1.HostedControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: manifests.HostedControlPlaneNamespaceFor(hostedCluster), Name: hostedCluster.Name}}
+		if err := mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(hcp), hcp); err != nil {
+			t.Fatalf("failed to get hosted control plane: %v", err)
+		}
+		if hcp.Spec.Platform.AWS == nil || hcp.Spec.Platform.AWS.SharedVPC == nil {
+			t.Fatal("expected the hosted control plane to have a shared VPC configuration")
+		}
+		if *hcp.Spec.Platform.AWS.SharedVPC != *sharedVPC {
+			t.Errorf("expected the hosted control plane shared VPC configuration %+v to match the hosted cluster's %+v", *hcp.Spec.Platform.AWS.SharedVPC, *sharedVPC)
+		}
+	})
+}
+
+// EnsureEncryptionProvider verifies that the kube-apiserver encryption config
+// rendered for the cluster encrypts secrets with the provider selected through
+// spec.secretEncryption, i.e. the selected provider comes before the identity
+// provider used for reads of not yet re-encrypted resources.
+func EnsureEncryptionProvider(t *testing.T, ctx context.Context, mgmtClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureEncryptionProvider", func(t *testing.T) {
+		if hostedCluster.Spec.SecretEncryption == nil {
+			t.Skip("test only applicable when the cluster was created with secret encryption")
+		}
+
+		encryptionConfigSecret := cpomanifests.KASSecretEncryptionConfigFile(manifests.HostedControlPlaneNamespaceFor(hostedCluster))
+		if err := mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(encryptionConfigSecret), encryptionConfigSecret); err != nil {
+			t.Fatalf("failed to get kas secret encryption config: %v", err)
+		}
+		encryptionConfig := &apiserverconfigv1.EncryptionConfiguration{}
+		if err := yaml.Unmarshal(encryptionConfigSecret.Data["config.yaml"], encryptionConfig); err != nil {
+			t.Fatalf("failed to parse kas secret encryption config: %v", err)
+		}
+
+		var secretProviders []apiserverconfigv1.ProviderConfiguration
+		for _, resourceConfig := range encryptionConfig.Resources {
+			for _, resource := range resourceConfig.Resources {
+				if resource == "secrets" {
+					secretProviders = resourceConfig.Providers
+				}
+			}
+		}
+		if len(secretProviders) == 0 {
+			t.Fatal("expected the encryption config to cover secrets")
+		}
+		switch hostedCluster.Spec.SecretEncryption.Type {
+		case hyperv1.AESCBC:
+			if secretProviders[0].AESCBC == nil {
+				t.Errorf("expected the first secrets encryption provider to be aescbc, got %+v", secretProviders[0])
+			} else if len(secretProviders[0].AESCBC.Keys) == 0 {
+				t.Error("expected the aescbc provider to have at least one key")
+			}
+		case hyperv1.KMS:
+			if secretProviders[0].KMS == nil {
+				t.Errorf("expected the first secrets encryption provider to be kms, got %+v", secretProviders[0])
+			}
+		default:
+			t.Errorf("unexpected secret encryption type %q", hostedCluster.Spec.SecretEncryption.Type)
+		}
+		if secretProviders[0].Identity != nil {
+			t.Error("expected the identity provider to not be the write provider")
+		}
+	})
+}
+
+// EnsureControlPlanePodDeletionRecovery deletes a randomly selected non-etcd
+// control plane pod and verifies the owning deployment recreates it while the
+// cluster stays Available. When component is non-empty, only pods whose "app"
+// label matches it are candidates for deletion.
+func EnsureControlPlanePodDeletionRecovery(t *testing.T, ctx context.Context, mgmtClient crclient.Client, hostedCluster *hyperv1.HostedCluster, component string) {
+	t.Run("EnsureControlPlanePodDeletionRecovery", func(t *testing.T) {
+		namespace := manifests.HostedControlPlaneNamespaceFor(hostedCluster)
+		pods := &corev1.PodList{}
+		if err := mgmtClient.List(ctx, pods, crclient.InNamespace(namespace)); err != nil {
+			t.Fatalf("failed to list control plane pods: %v", err)
+		}
+		var candidates []corev1.Pod
+		for _, pod := range pods.Items {
+			app := pod.Labels["app"]
+			if app == "" || app == "etcd" {
+				continue
+			}
+			if component != "" && app != component {
+				continue
+			}
+			// Only pods owned by a ReplicaSet, i.e. managed by a deployment,
+			// are expected to be recreated.
+			if replicaSetOwner(&pod) == "" {
+				continue
+			}
+			candidates = append(candidates, pod)
+		}
+		if len(candidates) == 0 {
+			t.Fatalf("no deployment managed control plane pods found for component %q", component)
+		}
+		victim := candidates[rand.Intn(len(candidates))]
+		deploymentName := victim.Labels["app"]
+
+		t.Logf("Deleting control plane pod %s/%s", victim.Namespace, victim.Name)
+		if err := mgmtClient.Delete(ctx, &victim); err != nil {
+			t.Fatalf("failed to delete pod %s: %v", victim.Name, err)
+		}
+
+		err := wait.PollImmediateWithContext(ctx, 5*time.Second, 10*time.Minute, func(ctx context.Context) (done bool, err error) {
+			replaced := &corev1.Pod{}
+			if err := mgmtClient.Get(ctx, types.NamespacedName{Namespace: victim.Namespace, Name: victim.Name}, replaced); err == nil && replaced.UID == victim.UID {
+				return false, nil
+			} else if err != nil && !errors.IsNotFound(err) {
+				return false, nil
+			}
+			deployment := &appsv1.Deployment{}
+			if err := mgmtClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: deploymentName}, deployment); err != nil {
+				return false, nil
+			}
+			if deployment.Spec.Replicas == nil || deployment.Status.AvailableReplicas != *deployment.Spec.Replicas {
+				return false, nil
+			}
+			return true, nil
+		})
+		if err != nil {
+			t.Fatalf("deployment %s did not recover after deleting pod %s: %v", deploymentName, victim.Name, err)
+		}
+
+		cluster := &hyperv1.HostedCluster{}
+		if err := mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(hostedCluster), cluster); err != nil {
+			t.Fatalf("failed to get hostedcluster: %v", err)
+		}
+		available := meta.FindStatusCondition(cluster.Status.Conditions, string(hyperv1.HostedClusterAvailable))
+		if available == nil || available.Status != metav1.ConditionTrue {
+			t.Errorf("expected hosted cluster to stay Available after pod deletion, got: %+v", available)
+		}
+	})
+}
+
+// replicaSetOwner returns the name of the owning ReplicaSet of a pod, or the
+// empty string if the pod is not managed by one.
+func replicaSetOwner(pod *corev1.Pod) string {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "ReplicaSet" {
+			return ref.Name
+		}
+	}
+	return ""
+}
+
+// EnsureNodePoolCustomUserData verifies that for NodePools created with a
+// custom userData Secret, the user provided content made it into the user data
+// Secret consumed by the machines in the control plane namespace.
+func EnsureNodePoolCustomUserData(t *testing.T, ctx context.Context, mgmtClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureNodePoolCustomUserData", func(t *testing.T) {
+		nodePools := &hyperv1.NodePoolList{}
+		if err := mgmtClient.List(ctx, nodePools, crclient.InNamespace(hostedCluster.Namespace)); err != nil {
+			t.Fatalf("failed to list nodepools: %v", err)
+		}
+		checked := false
+		for i := range nodePools.Items {
+			nodePool := &nodePools.Items[i]
+			if nodePool.Spec.ClusterName != hostedCluster.Name || nodePool.Spec.UserDataSecret == nil {
+				continue
+			}
+			checked = true
+			customSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: nodePool.Namespace, Name: nodePool.Spec.UserDataSecret.Name}}
+			if err := mgmtClient.Get(ctx, crclient.ObjectKeyFromObject(customSecret), customSecret); err != nil {
+				t.Fatalf("failed to get custom userData secret for nodepool %s: %v", nodePool.Name, err)
+			}
+			secrets := &corev1.SecretList{}
+			if err := mgmtClient.List(ctx, secrets, crclient.InNamespace(manifests.HostedControlPlaneNamespaceFor(hostedCluster))); err != nil {
+				t.Fatalf("failed to list control plane secrets: %v", err)
+			}
+			found := false
+			for j := range secrets.Items {
+				secret := &secrets.Items[j]
+				if !strings.HasPrefix(secret.Name, fmt.Sprintf("user-data-%s-", nodePool.Name)) {
+					continue
+				}
+				found = true
+				if !bytes.Equal(secret.Data["value"], customSecret.Data["value"]) {
+					t.Errorf("expected user data secret %s to carry the custom userData content of nodepool %s", secret.Name, nodePool.Name)
+				}
+			}
+			if !found {
+				t.Errorf("no user data secret found for nodepool %s", nodePool.Name)
+			}
+		}
+		if !checked {
+			t.Skip("test only applicable when a NodePool has a custom userData secret")
+		}
+	})
+}
+
+// EnsureMixedInstanceTypes verifies that a cluster whose NodePools spread
+// across multiple AWS instance types actually provisions nodes of every
+// configured type, matching nodes to their NodePool via the
+// node.kubernetes.io/instance-type label.
+func EnsureMixedInstanceTypes(t *testing.T, ctx context.Context, mgmtClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureMixedInstanceTypes", func(t *testing.T) {
+		if hostedCluster.Spec.Platform.Type != hyperv1.AWSPlatform {
+			t.Skip("test only supported on platform AWS")
+		}
+		nodePools := &hyperv1.NodePoolList{}
+		if err := mgmtClient.List(ctx, nodePools, crclient.InNamespace(hostedCluster.Namespace)); err != nil {
+			t.Fatalf("failed to list nodepools: %v", err)
+		}
+		expectedTypes := sets.New[string]()
+		for i := range nodePools.Items {
+			nodePool := &nodePools.Items[i]
+			if nodePool.Spec.ClusterName != hostedCluster.Name || nodePool.Spec.Platform.AWS == nil {
+				continue
+			}
+			if nodePool.Spec.Replicas != nil && *nodePool.Spec.Replicas > 0 {
+				expectedTypes.Insert(nodePool.Spec.Platform.AWS.InstanceType)
+			}
+		}
+		if expectedTypes.Len() < 2 {
+			t.Skip("test only applicable when NodePools span multiple instance types")
+		}
+		guestClient := WaitForGuestClient(t, ctx, mgmtClient, hostedCluster)
+		nodes := &corev1.NodeList{}
+		if err := guestClient.List(ctx, nodes); err != nil {
+			t.Fatalf("failed to list nodes: %v", err)
+		}
+		observedTypes := sets.New[string]()
+		for i := range nodes.Items {
+			if instanceType := nodes.Items[i].Labels[corev1.LabelInstanceTypeStable]; instanceType != "" {
+				observedTypes.Insert(instanceType)
+			}
+		}
+		if unexpected := observedTypes.Difference(expectedTypes); unexpected.Len() > 0 {
+			t.Errorf("nodes run instance types not configured on any NodePool: %v", sets.List(unexpected))
+		}
+		if missing := expectedTypes.Difference(observedTypes); missing.Len() > 0 {
+			t.Errorf("no nodes found for configured instance types: %v", sets.List(missing))
+		}
+	})
+}
+
+// EnsureTelemetryDisabled verifies that a cluster created with telemetry
+// disabled does not run the telemeter client: the guest pull secret must not
+// carry the cloud.openshift.com token and the telemeter-client deployment must
+// not exist in openshift-monitoring.
+func EnsureTelemetryDisabled(t *testing.T, ctx context.Context, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureTelemetryDisabled", func(t *testing.T) {
+		pullSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-config", Name: "pull-secret"}}
+		if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(pullSecret), pullSecret); err != nil {
+			t.Fatalf("failed to get guest cluster pull secret: %v", err)
+		}
+		var dockerConfig struct {
+			Auths map[string]json.RawMessage `json:"auths"`
+		}
+		if err := json.Unmarshal(pullSecret.Data[corev1.DockerConfigJsonKey], &dockerConfig); err != nil {
+			t.Fatalf("failed to parse guest cluster pull secret: %v", err)
+		}
+		if _, hasToken := dockerConfig.Auths["cloud.openshift.com"]; hasToken {
+			t.Skip("test only applicable when the cluster was created with telemetry disabled")
+		}
+		telemeterClient := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-monitoring", Name: "telemeter-client"}}
+		err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(telemeterClient), telemeterClient)
+		if err == nil {
+			t.Error("expected no telemeter-client deployment when telemetry is disabled")
+		} else if !errors.IsNotFound(err) {
+			t.Errorf("failed to check for telemeter-client deployment: %v", err)
+		}
+	})
+}
+
+// EnsureAWSCredentialRotation exercises the credential rotation path on an AWS
+// cluster by rotating to the cluster's current role ARNs and verifying the
+// control plane restarts and reports Available again.
+func EnsureAWSCredentialRotation(t *testing.T, ctx context.Context, hostClient crclient.Client, hostedCluster *hyperv1.HostedCluster, awsCredentialsFile string, awsRegion string) {
+	t.Run("EnsureAWSCredentialRotation", func(t *testing.T) {
+		if hostedCluster.Spec.Platform.Type != hyperv1.AWSPlatform {
+			t.Skip("test only supported on platform AWS")
+		}
+		if awsCredentialsFile == "" {
+			t.Skip("AWS credentials are required to validate rotated roles")
+		}
+
+		awsSession := awsutil.NewSession("e2e-credential-rotation", awsCredentialsFile, "", "", awsRegion)
+		iamClient := iam.New(awsSession, awsutil.NewConfig())
+		validateRole := func(ctx context.Context, arn string) error {
+			roleName := arn[strings.LastIndex(arn, "/")+1:]
+			if _, err := iamClient.GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)}); err != nil {
+				return fmt.Errorf("failed to validate role %s: %w", arn, err)
+			}
+			return nil
+		}
+
+		// Rotating to the current ARNs exercises the full cutover, including
+		// validation and the control plane restart, without requiring a second
+		// set of provisioned roles.
+		rolesRef := hostedCluster.Spec.Platform.AWS.RolesRef
+		opts := &rotate.CredentialsOptions{
+			Namespace:                   hostedCluster.Namespace,
+			Name:                        hostedCluster.Name,
+			KubeCloudControllerRoleARN:  rolesRef.KubeCloudControllerARN,
+			NodePoolManagementRoleARN:   rolesRef.NodePoolManagementARN,
+			ControlPlaneOperatorRoleARN: rolesRef.ControlPlaneOperatorARN,
+			NetworkRoleARN:              rolesRef.NetworkARN,
+			StorageRoleARN:              rolesRef.StorageARN,
+		}
+		if err := rotate.RotateCredentials(ctx, hostClient, opts, validateRole); err != nil {
+			t.Fatalf("failed to rotate credentials: %v", err)
+		}
+
+		t.Logf("Waiting for the HostedCluster to report Available after the credential rotation")
+		err := wait.PollImmediateWithContext(ctx, 10*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+			latest := &hyperv1.HostedCluster{}
+			if err := hostClient.Get(ctx, crclient.ObjectKeyFromObject(hostedCluster), latest); err != nil {
+				return false, nil
+			}
+			available := meta.FindStatusCondition(latest.Status.Conditions, string(hyperv1.HostedClusterAvailable))
+			return available != nil && available.Status == metav1.ConditionTrue, nil
+		})
+		if err != nil {
+			t.Fatalf("hosted cluster did not report Available after credential rotation: %v", err)
+		}
+
+		// Verify the control plane came back cleanly with the rotated credentials.
+		EnsureNoCrashingPods(t, ctx, hostClient, hostedCluster)
+	})
+}
+
+// clockSyncThreshold is the maximum tolerated skew between the management
+// cluster, the control plane pods, and the guest nodes. Leases are renewed on
+// an interval, so the threshold needs to be comfortably larger than the
+// longest renew period of the components involved.
+const clockSyncThreshold = 5 * time.Minute
+
+// EnsureImageSignaturePolicy verifies that the guest cluster enforces the
+// configured image signature policy: a pod using an unsigned image within the
+// policy scope must fail to pull it, while a pod using a signed image must
+// pull successfully.
+func EnsureImageSignaturePolicy(t *testing.T, ctx context.Context, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster, signedImage, unsignedImage string) {
+	t.Run("EnsureImageSignaturePolicy", func(t *testing.T) {
+		if len(hostedCluster.Spec.ImageSignaturePolicies) == 0 {
+			t.Skip("no image signature policies configured")
+		}
+		if signedImage == "" || unsignedImage == "" {
+			t.Skip("no signed/unsigned test images given")
+		}
+		g := NewWithT(t)
+
+		pod := func(name, image string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      name,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:    "test",
+						Image:   image,
+						Command: []string{"/bin/sleep", "3600"},
+					}},
+				},
+			}
+		}
+		signedPod := pod("image-policy-signed", signedImage)
+		unsignedPod := pod("image-policy-unsigned", unsignedImage)
+		for _, p := range []*corev1.Pod{signedPod, unsignedPod} {
+			g.Expect(guestClient.Create(ctx, p)).To(Succeed(), "failed to create pod %s", p.Name)
+			defer func(p *corev1.Pod) {
+				if err := guestClient.Delete(ctx, p); err != nil {
+					t.Logf("failed to delete pod %s: %v", p.Name, err)
+				}
+			}(p)
+		}
+
+		var signedPulled, unsignedRejected bool
+		err := wait.PollImmediateWithContext(ctx, 10*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+			if !signedPulled {
+				latest := signedPod.DeepCopy()
+				if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(signedPod), latest); err != nil {
+					t.Logf("failed to get pod %s: %v", signedPod.Name, err)
+					return false, nil
+				}
+				for _, status := range latest.Status.ContainerStatuses {
+					if status.State.Running != nil {
+						signedPulled = true
+					}
+				}
+			}
+			if !unsignedRejected {
+				latest := unsignedPod.DeepCopy()
+				if err := guestClient.Get(ctx, crclient.ObjectKeyFromObject(unsignedPod), latest); err != nil {
+					t.Logf("failed to get pod %s: %v", unsignedPod.Name, err)
+					return false, nil
+				}
+				for _, status := range latest.Status.ContainerStatuses {
+					waiting := status.State.Waiting
+					if waiting != nil && (waiting.Reason == "ErrImagePull" || waiting.Reason == "ImagePullBackOff") && strings.Contains(waiting.Message, "signature") {
+						unsignedRejected = true
+					}
+				}
+			}
+			return signedPulled && unsignedRejected, nil
+		})
+		g.Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("image signature policy not enforced, signed image pulled: %v, unsigned image rejected: %v", signedPulled, unsignedRejected))
+	})
+}
+
+func EnsureClockSync(t *testing.T, ctx context.Context, hostClient, guestClient crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureClockSync", func(t *testing.T) {
+		namespace := manifests.HostedControlPlaneNamespace(hostedCluster.Namespace, hostedCluster.Name)
+		sources := map[string]struct {
+			client    crclient.Client
+			namespace string
+		}{
+			"management cluster nodes": {client: hostClient, namespace: "kube-node-lease"},
+			"control plane pods":       {client: hostClient, namespace: namespace},
+			"guest cluster nodes":      {client: guestClient, namespace: "kube-node-lease"},
+		}
+		for source, leases := range sources {
+			renewTime, err := latestLeaseRenewTime(ctx, leases.client, leases.namespace)
+			if err != nil {
+				t.Fatalf("failed to determine %s time: %v", source, err)
+			}
+			if skew := time.Since(renewTime); skew > clockSyncThreshold || skew < -clockSyncThreshold {
+				t.Errorf("%s clock is skewed by %s, exceeding the %s threshold", source, skew.Round(time.Second), clockSyncThreshold)
+			}
+		}
+	})
+}
+
+// latestLeaseRenewTime returns the most recent renew time across all leases in
+// the given namespace. The freshest lease reflects the clock of whichever
+// component renewed it last.
+func latestLeaseRenewTime(ctx context.Context, c crclient.Client, namespace string) (time.Time, error) {
+	var leaseList coordinationv1.LeaseList
+	if err := c.List(ctx, &leaseList, &crclient.ListOptions{Namespace: namespace}); err != nil {
+		return time.Time{}, fmt.Errorf("failed to list leases in namespace %s: %w", namespace, err)
+	}
+	var latest time.Time
+	for _, lease := range leaseList.Items {
+		if lease.Spec.RenewTime != nil && lease.Spec.RenewTime.Time.After(latest) {
+			latest = lease.Spec.RenewTime.Time
+		}
+	}
+	if latest.IsZero() {
+		return time.Time{}, fmt.Errorf("no renewed leases found in namespace %s", namespace)
+	}
+	return latest, nil
+}
+
 func EnsureNodeCountMatchesNodePoolReplicas(t *testing.T, ctx context.Context, hostClient, guestClient crclient.Client, nodePoolNamespace string) {
 	t.Run("EnsureNodeCountMatchesNodePoolReplicas", func(t *testing.T) {
 		var nodePoolList hyperv1.NodePoolList
@@ -997,6 +2510,35 @@ func EnsureHCPContainersHaveResourceRequests(t *testing.T, ctx context.Context,
 	})
 }
 
+// EnsureControlPlanePodDisruptionBudgets verifies that highly available control
+// planes carry PodDisruptionBudgets for their disruption sensitive components
+// and that every budget in the control plane namespace actually bounds
+// disruptions.
+func EnsureControlPlanePodDisruptionBudgets(t *testing.T, ctx context.Context, client crclient.Client, hostedCluster *hyperv1.HostedCluster) {
+	t.Run("EnsureControlPlanePodDisruptionBudgets", func(t *testing.T) {
+		if hostedCluster.Spec.ControllerAvailabilityPolicy != hyperv1.HighlyAvailable {
+			t.Skip("test only applicable for highly available control planes")
+		}
+		namespace := manifests.HostedControlPlaneNamespace(hostedCluster.Namespace, hostedCluster.Name)
+		var pdbList policyv1.PodDisruptionBudgetList
+		if err := client.List(ctx, &pdbList, &crclient.ListOptions{Namespace: namespace}); err != nil {
+			t.Fatalf("failed to list poddisruptionbudgets: %v", err)
+		}
+		found := sets.NewString()
+		for _, pdb := range pdbList.Items {
+			found.Insert(pdb.Name)
+			if pdb.Spec.MinAvailable == nil && pdb.Spec.MaxUnavailable == nil {
+				t.Errorf("poddisruptionbudget %s has neither minAvailable nor maxUnavailable set", pdb.Name)
+			}
+		}
+		for _, required := range []string{"etcd", "kube-apiserver"} {
+			if !found.Has(required) {
+				t.Errorf("expected a poddisruptionbudget for %s, found none", required)
+			}
+		}
+	})
+}
+
 func EnsureSecretEncryptedUsingKMS(t *testing.T, ctx context.Context, hostedCluster *hyperv1.HostedCluster, guestClient crclient.Client) {
 	t.Run("EnsureSecretEncryptedUsingKMS", func(t *testing.T) {
 		// create secret in guest cluster
@@ -1449,6 +2991,52 @@ func ValidatePrivateCluster(t *testing.T, ctx context.Context, client crclient.C
 	EnsureNoCrashingPods(t, ctx, client, hostedCluster)
 }
 
+// EnsureHostedClusterConditions asserts that the HostedCluster's conditions
+// match the expected statuses, reporting every mismatch rather than stopping
+// at the first one. The expected set starts from the defaults for a healthy
+// cluster (conditions.ExpectedHCConditions); entries in the given map override
+// or extend those defaults.
+func EnsureHostedClusterConditions(t *testing.T, ctx context.Context, client crclient.Client, hostedCluster *hyperv1.HostedCluster, expected map[hyperv1.ConditionType]metav1.ConditionStatus) {
+	t.Run("EnsureHostedClusterConditions", func(t *testing.T) {
+		expectedConditions := conditions.ExpectedHCConditions()
+		for conditionType, status := range expected {
+			expectedConditions[conditionType] = status
+		}
+
+		latest := hostedCluster.DeepCopy()
+		if err := client.Get(ctx, crclient.ObjectKeyFromObject(latest), latest); err != nil {
+			t.Fatalf("failed to get hostedcluster: %v", err)
+		}
+
+		observed := map[hyperv1.ConditionType]*metav1.Condition{}
+		for i := range latest.Status.Conditions {
+			condition := &latest.Status.Conditions[i]
+			observed[hyperv1.ConditionType(condition.Type)] = condition
+		}
+
+		conditionTypes := make([]string, 0, len(expectedConditions))
+		for conditionType := range expectedConditions {
+			conditionTypes = append(conditionTypes, string(conditionType))
+		}
+		sort.Strings(conditionTypes)
+
+		for _, conditionType := range conditionTypes {
+			expectedStatus := expectedConditions[hyperv1.ConditionType(conditionType)]
+			condition, exists := observed[hyperv1.ConditionType(conditionType)]
+			switch {
+			case !exists:
+				// Some conditions are only reported in specific
+				// configurations, e.g. UnmanagedEtcdAvailable.
+				t.Logf("status.conditions[type==%s] is not reported, expected status %q", conditionType, expectedStatus)
+			case condition.Status != expectedStatus:
+				t.Errorf("status.conditions[type==%s] %q (expected %q): %s: %s", condition.Type, condition.Status, expectedStatus, condition.Reason, condition.Message)
+			default:
+				t.Logf("status.conditions[type==%s] matches expected status %q", conditionType, expectedStatus)
+			}
+		}
+	})
+}
+
 func validateHostedClusterConditions(t *testing.T, ctx context.Context, client crclient.Client, hostedCluster *hyperv1.HostedCluster, hasWorkerNodes bool) {
 	expectedConditions := conditions.ExpectedHCConditions()
 